@@ -5,9 +5,16 @@
 package main
 
 import (
+	"bufio"
+	"fmt"
 	"github.com/onosproject/config-models/pkg/compiler"
 	"github.com/spf13/cobra"
+	"io"
 	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
 )
 
 const (
@@ -27,12 +34,176 @@ func getCmd() *cobra.Command {
 		Short: "Compiles the specified config model",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := defaultModelPath
-			if len(args) > 0 {
-				path = args[0]
+			// Arguments are valid, any error from here on is not a usage error
+			cmd.SilenceUsage = true
+			check, _ := cmd.Flags().GetBool("check")
+			c := compiler.NewCompiler(compileOptions(cmd)...)
+			if check {
+				return c.Check(modelPath(args), cmd.OutOrStdout())
 			}
-			return compiler.NewCompiler().Compile(path)
+			return c.Compile(modelPath(args))
 		},
 	}
+	cmd.Flags().Bool("check", false, "compare the generated files with the ones on disk without writing them, and fail if any differ")
+	cmd.PersistentFlags().String("templates-dir", "", "directory of templates to use instead of the built-in ones; a model's own templates directory takes precedence")
+	cmd.PersistentFlags().Bool("force", false, "regenerate all files, even if their inputs have not changed since the last compilation")
+	cmd.PersistentFlags().String("config-models-dir", "", "directory of the config-models module that the plugins are built with, relative to the model, instead of its release, which is required while that release is unpublished")
+	cmd.AddCommand(getTreeCmd())
+	cmd.AddCommand(getLintCmd())
+	cmd.AddCommand(getCompileAllCmd())
+	cmd.AddCommand(getInitCmd())
 	return cmd
 }
+
+// compileOptions reads the compilation flags, which the root command declares
+// for itself and for compile-all
+func compileOptions(cmd *cobra.Command) []compiler.Option {
+	templatesDir, _ := cmd.Flags().GetString("templates-dir")
+	force, _ := cmd.Flags().GetBool("force")
+	configModelsDir, _ := cmd.Flags().GetString("config-models-dir")
+	return []compiler.Option{compiler.WithTemplatesDir(templatesDir), compiler.WithForce(force),
+		compiler.WithConfigModelsDir(configModelsDir)}
+}
+
+func getTreeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tree [path]",
+		Short: "Prints the YANG tree of the specified config model",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return compiler.NewCompiler().Tree(modelPath(args), cmd.OutOrStdout())
+		},
+	}
+	return cmd
+}
+
+func getLintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [path]",
+		Short: "Lints the YANG files of the specified config model",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return compiler.NewCompiler().Lint(modelPath(args))
+		},
+	}
+	return cmd
+}
+
+func getCompileAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compile-all <root>",
+		Short: "Compiles all the config models found under the root directory",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			workers, _ := cmd.Flags().GetInt("workers")
+			results, err := compiler.CompileAll(args[0], workers, compileOptions(cmd)...)
+			printSummary(cmd.OutOrStdout(), results)
+			return err
+		},
+	}
+	cmd.Flags().IntP("workers", "j", runtime.NumCPU(), "maximum number of models compiled concurrently")
+	return cmd
+}
+
+func getInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init <dir> --yang <files...>",
+		Short: "Creates a new config model from YANG files",
+		Long: `Creates a new config model in the directory from YANG files. The files are copied to
+the yang directory of the model and its metadata.yaml lists the modules that no other
+module imports. Attributes not given as flags take their default value, or are prompted for
+with --interactive.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			yangFiles, _ := cmd.Flags().GetStringSlice("yang")
+			opts := compiler.DefaultInitOptions(args[0])
+			prompt, _ := cmd.Flags().GetBool("interactive")
+			in := bufio.NewReader(cmd.InOrStdin())
+			for _, f := range []struct {
+				flag  string
+				value *string
+			}{
+				{"name", &opts.Name},
+				{"version", &opts.Version},
+				{"artifact-name", &opts.ArtifactName},
+				{"go-package", &opts.GoPackage},
+				{"contact-name", &opts.ContactName},
+				{"contact-url", &opts.ContactUrl},
+				{"contact-email", &opts.ContactEmail},
+				{"license-name", &opts.LicenseName},
+				{"license-url", &opts.LicenseUrl},
+			} {
+				if cmd.Flags().Changed(f.flag) {
+					*f.value, _ = cmd.Flags().GetString(f.flag)
+				} else if prompt {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s [%s]: ", f.flag, *f.value)
+					line, err := in.ReadString('\n')
+					if err != nil && err != io.EOF {
+						return err
+					}
+					if line = strings.TrimSpace(line); line != "" {
+						*f.value = line
+					}
+				}
+			}
+			// The default contact and license details only go with the default names
+			if cmd.Flags().Changed("contact-name") && !prompt {
+				for flag, value := range map[string]*string{"contact-url": &opts.ContactUrl, "contact-email": &opts.ContactEmail} {
+					if !cmd.Flags().Changed(flag) {
+						*value = ""
+					}
+				}
+			}
+			if cmd.Flags().Changed("license-name") && !prompt && !cmd.Flags().Changed("license-url") {
+				opts.LicenseUrl = ""
+			}
+			metaData, err := compiler.Init(args[0], yangFiles, opts)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created config model %s %s in %s\n", metaData.Name, metaData.Version, args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice("yang", nil, "YANG files of the model, including the ones they import")
+	_ = cmd.MarkFlagRequired("yang")
+	cmd.Flags().BoolP("interactive", "i", false, "prompt for the attributes not given as flags")
+	cmd.Flags().String("name", "", "name of the model; defaults to the directory name without its version")
+	cmd.Flags().String("version", "", "version of the model; defaults to the version suffix of the directory name or 1.0.0")
+	cmd.Flags().String("artifact-name", "", "name of the plugin artifact; defaults to the directory name")
+	cmd.Flags().String("go-package", "", "Go import path of the plugin; defaults to github.com/onosproject/config-models/models/<dir>")
+	cmd.Flags().String("contact-name", "", "maintainer of the model")
+	cmd.Flags().String("contact-url", "", "URL of the maintainer")
+	cmd.Flags().String("contact-email", "", "e-mail address of the maintainer")
+	cmd.Flags().String("license-name", "", "license of the model")
+	cmd.Flags().String("license-url", "", "URL of the license")
+	return cmd
+}
+
+func printSummary(out io.Writer, results []compiler.ModelResult) {
+	if len(results) == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODEL\tSTATUS\tDURATION\tPATHS")
+	for _, r := range results {
+		status, paths := "ok", fmt.Sprint(r.Paths)
+		if r.Err != nil {
+			status = "failed"
+		} else if r.UpToDate {
+			status, paths = "up-to-date", "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Path, status, r.Duration.Round(time.Millisecond), paths)
+	}
+	w.Flush()
+}
+
+func modelPath(args []string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+	return defaultModelPath
+}
@@ -5,6 +5,7 @@ go 1.16
 require (
 	github.com/SeanCondon/xpath v0.0.0-20220821123841-6149b14eb04f
 	github.com/getkin/kin-openapi v0.20.0
+	github.com/ghodss/yaml v1.0.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/onosproject/onos-api/go v0.9.46
@@ -12,10 +13,12 @@ require (
 	github.com/openconfig/gnmi v0.0.0-20220617175856-41246b1b3507
 	github.com/openconfig/goyang v1.1.0
 	github.com/openconfig/ygot v0.24.4
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/spf13/cobra v1.4.0
 	github.com/spf13/viper v1.11.0
 	github.com/stretchr/testify v1.7.1
 	golang.org/x/text v0.3.7
+	google.golang.org/genproto v0.0.0-20220407144326-9054f6ed7bac
 	google.golang.org/grpc v1.46.0
 	google.golang.org/protobuf v1.28.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
@@ -5,12 +5,12 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.24.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/kpimon-xapp.yang
-	- /config-model/yang/xapp.yang
+	- yang/kpimon-xapp.yang
+	- yang/xapp.yang
 Imported modules were sourced from:
-	- "/config-model/yang"/...
+	- yang/...
 */
 package api
 
@@ -279,74 +279,74 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xff, 0x6f, 0xa3, 0x36,
-		0x14, 0xff, 0xbd, 0x7f, 0x85, 0x85, 0x74, 0x12, 0xf4, 0x42, 0x4a, 0xd2, 0x90, 0x5c, 0xf8, 0xa5,
-		0xea, 0xad, 0xab, 0x36, 0xb5, 0xcd, 0xa2, 0xee, 0x34, 0x4d, 0x4a, 0xd8, 0xc9, 0x0b, 0x4e, 0x6a,
-		0x1d, 0x31, 0xc8, 0x98, 0xae, 0x55, 0xca, 0xff, 0x3e, 0x19, 0x13, 0x06, 0x84, 0x6f, 0x66, 0xeb,
-		0xae, 0xa7, 0xcb, 0x2f, 0x94, 0xda, 0xef, 0xc1, 0xc7, 0xef, 0xf3, 0xb1, 0xdf, 0x13, 0x2f, 0xbb,
-		0x13, 0x00, 0x00, 0x50, 0x66, 0x70, 0x8b, 0x14, 0x0b, 0x28, 0x0e, 0x7a, 0xc4, 0x2b, 0xa4, 0xf4,
-		0xc4, 0xe8, 0x0d, 0x26, 0x8e, 0x62, 0x81, 0x41, 0xf2, 0xef, 0x0f, 0x1e, 0x59, 0xe3, 0x8d, 0x62,
-		0x01, 0x23, 0x19, 0xb8, 0xc2, 0x54, 0xb1, 0x80, 0x78, 0x44, 0x3c, 0x40, 0x3c, 0x07, 0x05, 0xb9,
-		0xa1, 0xdc, 0xd3, 0xc5, 0x74, 0x2f, 0x3f, 0x79, 0x85, 0x82, 0x15, 0xc5, 0x3e, 0xc3, 0x1e, 0xe1,
-		0x36, 0x9f, 0x1e, 0x10, 0x70, 0x71, 0xc0, 0x80, 0xb7, 0x06, 0x68, 0x08, 0x4a, 0x5d, 0xf2, 0xb8,
-		0xd2, 0xe1, 0x14, 0xdf, 0xb0, 0x30, 0x31, 0xa7, 0x68, 0x8d, 0x9f, 0x0e, 0x70, 0xe5, 0xb0, 0x3d,
-		0x41, 0xdf, 0x2f, 0xbc, 0x27, 0x9e, 0xff, 0xd5, 0x0b, 0xe9, 0x0a, 0x95, 0xfa, 0x0a, 0x2c, 0xe8,
-		0xf9, 0x2f, 0x8f, 0x72, 0x38, 0x8a, 0x2f, 0x5e, 0xd3, 0x2b, 0x37, 0xfc, 0x09, 0x06, 0x97, 0x74,
-		0x13, 0x6e, 0x11, 0x61, 0x8a, 0x05, 0x18, 0x0d, 0x51, 0x85, 0x61, 0xc6, 0x4a, 0xa0, 0x3a, 0x30,
-		0x8b, 0x72, 0x23, 0x51, 0x31, 0xa0, 0x05, 0x56, 0x72, 0xec, 0x54, 0x2f, 0x24, 0x4b, 0x52, 0xd5,
-		0x1a, 0x0a, 0x5c, 0x25, 0xfc, 0x54, 0x59, 0x97, 0xd3, 0x74, 0x48, 0x97, 0x51, 0x61, 0x50, 0x43,
-		0x5b, 0x5b, 0xfa, 0xda, 0xd2, 0x28, 0x4d, 0xa7, 0x34, 0xad, 0x12, 0xf4, 0x96, 0xd3, 0x5c, 0x41,
-		0x77, 0x23, 0xed, 0xa9, 0x01, 0x76, 0x9a, 0x97, 0xbf, 0x0f, 0x27, 0x76, 0x9a, 0xd6, 0x5d, 0x94,
-		0xc2, 0xec, 0x23, 0x68, 0x76, 0x4a, 0x14, 0x61, 0x34, 0x98, 0x35, 0x29, 0x43, 0x46, 0x21, 0xb2,
-		0x4a, 0x91, 0x55, 0x4c, 0x67, 0xe5, 0x74, 0x56, 0x50, 0x07, 0x25, 0xd5, 0x2b, 0xaa, 0x41, 0x59,
-		0xe9, 0xfb, 0x3e, 0x3d, 0xfb, 0x48, 0x2e, 0xd6, 0x01, 0xa3, 0x98, 0x6c, 0xda, 0x44, 0x7b, 0x7f,
-		0x56, 0x7c, 0x38, 0xe9, 0x86, 0xbf, 0x06, 0xbb, 0x82, 0x7d, 0x09, 0xe1, 0xfb, 0x92, 0xc2, 0xe7,
-		0xf9, 0xea, 0xe7, 0x39, 0x80, 0x8e, 0x43, 0x51, 0x10, 0xf0, 0xac, 0xc5, 0x1e, 0x50, 0xdd, 0xb1,
-		0x78, 0xdc, 0x0c, 0xdf, 0xe9, 0x66, 0xc0, 0xbe, 0x9e, 0xa8, 0x44, 0x66, 0x43, 0x4c, 0x5b, 0xd8,
-		0x26, 0x58, 0x16, 0xad, 0x22, 0xd5, 0x8e, 0xc1, 0x02, 0xf2, 0xc7, 0x91, 0x04, 0xf6, 0xc3, 0x4d,
-		0x2d, 0xe1, 0x33, 0x87, 0x8c, 0x21, 0x4a, 0x5a, 0x2f, 0x27, 0x75, 0x54, 0xd5, 0x85, 0xa1, 0x4f,
-		0xed, 0x97, 0xc5, 0x40, 0x9f, 0xda, 0xe2, 0x76, 0x10, 0xff, 0x11, 0xf7, 0xc3, 0x85, 0xa1, 0x8f,
-		0xf6, 0xf7, 0xe6, 0xc2, 0xd0, 0x4d, 0x5b, 0x5b, 0x2e, 0xfb, 0xda, 0xee, 0x3c, 0x92, 0x77, 0x54,
-		0xdf, 0x2d, 0x96, 0x4b, 0x7f, 0x37, 0x8b, 0xf8, 0xf5, 0x36, 0xb2, 0xdf, 0x6b, 0x17, 0x4a, 0x6b,
-		0xb4, 0x76, 0x2b, 0xcb, 0xa8, 0xf7, 0x8a, 0x6c, 0x8e, 0xbf, 0x01, 0x36, 0xad, 0x17, 0x1e, 0x73,
-		0xa8, 0xaf, 0x2f, 0xf5, 0x6b, 0x7b, 0x67, 0xf4, 0x46, 0x91, 0x66, 0x69, 0x6a, 0x71, 0xcc, 0xd2,
-		0x76, 0x46, 0xcf, 0x8c, 0x54, 0xb5, 0x64, 0xe6, 0xa2, 0xec, 0x19, 0xda, 0x8b, 0xaa, 0xaa, 0x09,
-		0x8f, 0x39, 0x6e, 0x17, 0xc6, 0xc0, 0xbe, 0x88, 0x6f, 0xc5, 0x35, 0x55, 0x47, 0x2b, 0x63, 0xad,
-		0x54, 0x13, 0x3d, 0x69, 0x09, 0xff, 0x61, 0xd9, 0xef, 0x2d, 0x6d, 0x37, 0x8e, 0xf6, 0xf7, 0xf1,
-		0x55, 0x7b, 0x51, 0xfb, 0xa7, 0xcb, 0x65, 0xbf, 0x7f, 0xaa, 0x89, 0x05, 0x24, 0x76, 0xa7, 0x62,
-		0xf6, 0xc2, 0xb2, 0x0e, 0x86, 0x34, 0xf5, 0x5d, 0xff, 0x35, 0x64, 0x79, 0xf2, 0xef, 0x9e, 0xd3,
-		0x2d, 0x83, 0xfb, 0xee, 0x96, 0xe8, 0x32, 0xf5, 0xeb, 0xde, 0x41, 0x2e, 0x97, 0xcf, 0x6f, 0xef,
-		0x66, 0xc7, 0x2a, 0xf6, 0x98, 0xb8, 0xdf, 0x64, 0x15, 0xeb, 0x7b, 0x94, 0x49, 0x6c, 0x00, 0x6e,
-		0x2d, 0x5f, 0xc9, 0x72, 0xb7, 0x63, 0x0d, 0x7b, 0xdc, 0x0a, 0x75, 0xb2, 0xd2, 0x49, 0xb8, 0xfd,
-		0x13, 0x51, 0x89, 0xfd, 0x30, 0x6e, 0x61, 0x7a, 0x0f, 0xc9, 0xe6, 0x55, 0x8a, 0xd8, 0x3b, 0x4c,
-		0x5a, 0x73, 0x9e, 0x3a, 0xfd, 0x06, 0xdd, 0x10, 0x35, 0xeb, 0xf5, 0xc0, 0xef, 0x9a, 0xc2, 0x15,
-		0xdf, 0x4f, 0x57, 0x78, 0x83, 0x59, 0xd0, 0xe1, 0x01, 0x33, 0xb4, 0x81, 0x0c, 0x3f, 0xf2, 0x77,
-		0xaf, 0xa1, 0x1b, 0xa0, 0xd6, 0xde, 0x91, 0x44, 0x55, 0x76, 0x07, 0x9f, 0xba, 0x87, 0x64, 0x6c,
-		0x9a, 0xe7, 0xe6, 0xb7, 0x13, 0x96, 0xaf, 0x5d, 0xd5, 0x48, 0x7d, 0xc3, 0xbb, 0x41, 0xcf, 0xb5,
-		0x5f, 0xdf, 0x94, 0x5b, 0x1c, 0xb0, 0x4b, 0xc6, 0x1a, 0xbe, 0xf4, 0xdd, 0x61, 0xf2, 0xa3, 0x8b,
-		0xf8, 0x49, 0xd2, 0x10, 0x6b, 0xae, 0x84, 0x8c, 0xe5, 0xe0, 0xc3, 0x68, 0x34, 0x9e, 0x8c, 0x46,
-		0xc6, 0xe4, 0x7c, 0x62, 0x4c, 0x4d, 0x73, 0x30, 0x1e, 0xd4, 0x30, 0xad, 0xfc, 0x42, 0x1d, 0x44,
-		0x91, 0xf3, 0x91, 0x63, 0x26, 0xa1, 0xeb, 0x4a, 0x2d, 0xf5, 0x92, 0x10, 0x8f, 0xc1, 0x24, 0xf7,
-		0xd4, 0xac, 0x25, 0x58, 0x3d, 0xa0, 0x2d, 0xf4, 0x21, 0x7b, 0xe0, 0x91, 0x39, 0xe3, 0xc7, 0xe2,
-		0x59, 0xdc, 0x14, 0x38, 0x6b, 0xc8, 0x4f, 0x3c, 0x6d, 0x87, 0x2b, 0x46, 0x92, 0x93, 0xeb, 0x77,
-		0xe8, 0xfb, 0x9f, 0x67, 0xdc, 0x31, 0xbe, 0x96, 0x1f, 0xad, 0x91, 0xe4, 0xa7, 0xf6, 0x86, 0x45,
-		0x54, 0x83, 0x2f, 0xeb, 0x34, 0x54, 0xe1, 0xcd, 0x43, 0xfd, 0x07, 0x50, 0x06, 0x8c, 0x42, 0x11,
-		0x3f, 0x99, 0x3f, 0xfb, 0x88, 0x62, 0xcf, 0xa9, 0x6e, 0xc0, 0xe4, 0xcd, 0x9a, 0x1b, 0x31, 0x5f,
-		0x7c, 0xbc, 0xf5, 0x88, 0xce, 0x71, 0x03, 0xe1, 0x0b, 0x84, 0x2f, 0x58, 0x79, 0x84, 0x41, 0x4c,
-		0x0e, 0xd2, 0x40, 0x63, 0x6f, 0xc6, 0xe8, 0xd0, 0x9b, 0xc9, 0xc0, 0x78, 0x7b, 0x2d, 0x9a, 0x2c,
-		0xb8, 0xff, 0xaa, 0x53, 0x83, 0x09, 0x43, 0xf4, 0x11, 0xba, 0xcd, 0xdd, 0x9a, 0xd4, 0xb2, 0x5d,
-		0xc7, 0xe6, 0x5e, 0x90, 0xb8, 0xf7, 0x02, 0x6b, 0x8f, 0x02, 0x4c, 0x1c, 0xbc, 0x8a, 0x45, 0x0c,
-		0xb6, 0x28, 0x08, 0xe0, 0x06, 0x05, 0xd5, 0x4f, 0x5b, 0xc3, 0xd0, 0x65, 0xb5, 0x69, 0x5a, 0x19,
-		0x18, 0xe5, 0x9b, 0xcb, 0xae, 0x6f, 0x13, 0x19, 0xff, 0x47, 0x9b, 0xa8, 0x5e, 0x49, 0x6f, 0xbc,
-		0x5b, 0x54, 0xab, 0xb4, 0x6e, 0x09, 0xa7, 0xb1, 0x02, 0x4c, 0x23, 0x17, 0x62, 0xc2, 0xce, 0x87,
-		0x75, 0x41, 0x4b, 0x78, 0x9c, 0xd4, 0x98, 0xb4, 0x2b, 0xf2, 0x5a, 0xd4, 0xa3, 0x32, 0x45, 0x9d,
-		0x6c, 0x31, 0xd7, 0xb9, 0x5a, 0x91, 0xaf, 0x52, 0x5a, 0x14, 0x6d, 0x52, 0xc5, 0x5a, 0xba, 0xd4,
-		0xd1, 0x70, 0x3a, 0x9a, 0x8e, 0x27, 0xc3, 0xa9, 0xf9, 0xf6, 0xd6, 0xdc, 0xb1, 0x92, 0xb2, 0xbf,
-		0x46, 0xc6, 0xce, 0x6c, 0xb9, 0xb3, 0xba, 0xe4, 0x59, 0x92, 0xc0, 0x6f, 0x62, 0xd7, 0x38, 0x8d,
-		0x8b, 0x53, 0x77, 0x2e, 0x1c, 0xab, 0xb2, 0xf9, 0x49, 0x06, 0x6e, 0x15, 0x4c, 0x05, 0x07, 0xd7,
-		0xf0, 0x0b, 0xba, 0xf7, 0xbc, 0xc3, 0xe3, 0xa3, 0x08, 0x5d, 0xc9, 0x4e, 0xe5, 0x90, 0x5d, 0x89,
-		0x1f, 0x79, 0x88, 0x17, 0x9e, 0x44, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff,
-		0xff, 0xf9, 0x97, 0xfa, 0x3c, 0x03, 0x22, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xef, 0x6f, 0xa3, 0x36,
+		0x18, 0xfe, 0xce, 0x5f, 0x61, 0x59, 0x3a, 0x09, 0x7a, 0xd0, 0x92, 0x34, 0x24, 0x17, 0xbe, 0x54,
+		0xbd, 0x75, 0xd5, 0xa6, 0xb6, 0x59, 0xd4, 0x9d, 0xa6, 0x49, 0x09, 0x3b, 0x79, 0xc1, 0x49, 0xad,
+		0x23, 0x06, 0x19, 0xd3, 0xb5, 0x4a, 0xf9, 0xdf, 0x27, 0x62, 0x88, 0x02, 0xe1, 0x97, 0xb9, 0xeb,
+		0xae, 0xa7, 0x85, 0x0f, 0x94, 0xda, 0xef, 0x6b, 0x1e, 0xfb, 0x79, 0xec, 0xf7, 0x15, 0x6f, 0x36,
+		0x0a, 0x00, 0x00, 0xc0, 0x09, 0x5a, 0x63, 0x68, 0x03, 0xe8, 0xe2, 0x47, 0xb2, 0xc0, 0x50, 0x17,
+		0xad, 0x37, 0x84, 0xba, 0xd0, 0x06, 0xbd, 0xf4, 0xdf, 0x9f, 0x7c, 0xba, 0x24, 0x2b, 0x68, 0x03,
+		0x33, 0x6d, 0xb8, 0x22, 0x0c, 0xda, 0x40, 0x0c, 0x01, 0x00, 0x00, 0x90, 0xfa, 0x2e, 0x0e, 0x73,
+		0x4d, 0xb9, 0xd1, 0x45, 0xb7, 0x9e, 0xef, 0xbc, 0xc2, 0xe1, 0x82, 0x91, 0x80, 0x13, 0x9f, 0x26,
+		0x36, 0x9f, 0x1e, 0x30, 0xf0, 0x48, 0xc8, 0x81, 0xbf, 0x04, 0xb8, 0x0f, 0x4a, 0x5d, 0xf2, 0xb8,
+		0x76, 0xcd, 0x3b, 0x7c, 0xfd, 0x42, 0xc7, 0x94, 0xe1, 0x25, 0x79, 0x3a, 0xc0, 0x95, 0xc3, 0xf6,
+		0x84, 0x82, 0x00, 0xea, 0x87, 0xfd, 0xbf, 0xfb, 0x11, 0x5b, 0xe0, 0x52, 0x5f, 0x81, 0x05, 0x3f,
+		0xff, 0xe3, 0xb3, 0x04, 0x0e, 0x0c, 0xc4, 0x6b, 0xf4, 0x72, 0xc3, 0x5f, 0x50, 0x78, 0xc9, 0x56,
+		0xd1, 0x1a, 0x53, 0x0e, 0x6d, 0xc0, 0x59, 0x84, 0x2b, 0x0c, 0xf7, 0xac, 0x04, 0xaa, 0x03, 0xb3,
+		0x38, 0xd7, 0x12, 0x17, 0x17, 0xb4, 0xc0, 0x4a, 0x8e, 0x9d, 0xea, 0x89, 0xec, 0x93, 0x54, 0x35,
+		0x87, 0x02, 0x57, 0x29, 0x3f, 0x55, 0xd6, 0xe5, 0x34, 0x1d, 0xd2, 0x65, 0x56, 0x18, 0xd4, 0xd0,
+		0xd6, 0x96, 0xbe, 0xb6, 0x34, 0x4a, 0xd3, 0x29, 0x4d, 0xab, 0x04, 0xbd, 0xe5, 0x34, 0x57, 0xd0,
+		0xdd, 0x48, 0x7b, 0x76, 0x41, 0xe2, 0x36, 0x4f, 0x3f, 0x5b, 0x4e, 0xe2, 0x36, 0xcd, 0xbb, 0x28,
+		0x85, 0xc9, 0x47, 0xd0, 0xec, 0x94, 0x2a, 0xc2, 0x6c, 0x30, 0x6b, 0x52, 0x86, 0x8c, 0x42, 0x64,
+		0x95, 0x22, 0xab, 0x98, 0xce, 0xca, 0xe9, 0xac, 0xa0, 0x0e, 0x4a, 0xaa, 0x57, 0x54, 0x83, 0xb2,
+		0xb2, 0x0b, 0x7e, 0x7a, 0x0e, 0xb0, 0xdc, 0x5a, 0x87, 0x9c, 0x11, 0xba, 0x6a, 0xb3, 0xda, 0xd9,
+		0x59, 0xf1, 0x41, 0xe9, 0x86, 0xbf, 0x06, 0x3b, 0x24, 0x81, 0x84, 0xf0, 0x03, 0x49, 0xe1, 0x27,
+		0xf1, 0xea, 0xd7, 0x29, 0x40, 0xae, 0xcb, 0x70, 0x18, 0x02, 0x7f, 0x09, 0xf8, 0x03, 0xae, 0x3b,
+		0x16, 0x8f, 0x9b, 0xe1, 0x7f, 0xba, 0x19, 0x48, 0x60, 0xa4, 0x2a, 0x91, 0xd9, 0x10, 0xe3, 0x16,
+		0xb6, 0x29, 0x96, 0x59, 0xa3, 0x25, 0x00, 0xa0, 0x25, 0x83, 0x05, 0xe4, 0x8f, 0x03, 0x09, 0xec,
+		0x87, 0x9b, 0x5a, 0xc2, 0x67, 0x8a, 0x38, 0xc7, 0x8c, 0xb6, 0x9e, 0x4e, 0x76, 0x41, 0x55, 0x9d,
+		0x99, 0xc6, 0xd8, 0x79, 0x99, 0xf5, 0x8c, 0xb1, 0x23, 0x1e, 0x7b, 0xdb, 0x3f, 0xe2, 0xb9, 0x3f,
+		0x33, 0x8d, 0x41, 0xf6, 0x6c, 0xcd, 0x4c, 0xc3, 0x72, 0xb4, 0xf9, 0xfc, 0x54, 0xdb, 0x9c, 0xc7,
+		0xf2, 0x8e, 0xea, 0xbb, 0xd9, 0x7c, 0x1e, 0x6c, 0x26, 0x71, 0x72, 0xbf, 0x8d, 0x9d, 0xf7, 0xda,
+		0x05, 0x6c, 0x8d, 0xd6, 0x69, 0x65, 0x19, 0xeb, 0xaf, 0xc8, 0xe6, 0xf0, 0x07, 0x60, 0xd3, 0x7e,
+		0x49, 0xd6, 0x1c, 0x19, 0xcb, 0x4b, 0xe3, 0xda, 0xd9, 0x98, 0xfa, 0x20, 0xd6, 0x6c, 0x4d, 0x2d,
+		0xb6, 0xd9, 0xda, 0xc6, 0xd4, 0xad, 0x58, 0x55, 0x4b, 0x7a, 0x2e, 0xca, 0xc6, 0xd0, 0x5e, 0x54,
+		0x55, 0x4d, 0x79, 0xcc, 0x71, 0x3b, 0x33, 0x7b, 0xce, 0xc5, 0xf6, 0x51, 0xdc, 0x77, 0xea, 0x68,
+		0x65, 0xac, 0x95, 0x6a, 0x42, 0x97, 0x96, 0xf0, 0x5f, 0xb6, 0xf3, 0xde, 0xd6, 0x36, 0xc3, 0x38,
+		0x7b, 0xde, 0xde, 0xb5, 0x17, 0xf5, 0xf4, 0x64, 0x3e, 0x3f, 0x3d, 0x3d, 0xd1, 0xc4, 0x04, 0x52,
+		0xbb, 0x13, 0xd1, 0x7b, 0x61, 0xdb, 0x07, 0x4d, 0x9a, 0xfa, 0xee, 0xf4, 0x35, 0x64, 0xa9, 0x7c,
+		0xdd, 0x38, 0xdd, 0x22, 0x78, 0xe0, 0xad, 0xa9, 0x21, 0x93, 0xbf, 0x66, 0x0e, 0x72, 0xb1, 0x7c,
+		0x7a, 0x7b, 0x37, 0x39, 0x66, 0xb1, 0xc7, 0xc0, 0xfd, 0x26, 0xb3, 0xd8, 0xc0, 0x67, 0x5c, 0x62,
+		0x03, 0x24, 0xd6, 0xf2, 0x99, 0x6c, 0xe2, 0x76, 0xcc, 0x61, 0x8f, 0x5b, 0xa1, 0x4e, 0x56, 0x06,
+		0x8d, 0xd6, 0x7f, 0x63, 0x26, 0xb1, 0x1f, 0x86, 0x2d, 0x4c, 0xef, 0x11, 0x5d, 0xbd, 0x4a, 0x12,
+		0x7b, 0x47, 0x28, 0xb4, 0x25, 0x1c, 0x00, 0x00, 0x00, 0xfe, 0x81, 0xbc, 0x08, 0x37, 0xeb, 0xb5,
+		0x78, 0xc1, 0x6b, 0x86, 0x16, 0xc9, 0x7e, 0xba, 0x22, 0x2b, 0xc2, 0xc3, 0x0e, 0x03, 0x4c, 0xf0,
+		0x0a, 0x71, 0xf2, 0x98, 0xbc, 0x7b, 0x89, 0xbc, 0x10, 0xb7, 0xf6, 0x8e, 0x75, 0x89, 0x25, 0x41,
+		0x4f, 0xdd, 0x97, 0x64, 0x68, 0x59, 0xe7, 0xd6, 0x8f, 0xb3, 0x2c, 0xdf, 0x3b, 0xab, 0x91, 0xfa,
+		0x86, 0x77, 0x83, 0x9f, 0x6b, 0xbf, 0xbe, 0xc1, 0x5b, 0x12, 0xf2, 0x4b, 0xce, 0x1b, 0xbe, 0xf4,
+		0xdd, 0x11, 0xfa, 0xb3, 0x87, 0x93, 0x93, 0xa4, 0x61, 0xad, 0x13, 0x25, 0xec, 0x59, 0xf6, 0x3e,
+		0x0c, 0x06, 0xc3, 0xd1, 0x60, 0x60, 0x8e, 0xce, 0x47, 0xe6, 0xd8, 0xb2, 0x7a, 0xc3, 0x5e, 0x0d,
+		0xd3, 0xf0, 0x37, 0xe6, 0x62, 0x86, 0xdd, 0x8f, 0x09, 0x66, 0x1a, 0x79, 0x9e, 0xd4, 0x54, 0x2f,
+		0x29, 0xf5, 0x39, 0x4a, 0x63, 0x4f, 0xcd, 0x5c, 0xc2, 0xc5, 0x03, 0x5e, 0xa3, 0x00, 0xf1, 0x07,
+		0x68, 0x03, 0x78, 0x96, 0x1c, 0x8b, 0x67, 0xdb, 0xa2, 0xc0, 0x59, 0x43, 0x7c, 0x4a, 0xc2, 0x76,
+		0xb4, 0xe0, 0x34, 0x3d, 0xb9, 0xfe, 0x44, 0x41, 0xf0, 0x79, 0x92, 0x38, 0x6e, 0xef, 0x50, 0x69,
+		0xc7, 0x56, 0xfd, 0xa7, 0xf6, 0x86, 0x49, 0x54, 0x83, 0x2f, 0xc1, 0x5d, 0x89, 0x17, 0x2a, 0xe5,
+		0x80, 0xf6, 0xc0, 0x40, 0x86, 0x93, 0x93, 0xf9, 0x73, 0x80, 0x19, 0xf1, 0xdd, 0xea, 0x02, 0x4c,
+		0xde, 0xac, 0xb9, 0x10, 0xf3, 0x25, 0x20, 0x6b, 0x9f, 0x1a, 0x09, 0x6e, 0x20, 0x7c, 0x81, 0xf0,
+		0x05, 0x0b, 0x9f, 0x72, 0x44, 0xe8, 0x41, 0x18, 0x68, 0xac, 0xcd, 0x98, 0x1d, 0x6a, 0x33, 0x7b,
+		0x30, 0xde, 0x5e, 0x89, 0x66, 0x1f, 0xdc, 0xb7, 0xaa, 0xd4, 0x10, 0xca, 0x31, 0x7b, 0x44, 0x5e,
+		0x73, 0xb5, 0x66, 0x67, 0xd9, 0xae, 0x62, 0x73, 0x2f, 0x48, 0xcc, 0xbc, 0xc0, 0xd2, 0x67, 0x80,
+		0x50, 0x97, 0x2c, 0xb6, 0x22, 0x06, 0x6b, 0x1c, 0x86, 0x68, 0x85, 0xc3, 0xea, 0xd1, 0x96, 0x28,
+		0xf2, 0x78, 0x6d, 0x98, 0x86, 0x3d, 0xb3, 0x7c, 0x73, 0x39, 0xf5, 0x65, 0x22, 0xf3, 0xbf, 0x28,
+		0x13, 0xd5, 0x2b, 0xa9, 0xad, 0xa2, 0xa4, 0x95, 0x25, 0xad, 0x30, 0x79, 0xa5, 0x75, 0x0b, 0x38,
+		0x8d, 0x19, 0xe0, 0x6e, 0xe5, 0x22, 0x42, 0xf9, 0x79, 0xbf, 0x6e, 0xd1, 0x52, 0x1e, 0x47, 0xba,
+		0xf2, 0x95, 0x49, 0xde, 0x46, 0xf9, 0xa6, 0x49, 0x9d, 0x6c, 0x32, 0xd7, 0x39, 0x5b, 0x91, 0xcf,
+		0x52, 0x5a, 0x24, 0x6d, 0x52, 0xc9, 0xda, 0x6e, 0xaa, 0x83, 0xfe, 0x78, 0x30, 0x1e, 0x8e, 0xfa,
+		0x63, 0xeb, 0xed, 0xcd, 0xb9, 0x63, 0x26, 0xe5, 0x7c, 0x8f, 0x88, 0xbd, 0xb7, 0xe5, 0xce, 0xea,
+		0x82, 0x67, 0x49, 0x00, 0xbf, 0xd9, 0xba, 0x6e, 0xc3, 0xb8, 0x38, 0x75, 0xa7, 0xc2, 0xb1, 0x2a,
+		0x9a, 0x2b, 0x7b, 0x70, 0xab, 0x60, 0x42, 0x12, 0x5e, 0xa3, 0x2f, 0xf8, 0xde, 0xf7, 0x0f, 0x8f,
+		0x8f, 0x22, 0x74, 0xa8, 0x2b, 0x15, 0xc8, 0xae, 0xc4, 0x8f, 0x3c, 0xc4, 0x0b, 0x95, 0xf8, 0x5f,
+		0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xf9, 0x97, 0xfa, 0x3c, 0x03, 0x22, 0x00, 0x00,
 	}
 )
 
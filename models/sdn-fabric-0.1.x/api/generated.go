@@ -5,14 +5,14 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.24.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/onf-switch@2022-05-25.yang
-	- /config-model/yang/onf-switch-model@2022-05-25.yang
-	- /config-model/yang/onf-route@2022-05-25.yang
-	- /config-model/yang/onf-dhcp-server@2022-05-25.yang
+	- yang/onf-switch@2022-05-25.yang
+	- yang/onf-switch-model@2022-05-25.yang
+	- yang/onf-route@2022-05-25.yang
+	- yang/onf-dhcp-server@2022-05-25.yang
 Imported modules were sourced from:
-	- "/config-model/yang"/...
+	- yang/...
 */
 package api
 
@@ -1330,371 +1330,378 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xff, 0x73, 0xda, 0xb8,
-		0xb6, 0xff, 0xbd, 0x7f, 0x85, 0x86, 0x79, 0x77, 0x06, 0xba, 0xb1, 0x63, 0x08, 0xa4, 0x0d, 0x33,
-		0x77, 0x7a, 0xb3, 0x6d, 0x77, 0x37, 0x6f, 0xd3, 0x34, 0xd3, 0xa6, 0x7b, 0xef, 0x5b, 0x4c, 0x33,
-		0x8a, 0x2d, 0x40, 0x2f, 0x46, 0xf6, 0x5a, 0x72, 0xd2, 0x6c, 0x92, 0xff, 0xfd, 0x8e, 0x65, 0x63,
-		0xcc, 0x37, 0x5b, 0xb2, 0x21, 0x71, 0x40, 0x33, 0x9d, 0x42, 0x40, 0x12, 0xb2, 0x74, 0xf4, 0x39,
-		0x5f, 0x75, 0xce, 0xfd, 0x2b, 0x00, 0x00, 0xa8, 0x9d, 0xc1, 0x31, 0xaa, 0x75, 0x41, 0xcd, 0x46,
-		0x37, 0xd8, 0x42, 0xb5, 0xbd, 0xe8, 0xd3, 0xdf, 0x31, 0xb1, 0x6b, 0x5d, 0xd0, 0x8c, 0xff, 0x7c,
-		0xef, 0x92, 0x01, 0x1e, 0xd6, 0xba, 0xc0, 0x88, 0x3f, 0xf8, 0x80, 0xfd, 0x5a, 0x17, 0x44, 0x43,
-		0xf0, 0x0f, 0xec, 0x91, 0xe5, 0x69, 0x14, 0xf9, 0x37, 0x68, 0xf6, 0x8b, 0xd9, 0xdf, 0x48, 0x35,
-		0xda, 0x9b, 0x6d, 0xf2, 0x01, 0x51, 0xcb, 0xc7, 0x1e, 0xc3, 0x2e, 0x09, 0x5b, 0x1e, 0x03, 0x07,
-		0x53, 0x06, 0xdc, 0x01, 0xf8, 0xf0, 0xdb, 0xfb, 0x73, 0xf0, 0x95, 0xf7, 0xa1, 0xf3, 0x9d, 0x66,
-		0x67, 0x99, 0x7c, 0x3c, 0x3f, 0xdb, 0xe4, 0x8b, 0x73, 0x1f, 0x0d, 0xf0, 0x8f, 0x85, 0xf9, 0x2d,
-		0xcc, 0x71, 0xee, 0x77, 0xf8, 0xf7, 0x5f, 0xdd, 0xc0, 0xb7, 0xd0, 0xd2, 0xbe, 0xd1, 0x5c, 0xd0,
-		0xdd, 0xad, 0xeb, 0x87, 0xd3, 0xa9, 0x79, 0xd1, 0xcf, 0xec, 0x2d, 0x6f, 0xf8, 0x1b, 0xa4, 0xc7,
-		0xfe, 0x30, 0x18, 0x23, 0xc2, 0x6a, 0x5d, 0xc0, 0xfc, 0x00, 0xad, 0x68, 0x98, 0x6a, 0x15, 0xcd,
-		0x6a, 0xa1, 0xd9, 0xe3, 0xcc, 0x27, 0x8f, 0xf3, 0x4b, 0x8a, 0xfd, 0xe5, 0x8f, 0x0a, 0x6d, 0xdb,
-		0x47, 0x94, 0xae, 0x7e, 0x96, 0xc9, 0x5a, 0x4c, 0x1a, 0xae, 0x98, 0xe0, 0xdc, 0x9e, 0x41, 0x02,
-		0xb0, 0x07, 0x72, 0xfa, 0xc4, 0x5b, 0x66, 0xac, 0xf8, 0x7a, 0xd5, 0xd6, 0x89, 0x6c, 0xa1, 0xe8,
-		0x56, 0x8a, 0x6e, 0xa9, 0xf4, 0xd6, 0x4a, 0x6f, 0xb1, 0xc4, 0x56, 0x2f, 0xdf, 0xf2, 0x15, 0x5b,
-		0x9f, 0x8c, 0xfb, 0x09, 0x12, 0x1b, 0x32, 0xd7, 0xbf, 0x5b, 0x3c, 0x25, 0x49, 0x9b, 0x8b, 0x3b,
-		0x0f, 0x89, 0xad, 0x27, 0xf6, 0x6e, 0xda, 0x5a, 0xbc, 0xbf, 0x1a, 0x71, 0xb5, 0xbf, 0x5d, 0x82,
-		0xb2, 0xd6, 0x77, 0x72, 0x3c, 0xdf, 0x66, 0xb4, 0x39, 0x87, 0x8c, 0x21, 0x3f, 0xa4, 0x9f, 0x5e,
-		0xf6, 0x12, 0xd5, 0xeb, 0x3d, 0x43, 0x3b, 0xea, 0x3f, 0xf4, 0x9a, 0xda, 0x51, 0x3f, 0x7a, 0xdb,
-		0xe4, 0x2f, 0xd1, 0xfb, 0x56, 0xcf, 0xd0, 0xda, 0x93, 0xf7, 0x9d, 0x9e, 0xa1, 0x75, 0xfa, 0x0d,
-		0xd3, 0xd4, 0x1b, 0xf7, 0x07, 0x8f, 0xf2, 0x1d, 0xeb, 0xff, 0xe8, 0x99, 0xa6, 0x77, 0x7f, 0xf6,
-		0x18, 0xfe, 0x7f, 0xfa, 0xd8, 0xff, 0xa9, 0xf1, 0x2e, 0x6f, 0xcb, 0xc3, 0x01, 0x4c, 0x53, 0xef,
-		0xbf, 0x5e, 0xbd, 0x75, 0xfd, 0x57, 0x62, 0x1b, 0xba, 0x64, 0x33, 0x6b, 0xf6, 0xcc, 0x51, 0xcb,
-		0x39, 0xb6, 0xe9, 0xc6, 0x62, 0x47, 0xd7, 0x71, 0xc9, 0x10, 0xa4, 0xba, 0x81, 0x01, 0x46, 0x8e,
-		0x5d, 0x85, 0x33, 0x3c, 0x60, 0x2f, 0xef, 0x04, 0x0f, 0xd8, 0xba, 0xce, 0xaf, 0xf8, 0xd9, 0xa4,
-		0xcc, 0xc7, 0x64, 0x58, 0xf6, 0x34, 0x9e, 0x22, 0x32, 0x64, 0xa3, 0xdc, 0xc3, 0x98, 0xbd, 0xda,
-		0x11, 0xf2, 0x60, 0x92, 0xbb, 0x2d, 0x49, 0xe3, 0x3f, 0xa0, 0x13, 0xa0, 0xd5, 0x10, 0xb5, 0xd0,
-		0xfe, 0x17, 0x1f, 0x5a, 0x21, 0x99, 0x7e, 0xc0, 0x43, 0xcc, 0xe8, 0x6a, 0x4a, 0x5b, 0x5c, 0x2d,
-		0x34, 0x84, 0x0c, 0xdf, 0x84, 0xbf, 0x35, 0x80, 0x0e, 0x45, 0xb9, 0xbd, 0x1e, 0xf7, 0x04, 0x1e,
-		0x15, 0xfe, 0x28, 0xf0, 0xa8, 0x46, 0xab, 0x5d, 0xbd, 0xa7, 0x7d, 0x55, 0xec, 0xdb, 0x52, 0xc8,
-		0x36, 0x95, 0x09, 0x35, 0x6c, 0x0b, 0x80, 0xdb, 0x6c, 0x7b, 0x31, 0x7c, 0xbb, 0x18, 0x21, 0x70,
-		0xf2, 0x21, 0x14, 0x27, 0xd9, 0x08, 0xa5, 0x45, 0x4a, 0x25, 0xa6, 0x54, 0x41, 0x4c, 0x11, 0x87,
-		0xb9, 0x3b, 0x48, 0x86, 0x1a, 0xb6, 0x11, 0x61, 0x78, 0x80, 0x57, 0x6e, 0x9f, 0xc2, 0xbb, 0xea,
-		0xe1, 0xdd, 0xdb, 0x76, 0xfb, 0xf0, 0x4d, 0xbb, 0x6d, 0xbc, 0x39, 0x78, 0x63, 0x1c, 0x75, 0x3a,
-		0xcd, 0xc3, 0x66, 0x67, 0x7b, 0xf0, 0x6f, 0x1d, 0x02, 0x6e, 0x0f, 0x6a, 0x7f, 0x1f, 0x6b, 0x7f,
-		0x5e, 0xf6, 0xe3, 0x37, 0x5c, 0xa0, 0xd4, 0x2e, 0x43, 0x91, 0x32, 0xe7, 0x58, 0xea, 0x0f, 0xba,
-		0xfe, 0xd0, 0xfb, 0xfe, 0xe3, 0x3f, 0x7d, 0xfd, 0xf5, 0x83, 0xde, 0xfb, 0x3e, 0xfe, 0xc4, 0xdf,
-		0xe8, 0xbd, 0xef, 0xce, 0x69, 0x5f, 0xdf, 0x94, 0x44, 0x8a, 0xa9, 0xe7, 0xc0, 0x3b, 0x8d, 0x44,
-		0x27, 0x33, 0x0f, 0xb5, 0xd3, 0xad, 0xc5, 0x30, 0x3b, 0xee, 0x02, 0xc2, 0x2e, 0x80, 0xb9, 0x20,
-		0xa0, 0x08, 0x60, 0x02, 0x7e, 0xfd, 0x76, 0x02, 0x5c, 0x1f, 0xbc, 0x3f, 0x3d, 0x51, 0xe2, 0xa9,
-		0x12, 0x4f, 0x15, 0x5c, 0x6f, 0x06, 0xae, 0xdf, 0x1a, 0xbb, 0x27, 0x9c, 0x66, 0x1a, 0xd3, 0x7e,
-		0x47, 0x77, 0xb9, 0xd2, 0x67, 0xed, 0x14, 0x53, 0x76, 0xcc, 0xd8, 0x0a, 0xb3, 0xdb, 0x27, 0x4c,
-		0x3e, 0x3a, 0x28, 0x3c, 0x85, 0x2b, 0xd6, 0x29, 0xdc, 0xaa, 0x54, 0x0b, 0x31, 0x96, 0x59, 0xfb,
-		0xec, 0xdb, 0xc8, 0x47, 0xf6, 0xcf, 0xe1, 0xfc, 0x48, 0xe0, 0x38, 0x99, 0x8f, 0x71, 0x4c, 0x88,
-		0xcb, 0xe0, 0x4a, 0x33, 0x42, 0x8d, 0x5a, 0x23, 0x34, 0x86, 0x1e, 0xe4, 0xa7, 0xac, 0xb6, 0xef,
-		0x92, 0x81, 0x96, 0x7a, 0xe4, 0xfd, 0xd5, 0x06, 0xdc, 0xa8, 0x37, 0xf3, 0x03, 0x8b, 0xc5, 0x1c,
-		0xa1, 0xf6, 0x99, 0x0c, 0x3e, 0x8c, 0x2c, 0x2f, 0x12, 0xb4, 0x2f, 0xa7, 0x6f, 0x67, 0xd1, 0x67,
-		0xba, 0xec, 0xa9, 0xb9, 0xd6, 0x7c, 0x37, 0x60, 0x68, 0xb5, 0x21, 0x39, 0xfa, 0x5a, 0xd4, 0x84,
-		0xcc, 0x5b, 0x3f, 0x89, 0xf1, 0xd8, 0x67, 0xd5, 0x33, 0x1d, 0xfb, 0xac, 0xea, 0x86, 0xe3, 0x93,
-		0xf3, 0x89, 0xd5, 0x38, 0xdc, 0xad, 0x91, 0xeb, 0x55, 0x81, 0xb3, 0xfb, 0x2f, 0x90, 0xb3, 0xfb,
-		0xac, 0xaa, 0x86, 0xe3, 0x22, 0x66, 0xe3, 0xa3, 0x8c, 0x36, 0xf1, 0x6f, 0x97, 0x96, 0x03, 0x0a,
-		0x9a, 0xb6, 0x65, 0xa4, 0x16, 0x69, 0x4d, 0xe0, 0x85, 0x98, 0xbc, 0x65, 0x4c, 0xdf, 0xd9, 0xbc,
-		0x58, 0x50, 0x9a, 0x91, 0xdb, 0xce, 0xc3, 0x0a, 0x6e, 0x67, 0xf7, 0x21, 0x5c, 0x2b, 0xa8, 0x0d,
-		0x8e, 0xb5, 0x5f, 0xfa, 0xf7, 0xc6, 0x5e, 0xfb, 0xb1, 0xd1, 0x6d, 0xd4, 0xe7, 0x3f, 0xeb, 0x36,
-		0xee, 0x8d, 0xbd, 0xce, 0x63, 0xbd, 0xbe, 0xe4, 0x9b, 0x77, 0xcb, 0xc6, 0x68, 0x3c, 0xd4, 0xeb,
-		0xf5, 0x78, 0x23, 0x67, 0x36, 0xb7, 0x67, 0x34, 0xfb, 0xef, 0xf8, 0xdb, 0xe8, 0xff, 0x84, 0x3c,
-		0x84, 0x1a, 0x37, 0xca, 0x10, 0x45, 0xbd, 0xde, 0xfb, 0xde, 0xed, 0xff, 0xd4, 0x6d, 0xdc, 0x1f,
-		0x3e, 0x4e, 0xde, 0xf3, 0xff, 0x1b, 0x0f, 0x75, 0xfd, 0xb5, 0x69, 0xea, 0xfa, 0xeb, 0x46, 0x34,
-		0xf1, 0xb8, 0xdd, 0xeb, 0xe8, 0xdb, 0x77, 0xdd, 0xee, 0xc2, 0x47, 0x8d, 0xfa, 0x3f, 0x74, 0x49,
-		0x7a, 0x8c, 0xd6, 0xa7, 0xbb, 0x1e, 0xb2, 0x54, 0x1e, 0x1d, 0xa5, 0x32, 0x2b, 0x95, 0x59, 0xa9,
-		0xcc, 0xca, 0xa3, 0xa3, 0x2c, 0x83, 0x0a, 0xe6, 0x14, 0xcc, 0x29, 0xcb, 0xa0, 0x02, 0xb9, 0x31,
-		0x62, 0x3e, 0xb6, 0xf2, 0xe1, 0x2d, 0x6e, 0x27, 0x06, 0x6c, 0x9f, 0x78, 0x63, 0x40, 0x3d, 0x64,
-		0xe1, 0x01, 0x46, 0x94, 0x7b, 0xab, 0x3d, 0x1f, 0xbb, 0x3e, 0x66, 0x77, 0xca, 0x28, 0xb2, 0x5d,
-		0x46, 0x91, 0x00, 0x13, 0xf6, 0x56, 0x00, 0xf7, 0x32, 0xdc, 0xa4, 0xb5, 0x2f, 0x90, 0x0c, 0xd1,
-		0xb3, 0xa1, 0x9e, 0xb1, 0x3b, 0xa8, 0xd7, 0xea, 0x74, 0x14, 0xec, 0x81, 0xe4, 0xbc, 0xe6, 0xc2,
-		0x5e, 0xb6, 0x1d, 0x7b, 0x0e, 0xf6, 0x68, 0x70, 0x45, 0x10, 0x0b, 0x25, 0xb9, 0x31, 0x64, 0xd6,
-		0x08, 0x78, 0xd0, 0xba, 0x46, 0x4c, 0xe1, 0xdd, 0xd6, 0x19, 0x81, 0x73, 0x9f, 0xfe, 0xf9, 0x6c,
-		0xbf, 0x42, 0x1b, 0xb3, 0x5d, 0x26, 0xdf, 0xfd, 0xf8, 0xc7, 0x1a, 0x0f, 0xf5, 0x5e, 0x53, 0x6b,
-		0xf5, 0x27, 0x7f, 0x1c, 0xf4, 0x0c, 0xad, 0xd5, 0x6f, 0x34, 0x2a, 0x66, 0xce, 0xad, 0xcc, 0x0e,
-		0xbd, 0x38, 0x2b, 0x6e, 0x7a, 0xa3, 0xc3, 0xd7, 0xfb, 0xd6, 0x63, 0xe3, 0xa1, 0x1e, 0x92, 0x47,
-		0x33, 0xd9, 0xf4, 0x66, 0x38, 0xc8, 0xdb, 0xb0, 0xf9, 0xf3, 0x58, 0x78, 0xf7, 0xf5, 0x9f, 0x1a,
-		0xd5, 0x34, 0xd4, 0x72, 0xef, 0xb1, 0x50, 0x68, 0x6a, 0xd2, 0xb2, 0x48, 0x50, 0xea, 0x32, 0x97,
-		0xb6, 0xe2, 0x79, 0x4f, 0xce, 0xf3, 0x54, 0x28, 0xea, 0xf6, 0x1b, 0x6a, 0x55, 0x28, 0xea, 0x0b,
-		0x0e, 0x45, 0x15, 0x89, 0xd2, 0x5a, 0x01, 0xc4, 0xdb, 0x19, 0x9f, 0xc5, 0x1f, 0x76, 0x7f, 0x15,
-		0xfb, 0x58, 0x8c, 0xc9, 0xfa, 0x12, 0xb6, 0xbc, 0xe4, 0xff, 0x8b, 0x44, 0x62, 0xd1, 0x5b, 0xcc,
-		0xac, 0xd1, 0xea, 0x50, 0xac, 0xf8, 0xfb, 0xbc, 0x58, 0xac, 0x31, 0x24, 0x70, 0x88, 0x6c, 0x10,
-		0x5d, 0x33, 0x06, 0x98, 0x70, 0xa6, 0x37, 0x80, 0x57, 0x8b, 0xd6, 0xb1, 0x8d, 0x44, 0x66, 0xd1,
-		0xdb, 0xea, 0x45, 0x66, 0xd1, 0xdb, 0xf5, 0x45, 0x66, 0x31, 0xe6, 0xe3, 0xab, 0x65, 0x31, 0x73,
-		0x0b, 0x2b, 0x31, 0x6d, 0x2a, 0x78, 0xad, 0x17, 0x8c, 0xa1, 0x17, 0x4a, 0x29, 0xe8, 0x07, 0xf3,
-		0x21, 0x48, 0xba, 0xd3, 0x2e, 0x88, 0x6c, 0xf7, 0x5a, 0xa6, 0x09, 0x7f, 0xc5, 0x6e, 0x2a, 0xa7,
-		0xcb, 0xfa, 0x9d, 0x2e, 0xab, 0xa8, 0x63, 0x91, 0x4a, 0xb4, 0x6b, 0x0e, 0x94, 0x39, 0xeb, 0xb0,
-		0x40, 0x31, 0xbc, 0x5b, 0xce, 0xa3, 0xcd, 0x51, 0x4f, 0x78, 0xca, 0xaf, 0xd1, 0x5d, 0x78, 0xe0,
-		0x13, 0x42, 0x9a, 0x92, 0x50, 0xde, 0x60, 0xd9, 0x72, 0xaf, 0x30, 0x0d, 0xc9, 0xd0, 0x92, 0x1c,
-		0x4d, 0xc9, 0xd2, 0x56, 0x61, 0x1a, 0x2b, 0x4c, 0x6b, 0xd2, 0x34, 0x27, 0x28, 0x81, 0xe4, 0xac,
-		0x74, 0xae, 0x1c, 0x5d, 0x42, 0x9e, 0x2e, 0xa4, 0xe8, 0x0b, 0xca, 0xd7, 0xe2, 0x72, 0x76, 0x21,
-		0x79, 0xbb, 0xa8, 0xdc, 0x5d, 0x5a, 0x02, 0x2d, 0x2e, 0x89, 0x4a, 0xc8, 0xe3, 0x85, 0xe4, 0xf2,
-		0x85, 0x25, 0x69, 0x1b, 0x2f, 0x67, 0x4d, 0x5e, 0xad, 0xa7, 0x55, 0x7f, 0x13, 0x96, 0xaa, 0xc2,
-		0x52, 0x7c, 0x79, 0x69, 0xbe, 0xac, 0xbd, 0x26, 0x83, 0xd6, 0x6a, 0x37, 0x31, 0x99, 0x08, 0x32,
-		0xaf, 0xa8, 0xb9, 0x3c, 0xd3, 0xe2, 0xfd, 0x66, 0xb8, 0x95, 0x08, 0xf7, 0x53, 0x0c, 0xeb, 0xa5,
-		0x32, 0x2c, 0x01, 0x67, 0x47, 0x09, 0xe6, 0x96, 0x1b, 0xe5, 0xa2, 0x78, 0xda, 0x56, 0xf3, 0xb4,
-		0x96, 0xb1, 0x83, 0x4c, 0xad, 0x28, 0xf8, 0x4b, 0x29, 0x3d, 0xb1, 0xc9, 0x47, 0x04, 0xa5, 0xb3,
-		0xed, 0x3f, 0xe2, 0x76, 0xa0, 0x52, 0xf6, 0x20, 0x31, 0xbb, 0x50, 0xde, 0x53, 0xe7, 0xd8, 0x89,
-		0xb2, 0xec, 0x45, 0x91, 0xc1, 0x66, 0x3f, 0x7e, 0xc9, 0xb3, 0x06, 0x2c, 0xb7, 0x21, 0x7d, 0xe5,
-		0x9d, 0x2f, 0xe3, 0x97, 0xe3, 0x64, 0x0c, 0x15, 0x0f, 0xaf, 0x6c, 0x16, 0x2a, 0x50, 0x54, 0xb9,
-		0x59, 0x54, 0x3c, 0x7c, 0x1a, 0xd9, 0x54, 0x3c, 0xbc, 0x82, 0x39, 0x05, 0x73, 0x5b, 0x0a, 0x73,
-		0x2a, 0x1e, 0x9e, 0x4f, 0x32, 0xf2, 0xef, 0xc5, 0x07, 0x27, 0x2f, 0x26, 0x7e, 0xda, 0x56, 0x0c,
-		0xe0, 0x2c, 0x0e, 0x44, 0x81, 0xcf, 0x05, 0xde, 0x49, 0xc0, 0xcc, 0x74, 0x14, 0xe0, 0xb9, 0x3e,
-		0xab, 0x82, 0xfb, 0x69, 0xa9, 0x93, 0xb1, 0xe2, 0x18, 0xb7, 0xcc, 0x09, 0x59, 0x0c, 0xe3, 0xf2,
-		0xdd, 0x4f, 0x39, 0xe9, 0x23, 0x16, 0xd6, 0x33, 0x3b, 0x8d, 0xc4, 0x2a, 0x6a, 0xb9, 0x98, 0x25,
-		0x8e, 0x93, 0xf3, 0x9b, 0xf6, 0x34, 0xbf, 0x84, 0x0f, 0x46, 0x2e, 0x65, 0xe1, 0x2b, 0x45, 0x3e,
-		0x77, 0x45, 0x67, 0x30, 0xda, 0x4a, 0x99, 0xf5, 0x32, 0x89, 0xeb, 0x85, 0x9a, 0xf5, 0xb2, 0x88,
-		0x6f, 0x3d, 0x66, 0x3d, 0x79, 0x53, 0x9d, 0xed, 0x8e, 0x21, 0x26, 0x9a, 0x00, 0x59, 0x28, 0x7b,
-		0xdd, 0xf6, 0xda, 0xeb, 0x3a, 0x07, 0xca, 0x09, 0xb5, 0x0c, 0xb8, 0xe4, 0xc3, 0xa5, 0xeb, 0x53,
-		0xf7, 0xd3, 0x65, 0xbf, 0x3e, 0xeb, 0x8b, 0xea, 0x37, 0xee, 0x8d, 0xbd, 0xc3, 0xe6, 0x63, 0xe3,
-		0xdd, 0xf4, 0xf3, 0xbe, 0x69, 0xea, 0x8d, 0xd7, 0x45, 0x7a, 0xbd, 0x6b, 0x3c, 0x98, 0xa6, 0xfe,
-		0x3c, 0x5e, 0xa9, 0x50, 0x04, 0xd1, 0x48, 0x30, 0xbe, 0x42, 0xbe, 0x38, 0x7f, 0x4b, 0x77, 0x2a,
-		0xc4, 0xe3, 0x52, 0xf2, 0x0f, 0x10, 0x1b, 0x47, 0x71, 0xb2, 0xdd, 0xe1, 0x64, 0xe2, 0xe4, 0x95,
-		0x26, 0x8d, 0x43, 0x81, 0xa6, 0x62, 0xf7, 0x0e, 0x9f, 0x9a, 0x8f, 0x19, 0x8a, 0x8f, 0xcd, 0x2f,
-		0xc9, 0x61, 0xa7, 0x73, 0xd0, 0x51, 0x9e, 0xa7, 0x8d, 0x78, 0x9e, 0xd6, 0xe6, 0x83, 0xc9, 0xd5,
-		0x87, 0x45, 0x9c, 0x30, 0x9f, 0xa6, 0x83, 0x94, 0x51, 0xe3, 0x5d, 0x1b, 0x39, 0x42, 0x97, 0x5d,
-		0x92, 0x96, 0x82, 0xfe, 0x17, 0x4c, 0xae, 0x01, 0x73, 0x41, 0xf4, 0xc8, 0x80, 0xf7, 0xae, 0x82,
-		0x59, 0x72, 0xb7, 0x55, 0xf6, 0xf5, 0xde, 0xf0, 0x74, 0x10, 0x1c, 0xf8, 0x68, 0x20, 0x62, 0xbb,
-		0x7c, 0x93, 0x7d, 0x4d, 0x21, 0x3a, 0x29, 0x74, 0xdc, 0x8d, 0xc8, 0x45, 0xe3, 0xe4, 0x32, 0xff,
-		0x77, 0x48, 0x7c, 0x65, 0xae, 0x32, 0xbb, 0xbe, 0x80, 0xad, 0x2a, 0xcb, 0xb4, 0xb4, 0x10, 0xe4,
-		0xce, 0xe5, 0x30, 0x1e, 0xe9, 0xba, 0x34, 0x2a, 0x5e, 0xd9, 0xa4, 0xaa, 0x67, 0x93, 0xb2, 0xe0,
-		0x10, 0x49, 0xcb, 0xed, 0xe9, 0x4e, 0x72, 0x72, 0xbb, 0x8f, 0x06, 0xc8, 0x47, 0xc4, 0xe2, 0x7e,
-		0x1a, 0x36, 0x42, 0x20, 0x35, 0xd4, 0xc4, 0xb0, 0x39, 0xa1, 0xa2, 0xf0, 0xbd, 0x00, 0x5c, 0x2a,
-		0xd1, 0x7e, 0x07, 0x45, 0xfb, 0x7c, 0xa8, 0x95, 0x81, 0x5c, 0x21, 0xe8, 0x0d, 0xc9, 0x32, 0x7c,
-		0x4d, 0x13, 0x7f, 0xa9, 0xa7, 0xe6, 0xf7, 0x49, 0xb4, 0x80, 0x50, 0x06, 0xaf, 0x1c, 0xc1, 0xe7,
-		0x1f, 0x07, 0x94, 0x6d, 0x42, 0xef, 0x48, 0xe8, 0x17, 0x31, 0xcd, 0x72, 0x09, 0x83, 0x98, 0xd0,
-		0xfa, 0x7e, 0x7a, 0x09, 0x7a, 0xff, 0x9a, 0xe3, 0x3d, 0xff, 0xfc, 0x1f, 0x36, 0xc2, 0x74, 0x5f,
-		0xd7, 0xc3, 0x7f, 0x93, 0x0f, 0xfb, 0xfb, 0x7c, 0x99, 0xfe, 0x95, 0x5a, 0xa4, 0x3d, 0xa0, 0x8b,
-		0xde, 0xa9, 0x5e, 0x82, 0x18, 0x92, 0x72, 0x7f, 0x9a, 0x6d, 0xcd, 0x60, 0x4b, 0xb8, 0x70, 0xe0,
-		0x0a, 0x81, 0x81, 0xef, 0x8e, 0x23, 0xe0, 0x71, 0x7d, 0x1f, 0x51, 0xcf, 0x25, 0x36, 0x26, 0x43,
-		0x90, 0x7e, 0xb6, 0x9a, 0xa4, 0xb8, 0x2f, 0x79, 0x9a, 0x57, 0x9d, 0xec, 0xfc, 0x98, 0x9e, 0x8d,
-		0x1c, 0xf5, 0x95, 0xc7, 0xbe, 0xd4, 0x12, 0x4a, 0xfd, 0xf4, 0xe3, 0x46, 0xf4, 0xc6, 0x8f, 0xbe,
-		0xef, 0xfa, 0x9f, 0x10, 0xa5, 0x70, 0x88, 0x36, 0x40, 0x48, 0x9e, 0x8f, 0x28, 0x22, 0x9c, 0x51,
-		0xad, 0x5e, 0x87, 0xfd, 0x0c, 0xf1, 0x69, 0xd3, 0xf4, 0x84, 0xc2, 0xe7, 0xd7, 0xc6, 0xf1, 0x02,
-		0x54, 0x9a, 0xa2, 0x24, 0xd6, 0x72, 0x53, 0x84, 0xf5, 0xdc, 0x9a, 0x77, 0x86, 0x8c, 0x6a, 0x8d,
-		0x20, 0x21, 0xc8, 0x91, 0x97, 0xd2, 0x66, 0xfb, 0x95, 0x15, 0xd4, 0x66, 0x46, 0xcb, 0x93, 0xd5,
-		0x74, 0x93, 0x5c, 0x24, 0x97, 0x08, 0x2c, 0x48, 0x88, 0xcb, 0x00, 0xfa, 0x61, 0x21, 0x64, 0xc7,
-		0xbe, 0xeb, 0x1f, 0x5a, 0x3c, 0x20, 0x9d, 0x0c, 0x35, 0xbb, 0xf3, 0x8b, 0x03, 0x6b, 0x4a, 0x08,
-		0x54, 0x42, 0x20, 0x90, 0xc9, 0x20, 0x37, 0x4f, 0x14, 0x1d, 0x65, 0xd9, 0xdd, 0x1e, 0xcb, 0x6e,
-		0xf3, 0x50, 0x99, 0x75, 0x5f, 0xbc, 0xba, 0x11, 0x31, 0x93, 0xba, 0xde, 0x00, 0x66, 0x60, 0x18,
-		0x07, 0xd6, 0x3f, 0x63, 0x2f, 0x5e, 0x71, 0xd5, 0xa3, 0x97, 0x56, 0x3d, 0xa6, 0xed, 0xd2, 0x9f,
-		0xf6, 0xf7, 0x53, 0xec, 0xe7, 0xb9, 0x54, 0x93, 0x59, 0x6e, 0x3a, 0x11, 0x84, 0xd0, 0x5f, 0x01,
-		0x74, 0x80, 0xeb, 0x03, 0x07, 0x51, 0x0a, 0xd8, 0x08, 0x92, 0x34, 0xab, 0xac, 0xae, 0xac, 0x59,
-		0x4d, 0xdd, 0x65, 0x53, 0x6b, 0xbc, 0x65, 0xca, 0xcd, 0xec, 0x32, 0x45, 0x52, 0x1a, 0x9d, 0x59,
-		0x13, 0x77, 0xa0, 0x74, 0x9c, 0x12, 0x94, 0x57, 0x70, 0x49, 0x77, 0x50, 0xd5, 0xb1, 0x25, 0x00,
-		0x56, 0xe2, 0x32, 0xd4, 0x4a, 0xa7, 0x9c, 0xcc, 0xa5, 0xa8, 0x4a, 0xa9, 0x18, 0xea, 0x8e, 0xf3,
-		0xd3, 0xa8, 0x18, 0xea, 0xde, 0xf2, 0x8e, 0x6b, 0x19, 0xc2, 0x97, 0xb8, 0x76, 0x47, 0xcf, 0x28,
-		0x86, 0xec, 0x23, 0xcb, 0xd3, 0x2c, 0x97, 0x10, 0x64, 0x31, 0xcd, 0x73, 0x71, 0xc6, 0x3d, 0x89,
-		0x45, 0x80, 0x5f, 0xec, 0x2b, 0x87, 0xf3, 0x5f, 0xd2, 0xc6, 0x2c, 0x5e, 0xfd, 0x3e, 0x1e, 0x0d,
-		0x08, 0x8d, 0xa6, 0x6c, 0x4a, 0xbb, 0x63, 0x53, 0xda, 0xb4, 0x63, 0x31, 0xa4, 0xe5, 0xee, 0x7c,
-		0x49, 0xd9, 0xee, 0x5c, 0x59, 0xdd, 0x52, 0xcf, 0x2c, 0x74, 0xc9, 0x3f, 0xcd, 0x30, 0xc4, 0x2e,
-		0xfb, 0xa7, 0xf1, 0xb4, 0xf0, 0xa5, 0xff, 0x64, 0x10, 0xa1, 0xcb, 0xff, 0x25, 0x11, 0x47, 0xe4,
-		0xda, 0xe9, 0x22, 0xd6, 0xe4, 0x5f, 0x3f, 0x5d, 0x85, 0x32, 0x45, 0xaf, 0xa1, 0x2a, 0xc1, 0x52,
-		0x09, 0x96, 0x4a, 0xb0, 0xdc, 0x31, 0xc1, 0xf2, 0xad, 0xca, 0x87, 0xb3, 0x0e, 0x90, 0xa7, 0x1e,
-		0x42, 0xb6, 0x38, 0xba, 0x47, 0xcd, 0xe5, 0x60, 0x7d, 0x72, 0xf9, 0x16, 0xd9, 0x91, 0x53, 0x52,
-		0x68, 0x0c, 0x25, 0x32, 0xbe, 0x54, 0x91, 0x71, 0xb3, 0x79, 0xd0, 0xa2, 0xfc, 0x9e, 0xec, 0x4e,
-		0x52, 0xc4, 0x14, 0x11, 0xa9, 0x4e, 0xe2, 0xa1, 0x7f, 0x86, 0x54, 0x62, 0x3b, 0x65, 0x4e, 0xc6,
-		0x2c, 0x88, 0x51, 0x61, 0x36, 0x23, 0xc7, 0x6a, 0x16, 0xa7, 0xa5, 0x35, 0x0d, 0x63, 0x58, 0xdb,
-		0x04, 0x86, 0x97, 0x9c, 0x55, 0x15, 0x27, 0x55, 0xc1, 0x39, 0xb5, 0xb4, 0x4e, 0x15, 0x67, 0x55,
-		0xc5, 0x49, 0xb5, 0x2b, 0x49, 0xe9, 0xed, 0x2a, 0x4e, 0xaa, 0x8a, 0xdb, 0x07, 0x03, 0xe6, 0x12,
-		0x24, 0x33, 0x31, 0xa1, 0x96, 0xfd, 0x0d, 0xf3, 0xbc, 0x2a, 0x86, 0x06, 0x94, 0x8f, 0x42, 0x16,
-		0x0a, 0x05, 0xe0, 0xfb, 0x46, 0xf7, 0xe2, 0x54, 0xfe, 0x75, 0xde, 0xaa, 0xf1, 0x4c, 0x41, 0x01,
-		0x7c, 0x2e, 0x2a, 0x52, 0x59, 0x5a, 0x28, 0x2c, 0xb8, 0x78, 0xdb, 0xe5, 0xc6, 0x9f, 0x7d, 0x7e,
-		0x15, 0x9d, 0xbc, 0x0e, 0x2a, 0x52, 0x71, 0xc9, 0x99, 0xba, 0x37, 0x83, 0x4c, 0xc2, 0xb2, 0x1a,
-		0x35, 0x97, 0xd3, 0xbd, 0x3f, 0x7b, 0x80, 0x77, 0x93, 0x2f, 0x99, 0xd1, 0x14, 0xd5, 0xbc, 0x5b,
-		0x4a, 0xf3, 0xae, 0x88, 0xe6, 0x9d, 0x77, 0x57, 0x31, 0x69, 0x08, 0xed, 0x31, 0x26, 0x5a, 0x48,
-		0x18, 0x01, 0x95, 0xd7, 0x74, 0x67, 0x7a, 0x0b, 0x2e, 0xc4, 0x92, 0xec, 0x23, 0x36, 0xa2, 0xd8,
-		0x47, 0x76, 0x4c, 0x9f, 0x71, 0x0c, 0x3b, 0x26, 0x0c, 0xf9, 0x03, 0x68, 0x21, 0x1d, 0x80, 0x13,
-		0x02, 0xbe, 0xfc, 0xf2, 0x1e, 0xbc, 0x69, 0xb5, 0x0e, 0x40, 0x28, 0x55, 0x00, 0x07, 0xc1, 0x81,
-		0x49, 0x46, 0x30, 0x2a, 0x6b, 0x4e, 0xe1, 0x18, 0x01, 0x1f, 0x41, 0x1b, 0x50, 0x34, 0x86, 0x84,
-		0x61, 0x8b, 0x02, 0x48, 0x01, 0x1e, 0x1c, 0x87, 0xf3, 0xfb, 0xca, 0xa7, 0xa7, 0x03, 0xf0, 0x1b,
-		0xf2, 0xd1, 0x1e, 0xc0, 0xcc, 0x24, 0x3e, 0x1a, 0x38, 0xc8, 0x62, 0x51, 0x6f, 0xfe, 0x10, 0x98,
-		0x32, 0x9f, 0x9b, 0xf4, 0x26, 0x87, 0x84, 0x02, 0x8a, 0x18, 0xb8, 0xba, 0x03, 0x88, 0xc0, 0x2b,
-		0x27, 0x04, 0x2a, 0xd7, 0x37, 0x89, 0x8d, 0x69, 0xfc, 0xd7, 0xec, 0x14, 0x45, 0x1f, 0x5e, 0xcc,
-		0x96, 0x25, 0x6d, 0xd3, 0x2a, 0x72, 0xc2, 0x16, 0x36, 0xd3, 0xb5, 0x34, 0x3c, 0x90, 0x91, 0xd6,
-		0x0a, 0xb2, 0xaf, 0xc2, 0x87, 0x6f, 0x6d, 0xfc, 0x6a, 0xe6, 0x30, 0x46, 0x8f, 0xbd, 0x6e, 0x2e,
-		0x22, 0xb8, 0x5f, 0x12, 0x16, 0x32, 0x79, 0x4b, 0xd9, 0xc2, 0x0e, 0x23, 0x12, 0x8c, 0x51, 0x94,
-		0xf9, 0x50, 0x66, 0x9f, 0x27, 0x6c, 0xa0, 0x2d, 0x23, 0x8d, 0x91, 0x60, 0x2c, 0x4f, 0x19, 0x17,
-		0xee, 0xd7, 0xc8, 0x97, 0x53, 0x48, 0x24, 0x32, 0xc2, 0x67, 0xfc, 0x76, 0x5e, 0x44, 0x02, 0x6a,
-		0x86, 0x5d, 0x3f, 0x7c, 0xfe, 0xf7, 0x59, 0x91, 0xce, 0x2d, 0x8e, 0x63, 0x1f, 0xbf, 0x5e, 0x9c,
-		0x9c, 0xfd, 0x2a, 0x29, 0xbc, 0xec, 0xc9, 0xae, 0xcf, 0x89, 0x40, 0xb0, 0xc7, 0x72, 0xd8, 0x0d,
-		0x1f, 0x4e, 0xda, 0x7d, 0x14, 0xfd, 0x6a, 0xfc, 0x68, 0xb9, 0x0c, 0x7e, 0x69, 0xef, 0x6f, 0xe7,
-		0x21, 0x7e, 0x3d, 0xaf, 0x4c, 0x27, 0x78, 0xb2, 0x0a, 0xe8, 0xee, 0x49, 0xdf, 0xe4, 0x92, 0x97,
-		0x94, 0x29, 0x57, 0xde, 0x4a, 0x32, 0x73, 0xa0, 0x43, 0xa6, 0xd8, 0x7a, 0x7b, 0x78, 0xd0, 0x05,
-		0xbc, 0x00, 0xf0, 0x84, 0x1d, 0x51, 0xf0, 0xab, 0xef, 0x06, 0x1e, 0xf8, 0x74, 0xf2, 0x33, 0xd0,
-		0x66, 0xd9, 0x60, 0x11, 0xfa, 0x2e, 0xa1, 0xa6, 0xcc, 0xe3, 0xfd, 0x74, 0x95, 0xf6, 0x8a, 0x8d,
-		0xb5, 0x0e, 0x55, 0x65, 0x81, 0x05, 0x14, 0x58, 0x46, 0xe9, 0x1f, 0x7d, 0xdc, 0xd0, 0x09, 0xe8,
-		0xaf, 0x49, 0xab, 0x11, 0x49, 0x6a, 0x8c, 0x07, 0x98, 0xd8, 0xe8, 0x87, 0xbc, 0x9c, 0x38, 0xe9,
-		0x58, 0x4c, 0x44, 0xfc, 0x7a, 0x47, 0x19, 0x1a, 0x03, 0x48, 0x29, 0x1e, 0x12, 0x64, 0xc7, 0xf7,
-		0x5a, 0xc0, 0xc0, 0xf5, 0x01, 0x82, 0xd6, 0x68, 0x46, 0x52, 0x7c, 0x9f, 0xe8, 0x96, 0x14, 0x30,
-		0xd7, 0x24, 0x78, 0x70, 0x12, 0xfe, 0x32, 0x70, 0xaf, 0xfe, 0x1f, 0x59, 0x5c, 0xfb, 0xfc, 0x7a,
-		0xf6, 0xe9, 0x7c, 0xba, 0xc7, 0xe1, 0xe6, 0x2a, 0xe1, 0x4d, 0x09, 0x6f, 0x45, 0xd8, 0x45, 0x71,
-		0x41, 0x2c, 0xc0, 0x84, 0x1d, 0xb4, 0x0a, 0xc8, 0x60, 0x6f, 0x24, 0xba, 0xc8, 0x5d, 0xbf, 0x2c,
-		0xc1, 0x8d, 0x8a, 0xc4, 0xb3, 0x24, 0x9d, 0x0b, 0x5e, 0xcb, 0x4c, 0xfa, 0x97, 0x8d, 0xe5, 0x98,
-		0xee, 0x4d, 0xd1, 0x98, 0x8e, 0x82, 0xc2, 0x1c, 0x28, 0x1a, 0xf7, 0xb2, 0xb0, 0x74, 0xed, 0xd6,
-		0x51, 0xfb, 0xe8, 0xf0, 0x4d, 0xeb, 0xa8, 0xf3, 0xf2, 0xd7, 0xf0, 0x79, 0xb9, 0xe4, 0xb6, 0xcb,
-		0x89, 0x40, 0x5b, 0x29, 0xe1, 0x28, 0xc1, 0x30, 0x43, 0x30, 0xcc, 0x5a, 0xb7, 0x9d, 0x94, 0x04,
-		0x1d, 0x48, 0x19, 0xbf, 0x7f, 0x37, 0x2c, 0x10, 0x1f, 0x93, 0xee, 0x5c, 0xd4, 0x68, 0x88, 0x29,
-		0x60, 0x78, 0x8c, 0x28, 0x83, 0x63, 0x0f, 0x60, 0x62, 0x63, 0x0b, 0x32, 0x14, 0xd9, 0xf3, 0xc2,
-		0xcf, 0x27, 0x46, 0xc4, 0xf0, 0xa7, 0x62, 0x8b, 0x5e, 0xf4, 0x83, 0x26, 0x99, 0x37, 0x2f, 0x82,
-		0x3a, 0xd2, 0x87, 0xfa, 0x1e, 0x08, 0x3c, 0x8d, 0xb9, 0x9a, 0xed, 0xde, 0x12, 0xc0, 0x7c, 0x48,
-		0x28, 0x0e, 0x7f, 0xac, 0xa1, 0x83, 0xf0, 0xb7, 0x4c, 0x62, 0xcd, 0xc8, 0x95, 0xd1, 0x08, 0x83,
-		0x53, 0x48, 0xd9, 0x7b, 0x3e, 0x6c, 0x4a, 0xbe, 0xe4, 0x06, 0x49, 0x06, 0x89, 0x0d, 0x7d, 0xdb,
-		0x24, 0x38, 0x2d, 0x68, 0xea, 0x26, 0x49, 0xe7, 0xf2, 0xc0, 0xd3, 0xf9, 0x4e, 0x9e, 0x03, 0x10,
-		0x48, 0x5c, 0x8a, 0x2c, 0xfe, 0x3b, 0x3e, 0x72, 0x22, 0x93, 0x64, 0x28, 0xc8, 0x86, 0x2d, 0xbf,
-		0x11, 0xfc, 0x03, 0x7c, 0xf4, 0x5c, 0x6b, 0x04, 0xea, 0xff, 0x0b, 0x09, 0x68, 0xee, 0x81, 0xe6,
-		0xd1, 0x1b, 0x03, 0x18, 0x46, 0x97, 0xff, 0x03, 0xdf, 0x2e, 0xde, 0x37, 0x94, 0x2d, 0x52, 0x89,
-		0xb3, 0x4f, 0x2c, 0xce, 0x86, 0x14, 0xcc, 0xb0, 0x75, 0x4d, 0x0f, 0xdb, 0x05, 0x64, 0xda, 0xb7,
-		0x4a, 0xa6, 0x55, 0x32, 0xed, 0xfc, 0xd2, 0x15, 0xbb, 0x0b, 0xa3, 0xa4, 0x5b, 0x65, 0x05, 0x5d,
-		0x69, 0xbe, 0x9b, 0x72, 0x6b, 0x25, 0xeb, 0x16, 0x37, 0x82, 0xa6, 0x56, 0x71, 0x27, 0x25, 0x5f,
-		0xd7, 0x43, 0x7e, 0x61, 0x7f, 0x79, 0xba, 0x73, 0x71, 0x77, 0xb9, 0x15, 0xf8, 0x3e, 0x22, 0x0c,
-		0x84, 0xa3, 0x71, 0x67, 0x1e, 0x74, 0x56, 0xb9, 0xce, 0x23, 0x81, 0x33, 0x76, 0x98, 0x83, 0x19,
-		0x7f, 0xf9, 0x9c, 0xab, 0xfc, 0xb3, 0x87, 0xfc, 0xd8, 0x53, 0xae, 0x04, 0x48, 0x25, 0x40, 0x16,
-		0x61, 0x1c, 0xca, 0x99, 0x3d, 0xfb, 0x68, 0xa5, 0x9c, 0xd9, 0xcd, 0xe2, 0xce, 0xec, 0x56, 0x19,
-		0x67, 0xf6, 0x41, 0xda, 0x99, 0x5d, 0xa0, 0x7f, 0x9b, 0xcf, 0xfb, 0xec, 0xf7, 0xb3, 0x82, 0xbf,
-		0xdf, 0x89, 0x26, 0xff, 0xe5, 0xd3, 0xf1, 0xd9, 0x45, 0x91, 0xfe, 0x87, 0x61, 0xff, 0xb3, 0xcf,
-		0x17, 0x97, 0xe7, 0x5f, 0x3e, 0x7e, 0xfd, 0x58, 0x6c, 0x8c, 0x37, 0xe1, 0x18, 0xa7, 0x9f, 0xff,
-		0xfd, 0xf1, 0xcb, 0xe5, 0xe9, 0xf1, 0xff, 0x7d, 0xfc, 0x72, 0xc9, 0x17, 0xb3, 0xba, 0x9e, 0xfd,
-		0x68, 0xb1, 0x84, 0x92, 0x32, 0x2e, 0xe9, 0xcd, 0xe3, 0x02, 0x8a, 0x78, 0xf6, 0x17, 0x56, 0x48,
-		0xca, 0x3f, 0x31, 0x3d, 0xd0, 0xa9, 0xcd, 0x12, 0x2a, 0x19, 0xb4, 0xb8, 0x78, 0x49, 0x80, 0xc2,
-		0x41, 0x91, 0x00, 0x85, 0x98, 0x58, 0xbb, 0xa0, 0x5d, 0x34, 0xbc, 0xa1, 0xa9, 0xc2, 0x1b, 0x9e,
-		0x51, 0xb0, 0x9f, 0x8a, 0x2e, 0x4a, 0xb0, 0x2f, 0x2e, 0xd8, 0xa7, 0x56, 0x71, 0xfb, 0x04, 0xfb,
-		0x52, 0xc1, 0xb4, 0x82, 0xc5, 0x99, 0x92, 0xf6, 0xb9, 0x45, 0x9a, 0xa2, 0xf2, 0x07, 0x02, 0x31,
-		0xdc, 0x40, 0xa0, 0x58, 0xd3, 0xb9, 0xeb, 0xb3, 0xcb, 0xaf, 0x7c, 0xb0, 0x0d, 0x04, 0xa6, 0xdf,
-		0x38, 0x90, 0x48, 0x54, 0xd9, 0x8d, 0x9a, 0xcb, 0x05, 0xa6, 0x07, 0x84, 0xc1, 0xe1, 0x10, 0xd9,
-		0x00, 0x12, 0x1b, 0xc4, 0x6f, 0xf9, 0x38, 0xc0, 0x43, 0x3e, 0x10, 0xb8, 0x57, 0x21, 0x1d, 0xa7,
-		0xae, 0x6e, 0x88, 0x8b, 0x21, 0x47, 0x85, 0xe2, 0xd4, 0x23, 0xba, 0x90, 0xd7, 0xb8, 0xe3, 0x7e,
-		0xc5, 0x94, 0xed, 0x71, 0xe0, 0x30, 0xec, 0x39, 0x68, 0x86, 0x2a, 0xb7, 0x41, 0x3b, 0x16, 0x22,
-		0xd6, 0x2d, 0x53, 0x8d, 0x45, 0x88, 0x79, 0x33, 0x72, 0x57, 0x71, 0x1d, 0x57, 0x3c, 0x83, 0xd6,
-		0x02, 0x16, 0xca, 0x44, 0x0a, 0x4d, 0x32, 0x6a, 0x45, 0x37, 0x3f, 0x75, 0x7d, 0x9f, 0xde, 0x76,
-		0x43, 0x5a, 0x9f, 0xbc, 0xe6, 0xe6, 0xd1, 0x92, 0x5d, 0x11, 0xa9, 0xbc, 0x5a, 0x53, 0xfb, 0x82,
-		0x6c, 0x7e, 0xad, 0x94, 0x65, 0xa2, 0x7c, 0x9e, 0xad, 0x64, 0x30, 0xa9, 0x7c, 0x5b, 0xeb, 0x35,
-		0x3e, 0x4e, 0x58, 0xa5, 0x3c, 0x0e, 0x26, 0x3d, 0x8b, 0x21, 0x61, 0x48, 0x05, 0x3c, 0xe0, 0x32,
-		0x61, 0xd6, 0x1e, 0xb4, 0xae, 0x11, 0x93, 0x30, 0x63, 0x0e, 0x60, 0xe0, 0x30, 0x29, 0xed, 0xa1,
-		0xd6, 0x14, 0xa3, 0xba, 0xbe, 0x42, 0x64, 0x85, 0xc8, 0x0a, 0x91, 0xb7, 0x48, 0x37, 0x12, 0x15,
-		0xb4, 0x84, 0x74, 0xa3, 0x3f, 0xf8, 0x60, 0x4f, 0x52, 0xc6, 0xf7, 0x77, 0x74, 0x37, 0x57, 0xd3,
-		0x11, 0x08, 0x55, 0x0e, 0x12, 0x63, 0x88, 0xe2, 0x0c, 0xb0, 0x14, 0xc3, 0x13, 0x63, 0x70, 0x1b,
-		0xaf, 0x64, 0x9c, 0xa3, 0xf8, 0x89, 0x6c, 0x7d, 0x99, 0x92, 0xae, 0xbe, 0x9b, 0x61, 0x22, 0x4b,
-		0xe0, 0x80, 0xb7, 0x12, 0x2b, 0xe9, 0x7a, 0x31, 0x42, 0x20, 0x6c, 0x3e, 0x71, 0xd2, 0xc5, 0x25,
-		0x9e, 0xe2, 0x88, 0xb1, 0x01, 0xbc, 0xf2, 0xb1, 0xa5, 0xaa, 0x18, 0x6f, 0x57, 0x15, 0x63, 0x31,
-		0x1f, 0x95, 0x88, 0x4f, 0x4a, 0xcc, 0x07, 0x25, 0xe7, 0x73, 0x8a, 0x2e, 0x4c, 0x06, 0xc4, 0x46,
-		0x03, 0x4c, 0x84, 0x44, 0xc3, 0xc8, 0x2b, 0x15, 0xf2, 0x40, 0x91, 0xc6, 0xad, 0x28, 0x99, 0x0f,
-		0x26, 0xa8, 0x5c, 0x16, 0x5e, 0x71, 0xdf, 0x48, 0x34, 0x35, 0x21, 0xef, 0x63, 0x3c, 0x31, 0x21,
-		0xcf, 0x47, 0x6a, 0x8d, 0xf2, 0xae, 0x32, 0x4a, 0x73, 0x12, 0x21, 0x30, 0xca, 0x4e, 0x0f, 0x21,
-		0x94, 0x16, 0xa2, 0x78, 0x3a, 0x08, 0xe1, 0x12, 0xd3, 0x2d, 0x85, 0x3e, 0x25, 0xd1, 0x27, 0xbf,
-		0xc4, 0x74, 0x94, 0x6b, 0x5d, 0x26, 0x4f, 0xe7, 0xb4, 0x8b, 0x9c, 0x59, 0x36, 0x0a, 0x53, 0x49,
-		0x17, 0x27, 0xec, 0x82, 0xc0, 0x7b, 0xb0, 0xdd, 0x5b, 0xa2, 0x32, 0x76, 0xaa, 0x24, 0xef, 0x25,
-		0x92, 0x2f, 0x6f, 0xc0, 0x4d, 0x11, 0x5d, 0x29, 0x90, 0x3f, 0x1c, 0x73, 0xfd, 0xe4, 0x4e, 0xc8,
-		0x29, 0xa4, 0x0c, 0x5c, 0x13, 0x7e, 0x63, 0x00, 0x8f, 0x51, 0x5a, 0xaa, 0xbb, 0x85, 0x14, 0x08,
-		0x0f, 0xab, 0x8e, 0xcb, 0xee, 0x1c, 0x17, 0x1b, 0x32, 0xa4, 0x41, 0x62, 0x6b, 0x21, 0xc5, 0xac,
-		0x3b, 0x65, 0xf9, 0x39, 0x64, 0x0c, 0xf9, 0x44, 0xd8, 0xc8, 0x56, 0x33, 0x4d, 0xfb, 0xbe, 0xfd,
-		0xa8, 0x85, 0x2f, 0xad, 0xc9, 0xcb, 0x45, 0xf4, 0xd2, 0x9d, 0x79, 0xa9, 0x9b, 0xa6, 0x6e, 0x9a,
-		0xf6, 0x4f, 0x8d, 0x77, 0xf5, 0x3f, 0x1f, 0x7a, 0xa6, 0xf9, 0x93, 0x69, 0x6a, 0xfd, 0x99, 0x16,
-		0x8d, 0xda, 0xe6, 0x12, 0x67, 0x3d, 0x8f, 0x02, 0x9a, 0xe7, 0xa0, 0xcd, 0xd3, 0x40, 0x33, 0x7c,
-		0xb2, 0x82, 0x52, 0x5f, 0x94, 0x19, 0x0d, 0xdb, 0x02, 0x92, 0x5f, 0xd2, 0x54, 0x4c, 0xfa, 0x0b,
-		0x08, 0xfe, 0x2b, 0x40, 0x20, 0x4a, 0xa1, 0x3c, 0xc0, 0xf1, 0x6d, 0xfa, 0x29, 0x80, 0x29, 0x45,
-		0xf4, 0xb9, 0x45, 0x41, 0x71, 0x25, 0xd3, 0x76, 0xc7, 0x10, 0x93, 0xbc, 0x2a, 0x1b, 0x22, 0x40,
-		0x22, 0x5a, 0xf3, 0x40, 0xac, 0x16, 0x8a, 0x38, 0x9b, 0x90, 0xac, 0x6d, 0x50, 0xf8, 0x46, 0x87,
-		0xfc, 0x0d, 0x8e, 0x47, 0xb1, 0x22, 0x2e, 0xf2, 0x8f, 0xda, 0xea, 0x1c, 0x54, 0xef, 0x61, 0x0b,
-		0xe2, 0x73, 0x86, 0xff, 0x45, 0x98, 0x25, 0xd5, 0xea, 0xf5, 0x7a, 0x0f, 0x6a, 0x7f, 0x1f, 0x6b,
-		0x7f, 0x1a, 0xda, 0xd1, 0x65, 0x3f, 0xf5, 0x87, 0x69, 0x6a, 0x97, 0xfd, 0xc6, 0xbd, 0xb1, 0x77,
-		0xd8, 0x7c, 0x6c, 0xbc, 0x9b, 0x7e, 0xde, 0x37, 0x4d, 0xbd, 0xf1, 0xba, 0x48, 0xaf, 0x77, 0x8d,
-		0x07, 0xd3, 0xd4, 0x57, 0x9f, 0xd4, 0x7e, 0x79, 0xd0, 0xf6, 0x60, 0x86, 0x2a, 0x37, 0x0f, 0xdb,
-		0xbc, 0xb1, 0x18, 0x70, 0x1f, 0x83, 0x88, 0xbb, 0x80, 0xb0, 0x0f, 0x26, 0x43, 0x1d, 0x1c, 0x4f,
-		0x64, 0x4e, 0x0b, 0x12, 0xe0, 0x41, 0x9f, 0x61, 0x0b, 0x7b, 0xfc, 0x32, 0x00, 0x01, 0x86, 0xd6,
-		0x9c, 0x34, 0x2c, 0xad, 0xdd, 0x2b, 0x48, 0xdf, 0xb8, 0x76, 0x1f, 0x6e, 0x15, 0xb2, 0x63, 0x31,
-		0x44, 0x5c, 0x89, 0x99, 0xed, 0x26, 0xa7, 0xc3, 0x9c, 0xc3, 0x28, 0xf3, 0x62, 0x6c, 0x8c, 0x4e,
-		0xe4, 0x81, 0x90, 0xae, 0x86, 0x01, 0xf4, 0x6d, 0xe0, 0x43, 0xec, 0x80, 0x5b, 0xec, 0x38, 0xc0,
-		0x47, 0x7f, 0x05, 0xd8, 0x0f, 0xf5, 0x1c, 0xc8, 0x4c, 0xc2, 0xf3, 0x30, 0xf2, 0x22, 0x9f, 0x00,
-		0xd3, 0x24, 0xbd, 0xeb, 0xed, 0x08, 0x45, 0x06, 0xed, 0x98, 0xec, 0x34, 0x9e, 0x0a, 0xd6, 0xc1,
-		0x94, 0xf1, 0xdb, 0x27, 0x90, 0x01, 0x07, 0x85, 0x6a, 0x53, 0x13, 0xa0, 0x89, 0x7f, 0x42, 0x69,
-		0x47, 0x4a, 0x3b, 0x7a, 0xa2, 0x8a, 0x71, 0xf4, 0xb6, 0x9b, 0xc8, 0xf8, 0xdd, 0xa9, 0xcc, 0xbc,
-		0x01, 0x83, 0x44, 0x9a, 0xfc, 0xe5, 0x4e, 0x72, 0xd2, 0x4b, 0xee, 0x20, 0x5f, 0xc4, 0x46, 0xba,
-		0x10, 0xf6, 0x79, 0xe6, 0x03, 0xd7, 0x82, 0xce, 0xe4, 0x5c, 0x33, 0x17, 0x20, 0x1e, 0xf5, 0x8d,
-		0xe9, 0x28, 0x39, 0x9d, 0xc8, 0x06, 0x0e, 0x26, 0xd7, 0x26, 0x39, 0x21, 0x60, 0x10, 0xb0, 0x80,
-		0x9f, 0x6c, 0xe4, 0x23, 0x30, 0x86, 0x77, 0xe0, 0x0a, 0x81, 0xb1, 0x1b, 0x9d, 0x75, 0x02, 0x9a,
-		0xd1, 0xc8, 0xcc, 0x05, 0x9e, 0xef, 0xde, 0x60, 0x1b, 0x01, 0x1f, 0xd9, 0x01, 0xb1, 0x21, 0xb1,
-		0xee, 0x74, 0x93, 0xfc, 0x12, 0xab, 0x0e, 0x98, 0x60, 0x86, 0xa1, 0x03, 0x6e, 0x90, 0x4f, 0x31,
-		0x9f, 0x06, 0xa6, 0x21, 0x36, 0x38, 0x78, 0x8c, 0x19, 0xb2, 0xc3, 0xfe, 0x4d, 0x15, 0xca, 0xb9,
-		0xf3, 0xa1, 0x9c, 0x29, 0xef, 0xb8, 0x7c, 0x1c, 0x53, 0xba, 0x73, 0xb1, 0x50, 0xa6, 0x73, 0x5e,
-		0x55, 0x1c, 0x0e, 0xd1, 0x24, 0x8f, 0x5c, 0x40, 0x91, 0xcd, 0xd5, 0xdf, 0xd8, 0x60, 0xc7, 0xb3,
-		0xfb, 0xba, 0x40, 0xc5, 0x79, 0x8a, 0xf6, 0x53, 0x51, 0x45, 0x92, 0xfc, 0xae, 0x04, 0xdf, 0x2b,
-		0xc2, 0xff, 0x16, 0xf8, 0x60, 0x3a, 0xaa, 0x28, 0xba, 0x8b, 0x70, 0xdb, 0x4d, 0x1f, 0xac, 0x27,
-		0x8c, 0x68, 0x9c, 0x0b, 0x8f, 0x91, 0xc7, 0x03, 0x91, 0xf0, 0x1a, 0x31, 0x48, 0x88, 0x46, 0x5a,
-		0x40, 0x85, 0x58, 0xdf, 0x3a, 0x56, 0x78, 0xa0, 0xf0, 0x60, 0x97, 0xf0, 0x60, 0xf6, 0x60, 0x55,
-		0x22, 0xd8, 0xb0, 0x68, 0x78, 0xdd, 0xd4, 0xbe, 0xf9, 0xb4, 0xf5, 0x9c, 0xd7, 0x5e, 0xbc, 0xf9,
-		0xa9, 0x83, 0x31, 0x53, 0xf6, 0xa9, 0x7d, 0x09, 0xdd, 0x44, 0xc8, 0x3b, 0xc2, 0x5f, 0xce, 0x21,
-		0xf6, 0x2f, 0xcf, 0xa3, 0x91, 0xcf, 0x73, 0x6b, 0xbf, 0x54, 0xcf, 0x45, 0x94, 0x6b, 0xbf, 0x93,
-		0x5b, 0x8a, 0x32, 0xde, 0xa2, 0x1b, 0x07, 0x92, 0x7c, 0x8b, 0x23, 0x6f, 0x25, 0x66, 0x6a, 0x84,
-		0x91, 0xd9, 0xc6, 0x1d, 0x80, 0x3f, 0x4e, 0x8f, 0xcf, 0x94, 0xfd, 0xb0, 0xfa, 0xf6, 0x43, 0x5b,
-		0xa2, 0x7c, 0xda, 0xd4, 0x7d, 0x24, 0x5c, 0x81, 0x6c, 0x9e, 0x40, 0x1c, 0x97, 0x0c, 0x41, 0xaa,
-		0x7b, 0x64, 0x0d, 0x54, 0x95, 0xd9, 0x55, 0x65, 0x76, 0x55, 0x99, 0x7d, 0x83, 0x8e, 0xbd, 0xe2,
-		0x0e, 0x3e, 0x49, 0x01, 0x16, 0x94, 0xae, 0xcc, 0xde, 0x34, 0x5a, 0x6d, 0x55, 0x9b, 0x7d, 0x0d,
-		0xe6, 0x64, 0x1b, 0x53, 0xcf, 0x81, 0x77, 0x5a, 0x2c, 0x43, 0x88, 0x42, 0x7b, 0xba, 0x97, 0x1c,
-		0xb6, 0xc7, 0x5d, 0x41, 0xd8, 0x15, 0x30, 0x37, 0x54, 0x87, 0x01, 0x26, 0xe0, 0xd7, 0x6f, 0x27,
-		0xc0, 0xf5, 0xc1, 0xfb, 0xd3, 0x13, 0x05, 0xf3, 0x0a, 0xe6, 0x15, 0xcc, 0x2b, 0x98, 0x0f, 0xa7,
-		0xf5, 0xd6, 0x50, 0x20, 0xbf, 0x06, 0x90, 0xa7, 0xc1, 0x15, 0x41, 0x12, 0xde, 0xc2, 0xb8, 0xbd,
-		0x1c, 0xb0, 0x9f, 0x21, 0x76, 0xeb, 0xfa, 0xd7, 0x20, 0xea, 0x4c, 0xb9, 0x89, 0x33, 0xd4, 0xef,
-		0x94, 0x1b, 0x5e, 0xb9, 0xe1, 0x93, 0x72, 0x4d, 0xde, 0x4d, 0x5b, 0x13, 0x5e, 0x8b, 0xcd, 0x86,
-		0x28, 0xd7, 0xeb, 0x3d, 0x43, 0x3b, 0xea, 0x3f, 0xf4, 0x9a, 0xda, 0x51, 0x3f, 0x7a, 0xdb, 0xe4,
-		0x2f, 0xd1, 0xfb, 0x56, 0xcf, 0xd0, 0xda, 0x93, 0xf7, 0x9d, 0x9e, 0xa1, 0x75, 0xfa, 0x0d, 0xd3,
-		0xd4, 0x1b, 0xf7, 0x07, 0x8f, 0xf2, 0x1d, 0xf7, 0xe3, 0x1f, 0x6b, 0x3c, 0xd4, 0x7b, 0x4d, 0xad,
-		0xd5, 0x9f, 0xfc, 0x71, 0xd0, 0x33, 0xb4, 0x56, 0xbf, 0x51, 0x3e, 0xc4, 0x79, 0x83, 0xe6, 0xc9,
-		0xa6, 0xbc, 0x79, 0xb2, 0x50, 0x1a, 0x0c, 0x49, 0x8b, 0x65, 0xe1, 0xa4, 0x53, 0x59, 0x61, 0xcf,
-		0x4b, 0xad, 0x5a, 0xab, 0x83, 0x9f, 0x57, 0x41, 0x21, 0x1b, 0x21, 0x0e, 0x7d, 0xe0, 0xe4, 0x83,
-		0x42, 0x3f, 0x85, 0x7e, 0x02, 0x26, 0xd2, 0x65, 0x34, 0x21, 0x90, 0xb0, 0x52, 0xb2, 0x6c, 0xc1,
-		0x53, 0x49, 0xb2, 0x86, 0x92, 0x64, 0xe7, 0x97, 0xa4, 0x6d, 0x1c, 0x1d, 0x2a, 0x59, 0x56, 0x14,
-		0xc2, 0x8b, 0xe4, 0xc6, 0xc8, 0x86, 0x6a, 0x95, 0x08, 0x63, 0xd1, 0xc9, 0x94, 0x83, 0x47, 0x79,
-		0xde, 0xa5, 0x3f, 0xc2, 0xee, 0xa2, 0x7e, 0xa5, 0x57, 0x19, 0x4f, 0x36, 0xd9, 0xc1, 0x55, 0x77,
-		0x8d, 0xb2, 0xf7, 0x2e, 0x7f, 0xcf, 0x0a, 0xed, 0x55, 0xf6, 0x1e, 0xcd, 0x3f, 0x41, 0xce, 0x9e,
-		0xe4, 0xee, 0x45, 0x6d, 0xe9, 0xdd, 0xfc, 0xac, 0xe5, 0x9f, 0x5d, 0xf9, 0xe9, 0xfa, 0xa6, 0x66,
-		0x36, 0x59, 0xd0, 0xb1, 0x6b, 0x23, 0x67, 0x61, 0x5e, 0xf3, 0x77, 0x05, 0xa2, 0x56, 0x73, 0xcf,
-		0xb5, 0x70, 0x49, 0x80, 0xb7, 0x02, 0xee, 0x60, 0xf9, 0x75, 0xae, 0x15, 0x3e, 0xbb, 0x95, 0xe2,
-		0x4b, 0x96, 0xb8, 0x32, 0x9d, 0xdf, 0x78, 0xd9, 0xea, 0xe4, 0x88, 0x23, 0xc2, 0xe2, 0x87, 0xb0,
-		0xb8, 0x31, 0x2b, 0x5e, 0x8c, 0x6b, 0x92, 0x34, 0xbe, 0xca, 0xc7, 0x56, 0x4b, 0xd2, 0x24, 0xe4,
-		0xfb, 0x58, 0xa7, 0x4d, 0x45, 0x1d, 0xad, 0x63, 0xe8, 0x85, 0x9b, 0xc5, 0x13, 0x34, 0xa7, 0x12,
-		0x32, 0x74, 0x41, 0x64, 0x59, 0xd3, 0x32, 0x0d, 0x6c, 0x4f, 0xea, 0x81, 0xcd, 0x34, 0xa9, 0x56,
-		0xd4, 0x03, 0x9b, 0x65, 0x32, 0x5d, 0xb3, 0x07, 0x36, 0xd9, 0x3b, 0xed, 0x9a, 0x83, 0xa5, 0xa0,
-		0xfe, 0x32, 0xdb, 0x4d, 0x5e, 0x8b, 0xb9, 0x46, 0x77, 0x00, 0x13, 0x90, 0x10, 0x52, 0x6e, 0x4e,
-		0x0f, 0x65, 0xa6, 0xdf, 0x41, 0x33, 0xfd, 0x1d, 0x24, 0x43, 0x6d, 0x7a, 0xc5, 0x47, 0xd9, 0xeb,
-		0x77, 0x57, 0xcb, 0xd9, 0x3d, 0x1d, 0x67, 0x13, 0xb6, 0xc9, 0xf8, 0x56, 0xe9, 0x65, 0x7f, 0xf6,
-		0xda, 0xa9, 0xde, 0x7f, 0x2d, 0x8a, 0x35, 0xfa, 0x83, 0xae, 0x3f, 0xf4, 0xbe, 0xff, 0xf8, 0x4f,
-		0x5f, 0x7f, 0xfd, 0xa0, 0xf7, 0xbe, 0x8f, 0x3f, 0xf1, 0x37, 0x7a, 0xef, 0xbb, 0x73, 0xda, 0xd7,
-		0x5f, 0xd7, 0x9e, 0xc5, 0x0f, 0x71, 0x13, 0x93, 0x89, 0xa8, 0xf1, 0x8d, 0x37, 0x97, 0x67, 0x5a,
-		0x51, 0x11, 0xd4, 0x34, 0xb7, 0x12, 0xe1, 0x7e, 0x8a, 0x61, 0xbd, 0x54, 0x86, 0x25, 0x51, 0x1e,
-		0x4a, 0xf9, 0xa0, 0x15, 0x4f, 0x93, 0x5c, 0x92, 0x96, 0xa1, 0x9c, 0xd0, 0x1b, 0x35, 0xdc, 0x89,
-		0xa0, 0xb4, 0x32, 0xdf, 0x4d, 0x4d, 0x46, 0x91, 0xa5, 0x66, 0x7f, 0xe6, 0x8f, 0x3c, 0xcb, 0x40,
-		0x96, 0x41, 0xe9, 0x53, 0x38, 0xc2, 0x65, 0xfa, 0xfd, 0x71, 0x32, 0x5a, 0x89, 0xb0, 0x71, 0x91,
-		0x98, 0x61, 0x89, 0x58, 0xe1, 0x52, 0x31, 0xc2, 0x4f, 0x9a, 0x5e, 0x68, 0xb7, 0x2d, 0x19, 0xe2,
-		0xe9, 0x85, 0x72, 0x19, 0xab, 0xca, 0x2c, 0x54, 0xa9, 0xcc, 0x42, 0xe2, 0x31, 0xb7, 0x2f, 0x21,
-		0xb5, 0x50, 0x19, 0x64, 0x13, 0x89, 0x99, 0x95, 0x89, 0x95, 0x5d, 0x57, 0x8c, 0xac, 0x82, 0x39,
-		0x05, 0x73, 0x0a, 0xe6, 0x4a, 0xc2, 0x9c, 0x68, 0xcc, 0xe9, 0x96, 0x83, 0x9c, 0x87, 0x3d, 0xe4,
-		0x44, 0x89, 0xcc, 0x73, 0x00, 0x2e, 0x69, 0x29, 0x06, 0x6e, 0xe7, 0x71, 0x73, 0x60, 0x71, 0x34,
-		0x0a, 0xa2, 0x2c, 0xf6, 0x40, 0x03, 0x76, 0x00, 0x9d, 0x10, 0xdf, 0xfe, 0x0a, 0x60, 0x25, 0xe4,
-		0xb8, 0xa5, 0x7e, 0xc7, 0x8a, 0x03, 0xdc, 0x32, 0xbf, 0x64, 0x31, 0x80, 0xdb, 0xc5, 0x7a, 0x05,
-		0x3c, 0xe5, 0xb2, 0x70, 0xb5, 0x82, 0x90, 0x5e, 0x85, 0xab, 0x15, 0x70, 0xa2, 0x7e, 0xaa, 0x62,
-		0x05, 0x7c, 0x66, 0x62, 0x91, 0x9b, 0x7c, 0x5e, 0xa2, 0xb5, 0x0a, 0xa2, 0xf5, 0x79, 0x9e, 0x4a,
-		0x05, 0x99, 0xf9, 0xae, 0xa6, 0x58, 0xb4, 0xfa, 0x0e, 0xf9, 0x62, 0x2c, 0x03, 0xcf, 0x3d, 0xc5,
-		0xbd, 0x9b, 0x42, 0xb9, 0x69, 0x9b, 0x0a, 0x74, 0x36, 0x0d, 0x3a, 0xf9, 0x65, 0x0a, 0x24, 0x52,
-		0x3c, 0x15, 0x48, 0xed, 0x34, 0x4f, 0x23, 0xa9, 0x34, 0xc6, 0x71, 0xb9, 0x02, 0x9e, 0xdf, 0x49,
-		0x03, 0xde, 0xe8, 0x8e, 0x62, 0x0b, 0x3a, 0x49, 0x66, 0x34, 0xb1, 0x1c, 0x89, 0x95, 0x88, 0xf6,
-		0x1d, 0x6f, 0x61, 0xb4, 0xef, 0xb8, 0x7a, 0x4e, 0xf1, 0x00, 0x13, 0xf6, 0x56, 0xc2, 0x6d, 0xd0,
-		0x51, 0xe1, 0xbe, 0x5b, 0xe4, 0x34, 0xe8, 0x74, 0x94, 0xd3, 0x40, 0x54, 0x2a, 0xd8, 0x53, 0x89,
-		0x27, 0x4a, 0xa3, 0xba, 0xf2, 0x1c, 0x3f, 0x0d, 0xaa, 0x2b, 0x6f, 0xf0, 0x6e, 0x03, 0xbb, 0x4a,
-		0x3c, 0xb1, 0x2e, 0x64, 0x57, 0x89, 0x27, 0x14, 0xcc, 0x2b, 0x98, 0x57, 0x30, 0xaf, 0x12, 0x4f,
-		0x6c, 0x2f, 0xc8, 0x8f, 0xe1, 0x0f, 0x2d, 0x4e, 0xa4, 0x29, 0x8e, 0xf1, 0xe9, 0x4e, 0x72, 0x10,
-		0x7f, 0x0c, 0xa8, 0xe7, 0x60, 0xc6, 0x90, 0xcf, 0x6b, 0x96, 0xd8, 0x98, 0xa7, 0x94, 0x9f, 0x54,
-		0x99, 0xe4, 0x15, 0x91, 0xdd, 0x49, 0x62, 0x4f, 0xaa, 0x9b, 0xe4, 0x62, 0x84, 0x80, 0x8d, 0x06,
-		0x30, 0x70, 0x58, 0x1c, 0x34, 0x6a, 0x00, 0x4c, 0x6c, 0x6c, 0x41, 0x86, 0x68, 0xaa, 0x1f, 0x05,
-		0xc4, 0x4d, 0xf2, 0xf8, 0xe2, 0xbf, 0xe1, 0x95, 0x23, 0xc0, 0x7c, 0xf8, 0xb0, 0x42, 0x67, 0xbd,
-		0x66, 0x64, 0x03, 0x60, 0x5f, 0x59, 0x95, 0x94, 0x55, 0x49, 0x59, 0x95, 0x14, 0x57, 0x6a, 0xaa,
-		0x2b, 0xe4, 0xeb, 0xe0, 0x4a, 0xd4, 0x43, 0xc8, 0xa6, 0x12, 0xe9, 0x90, 0xa2, 0xf6, 0x72, 0xbc,
-		0x88, 0x73, 0x0e, 0xde, 0x53, 0x39, 0x05, 0x14, 0x7c, 0x27, 0x09, 0x90, 0xb8, 0x43, 0x89, 0xdd,
-		0x49, 0xd6, 0x22, 0x12, 0x41, 0xf1, 0x93, 0x78, 0xe8, 0x9f, 0x21, 0x45, 0xf2, 0xe5, 0x0e, 0x44,
-		0x28, 0x75, 0x16, 0x8f, 0xa8, 0x30, 0xc7, 0x90, 0xe3, 0x1a, 0x8b, 0xd3, 0xd2, 0x9a, 0x86, 0x31,
-		0xac, 0x6d, 0x02, 0x8e, 0x4b, 0xce, 0xaa, 0x8a, 0x93, 0xaa, 0xe0, 0x9c, 0x5a, 0x5a, 0xa7, 0x8a,
-		0xb3, 0xaa, 0xe2, 0xa4, 0xda, 0x95, 0xa4, 0xf4, 0x76, 0x15, 0x27, 0x55, 0xc5, 0xed, 0x83, 0x01,
-		0x73, 0x09, 0x1a, 0xae, 0xbb, 0x2e, 0x48, 0x7f, 0xc3, 0x7c, 0x6c, 0xa7, 0xd2, 0xc3, 0x15, 0xb9,
-		0xa2, 0x94, 0x1f, 0x41, 0xa2, 0x2e, 0x28, 0x65, 0x5f, 0x50, 0xca, 0x29, 0xee, 0x21, 0x7e, 0x37,
-		0x69, 0x75, 0x31, 0x0f, 0xa9, 0x32, 0xba, 0x7c, 0x56, 0x32, 0x15, 0xd0, 0x93, 0x0e, 0x5b, 0x54,
-		0x07, 0x7d, 0xa7, 0x63, 0xcd, 0xc4, 0x83, 0x57, 0xc5, 0xd3, 0x5b, 0xa8, 0x50, 0xfe, 0x8a, 0xdd,
-		0x58, 0x32, 0x54, 0x2d, 0xf4, 0x74, 0xc3, 0xc2, 0x79, 0x25, 0x8a, 0xe7, 0x93, 0xe8, 0x6f, 0x20,
-		0x43, 0xdc, 0x0a, 0x2c, 0xde, 0xe6, 0x3c, 0x71, 0x4b, 0x78, 0xaa, 0x44, 0xce, 0xb8, 0x05, 0x36,
-		0xba, 0x32, 0x7b, 0xdc, 0xab, 0xd4, 0xac, 0x57, 0xcd, 0xb6, 0x86, 0xe9, 0x2f, 0xf0, 0x1a, 0x7d,
-		0x71, 0xdd, 0x45, 0x00, 0x9f, 0x7f, 0x82, 0x5a, 0xfa, 0xab, 0x99, 0xc9, 0x7d, 0x40, 0x37, 0xd8,
-		0x8a, 0x6f, 0x18, 0x3f, 0xbe, 0x7a, 0xfc, 0x2f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0xf1, 0x46, 0x19, 0x0e, 0xea, 0x73, 0x01, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xfb, 0x53, 0xdb, 0xb8,
+		0xda, 0xff, 0xef, 0xfc, 0x15, 0xcf, 0x78, 0xbe, 0x67, 0x26, 0xe9, 0xe2, 0xe0, 0x84, 0x84, 0x42,
+		0x66, 0xce, 0xf4, 0xb0, 0x6d, 0x77, 0x0f, 0xdf, 0x05, 0xca, 0xb4, 0xdd, 0xb3, 0xe7, 0xdd, 0x24,
+		0x65, 0x84, 0xad, 0x04, 0xbd, 0x38, 0xb2, 0xd7, 0x96, 0xa1, 0xd9, 0xc2, 0xff, 0xfe, 0x8e, 0x7c,
+		0x49, 0x9c, 0x9b, 0x2d, 0xd9, 0x09, 0x18, 0x50, 0xa6, 0xd3, 0x84, 0x44, 0x92, 0x75, 0x79, 0xf4,
+		0x79, 0xae, 0x7a, 0xf4, 0x63, 0x07, 0x00, 0x40, 0x3b, 0x47, 0x63, 0xac, 0x75, 0x41, 0xb3, 0xf0,
+		0x2d, 0x31, 0xb1, 0xb6, 0x1b, 0x7d, 0xfb, 0x1b, 0xa1, 0x96, 0xd6, 0x85, 0x66, 0xfc, 0xe7, 0x7b,
+		0x87, 0x0e, 0xc9, 0x48, 0xeb, 0x82, 0x11, 0x7f, 0xf1, 0x81, 0x78, 0x5a, 0x17, 0xa2, 0x26, 0x00,
+		0x00, 0x34, 0xeb, 0xda, 0x74, 0x75, 0x1f, 0x7b, 0xb7, 0x78, 0xfe, 0x87, 0xf9, 0x67, 0xa4, 0x0a,
+		0xed, 0xce, 0x17, 0xf9, 0x80, 0x7d, 0xd3, 0x23, 0x2e, 0x23, 0x0e, 0xe5, 0x25, 0x8f, 0xc1, 0x26,
+		0x3e, 0x03, 0x67, 0x08, 0x1f, 0xfe, 0xfd, 0xfe, 0x02, 0xbe, 0x84, 0x75, 0xfc, 0xc5, 0x4a, 0xf3,
+		0xbd, 0x9c, 0x7e, 0xbd, 0xd8, 0xdb, 0xe9, 0x0f, 0x17, 0x1e, 0x1e, 0x92, 0xef, 0x4b, 0xfd, 0x5b,
+		0xea, 0xa3, 0xb6, 0xbb, 0xfc, 0xfb, 0x17, 0x27, 0xf0, 0x4c, 0xbc, 0xb2, 0x6e, 0xd4, 0x17, 0x3c,
+		0xb9, 0x73, 0x3c, 0xde, 0x1d, 0xcd, 0x8d, 0x1e, 0xb3, 0xbb, 0xba, 0xe0, 0xbf, 0x91, 0x7f, 0xec,
+		0x8d, 0x82, 0x31, 0xa6, 0x4c, 0xeb, 0x02, 0xf3, 0x02, 0xbc, 0xa6, 0x60, 0xaa, 0x54, 0xd4, 0xab,
+		0xa5, 0x62, 0x0f, 0x73, 0xdf, 0x3c, 0x2c, 0x4e, 0x29, 0xf1, 0x56, 0x0f, 0x15, 0x59, 0x96, 0x87,
+		0x7d, 0x7f, 0xfd, 0x58, 0x92, 0xb9, 0x48, 0x0a, 0xae, 0xe9, 0xe0, 0xc2, 0x9a, 0x21, 0x0a, 0xc4,
+		0x85, 0x9c, 0x3a, 0xf1, 0x92, 0x19, 0x6b, 0x7e, 0x5e, 0xb7, 0x74, 0x22, 0x4b, 0x28, 0xba, 0x94,
+		0xa2, 0x4b, 0x2a, 0xbd, 0xb4, 0xd2, 0x4b, 0x2c, 0xb1, 0xd4, 0xab, 0x97, 0x7c, 0xcd, 0xd2, 0x27,
+		0x2f, 0xed, 0x0c, 0x51, 0x0b, 0x31, 0xc7, 0x9b, 0x2c, 0xef, 0x92, 0xe4, 0xa5, 0x7d, 0x9d, 0xb8,
+		0x58, 0x6c, 0x3e, 0x89, 0x7b, 0xdb, 0xd6, 0xe3, 0xf5, 0xd5, 0xa9, 0xa3, 0xff, 0xed, 0x50, 0x9c,
+		0x35, 0xbf, 0xc9, 0xf6, 0x3c, 0xcc, 0x28, 0x73, 0x81, 0x18, 0xc3, 0x1e, 0xa7, 0x9f, 0x5e, 0xf6,
+		0x14, 0xd5, 0x6a, 0x3d, 0x43, 0x3f, 0x1a, 0xdc, 0xf7, 0x9a, 0xfa, 0xd1, 0x20, 0xfa, 0xd8, 0x0c,
+		0xdf, 0xa2, 0xcf, 0xad, 0x9e, 0xa1, 0xb7, 0x93, 0xcf, 0x9d, 0x9e, 0xa1, 0x77, 0x06, 0xf5, 0x7e,
+		0xbf, 0x51, 0xff, 0xb1, 0xff, 0x20, 0x5f, 0xb1, 0xf6, 0x8f, 0x5e, 0xbf, 0xef, 0xfe, 0x38, 0x7f,
+		0xe0, 0xff, 0x9f, 0x3e, 0x0c, 0x7e, 0xaa, 0xbf, 0xcb, 0x5b, 0x72, 0xde, 0x40, 0xbf, 0xdf, 0x18,
+		0xbc, 0x59, 0xbf, 0x74, 0x83, 0x1d, 0xb1, 0x05, 0x5d, 0xb1, 0x98, 0x9a, 0x35, 0xb7, 0xd5, 0x72,
+		0xb6, 0x6d, 0xba, 0xb0, 0xd8, 0xd6, 0xb5, 0x1d, 0x3a, 0x82, 0x54, 0x35, 0x18, 0x12, 0x6c, 0x5b,
+		0x55, 0xd8, 0xc3, 0x43, 0xf6, 0xfc, 0x76, 0xf0, 0x90, 0x6d, 0x6a, 0xff, 0x8a, 0xef, 0x4d, 0x9f,
+		0x79, 0x84, 0x8e, 0xca, 0xee, 0xc6, 0x53, 0x4c, 0x47, 0xec, 0x3a, 0x77, 0x33, 0x66, 0xcf, 0x76,
+		0x84, 0x3c, 0x84, 0x6a, 0x5d, 0x81, 0x82, 0x00, 0x00, 0xda, 0x7f, 0x90, 0x1d, 0xe0, 0xf5, 0x10,
+		0xb5, 0xf8, 0xd2, 0x7e, 0xf1, 0x90, 0xc9, 0xc9, 0xf4, 0x03, 0x19, 0x11, 0xe6, 0xaf, 0xa7, 0xb4,
+		0xe5, 0xd9, 0xc2, 0x23, 0xc4, 0xc8, 0x2d, 0x7f, 0xd6, 0x10, 0xd9, 0x3e, 0xce, 0xad, 0xf5, 0xb0,
+		0x2b, 0x30, 0x54, 0xf4, 0xbd, 0xc0, 0x50, 0x8d, 0x56, 0xbb, 0x7a, 0xa3, 0xdd, 0x29, 0xf6, 0x6b,
+		0x29, 0x64, 0x9b, 0xc9, 0x84, 0x3a, 0xb1, 0x04, 0xc0, 0x6d, 0xbe, 0xbc, 0x18, 0xbe, 0x7d, 0xbd,
+		0xc6, 0x70, 0xf2, 0x01, 0x9c, 0x21, 0xb0, 0x6b, 0x9c, 0x16, 0x29, 0x95, 0x98, 0x52, 0x05, 0x31,
+		0x45, 0x1c, 0xe6, 0x26, 0x88, 0x8e, 0x74, 0x62, 0x61, 0xca, 0xc8, 0x90, 0xac, 0x5d, 0x3e, 0x85,
+		0x77, 0xd5, 0xc3, 0xbb, 0xc3, 0x76, 0xfb, 0xe0, 0x6d, 0xbb, 0x6d, 0xbc, 0xdd, 0x7f, 0x6b, 0x1c,
+		0x75, 0x3a, 0xcd, 0x83, 0x66, 0xe7, 0xe5, 0xe0, 0xdf, 0x26, 0x04, 0xdc, 0x1e, 0xd2, 0xff, 0x3e,
+		0xd6, 0xff, 0xbc, 0x1c, 0xc4, 0x1f, 0x42, 0x81, 0x52, 0xbf, 0xe4, 0x22, 0x65, 0xce, 0xb6, 0x6c,
+		0xdc, 0x37, 0x1a, 0xf7, 0xbd, 0x6f, 0xdf, 0xff, 0x3b, 0x68, 0xbc, 0xb9, 0x6f, 0xf4, 0xbe, 0x8d,
+		0xcf, 0xc2, 0x0f, 0x8d, 0xde, 0x37, 0xfb, 0x74, 0xd0, 0xd8, 0x96, 0x44, 0x4a, 0x7c, 0xd7, 0x46,
+		0x13, 0x9d, 0x46, 0x3b, 0x33, 0x0f, 0xb5, 0xd3, 0xa5, 0xc5, 0x30, 0x3b, 0xae, 0x02, 0xbc, 0x0a,
+		0x30, 0x07, 0x02, 0x1f, 0x03, 0xa1, 0xf0, 0xeb, 0xef, 0x27, 0xe0, 0x78, 0xf0, 0xfe, 0xf4, 0x44,
+		0x89, 0xa7, 0x4a, 0x3c, 0x55, 0x70, 0xbd, 0x1d, 0xb8, 0x3e, 0x34, 0x5e, 0x9f, 0x70, 0x9a, 0x69,
+		0x4c, 0xfb, 0x0d, 0x4f, 0x72, 0xa5, 0x4f, 0xed, 0x94, 0xf8, 0xec, 0x98, 0xb1, 0x35, 0x66, 0xb7,
+		0x33, 0x42, 0x3f, 0xda, 0x98, 0xef, 0xc2, 0x35, 0xf3, 0xc4, 0x97, 0x2a, 0x55, 0x42, 0x8c, 0x65,
+		0x6a, 0x9f, 0x3c, 0x0b, 0x7b, 0xd8, 0xfa, 0x99, 0xf7, 0x8f, 0x06, 0xb6, 0x9d, 0x39, 0x8c, 0x63,
+		0x4a, 0x1d, 0x86, 0xd6, 0x9a, 0x11, 0x34, 0xdf, 0xbc, 0xc6, 0x63, 0xe4, 0xa2, 0x70, 0x97, 0x69,
+		0x7b, 0x0e, 0x1d, 0xea, 0xa9, 0x21, 0xef, 0xad, 0x37, 0xe0, 0x46, 0xb5, 0x99, 0x17, 0x98, 0x2c,
+		0xe6, 0x08, 0xda, 0x27, 0x3a, 0xfc, 0x70, 0x6d, 0xba, 0x91, 0xa0, 0x7d, 0x39, 0xfb, 0xa8, 0xed,
+		0xac, 0x9e, 0xf6, 0x54, 0x5f, 0x35, 0xcf, 0x09, 0x18, 0x5e, 0x6f, 0x48, 0x8e, 0x7e, 0x16, 0x35,
+		0x21, 0x87, 0xa5, 0x1f, 0xc5, 0x78, 0xec, 0xb1, 0xea, 0x99, 0x8e, 0x3d, 0x56, 0x75, 0xc3, 0xf1,
+		0xc9, 0x45, 0x62, 0x35, 0xe6, 0xab, 0x75, 0xed, 0xb8, 0x55, 0xe0, 0xec, 0xde, 0x33, 0xe4, 0xec,
+		0x1e, 0xab, 0xaa, 0xe1, 0xb8, 0x88, 0xd9, 0xf8, 0x28, 0xa3, 0x4c, 0xfc, 0xec, 0xd2, 0x72, 0x40,
+		0x41, 0xd3, 0xb6, 0x8c, 0xd4, 0x22, 0xad, 0x09, 0x3c, 0x13, 0x93, 0xb7, 0x8c, 0xe9, 0x3b, 0x9b,
+		0x17, 0xe7, 0x10, 0x63, 0xb1, 0xe5, 0x3c, 0xa8, 0xe0, 0x72, 0x76, 0xef, 0xf9, 0x5c, 0x21, 0x7d,
+		0x78, 0xac, 0xff, 0x32, 0xf8, 0x61, 0xec, 0xb6, 0x1f, 0xea, 0xdd, 0x7a, 0x6d, 0xf1, 0xbb, 0x6e,
+		0xfd, 0x87, 0xb1, 0xdb, 0x79, 0xa8, 0xd5, 0x56, 0xfc, 0xf2, 0x6e, 0x55, 0x1b, 0xf5, 0xfb, 0x5a,
+		0xad, 0x16, 0x2f, 0xe4, 0xdc, 0xe2, 0xf6, 0x8c, 0xe6, 0xe0, 0x5d, 0xf8, 0x31, 0xfa, 0x7f, 0x4a,
+		0x1e, 0x42, 0x85, 0xeb, 0x65, 0x88, 0xa2, 0x56, 0xeb, 0x7d, 0xeb, 0x0e, 0x7e, 0xea, 0xd6, 0x7f,
+		0x1c, 0x3c, 0x24, 0x9f, 0xc3, 0xff, 0xeb, 0xf7, 0xb5, 0xc6, 0x9b, 0x7e, 0xbf, 0xd1, 0x78, 0x53,
+		0x8f, 0x3a, 0x1e, 0x97, 0x7b, 0x13, 0xfd, 0xfa, 0xae, 0xdb, 0x5d, 0xfa, 0xaa, 0x5e, 0xfb, 0x47,
+		0x43, 0x92, 0x1e, 0xa3, 0xf9, 0xe9, 0x6e, 0x86, 0x2c, 0x95, 0x47, 0x47, 0xa9, 0xcc, 0x4a, 0x65,
+		0x56, 0x2a, 0x33, 0x80, 0xf2, 0xe8, 0x88, 0x20, 0x9b, 0xb2, 0x0c, 0x2a, 0x98, 0x53, 0x30, 0x07,
+		0xa0, 0x2c, 0x83, 0x2f, 0x17, 0xe4, 0xc6, 0x98, 0x79, 0xc4, 0xcc, 0x87, 0xb7, 0xb8, 0x9c, 0x18,
+		0xb0, 0x9d, 0x85, 0x85, 0xc1, 0x77, 0xb1, 0xc9, 0xdd, 0x9b, 0x7e, 0xe8, 0xad, 0x76, 0x3d, 0xe2,
+		0x78, 0x84, 0x4d, 0x94, 0x51, 0xe4, 0x65, 0x19, 0x45, 0x02, 0x42, 0xd9, 0xa1, 0x00, 0xee, 0x65,
+		0xb8, 0x49, 0xb5, 0xcf, 0x88, 0x8e, 0xf0, 0x93, 0xa1, 0x9e, 0xf1, 0x7a, 0x50, 0xaf, 0xd5, 0xe9,
+		0x28, 0xd8, 0x83, 0xe9, 0x7e, 0xcd, 0x85, 0xbd, 0x6c, 0x3b, 0xf6, 0x02, 0xec, 0xf9, 0xc1, 0x15,
+		0xc5, 0x0c, 0x98, 0x03, 0x63, 0xc4, 0xcc, 0x6b, 0x70, 0x91, 0x79, 0x83, 0x99, 0xc2, 0xbb, 0x17,
+		0x67, 0x04, 0xce, 0x1d, 0xfd, 0xd3, 0xd9, 0x7e, 0x85, 0x16, 0x06, 0x5e, 0x94, 0xc9, 0x77, 0x2f,
+		0x7e, 0x58, 0xfd, 0xbe, 0xd6, 0x6b, 0xea, 0xad, 0x41, 0xf2, 0xc7, 0x7e, 0xcf, 0xd0, 0x5b, 0x83,
+		0x7a, 0xbd, 0x62, 0xe6, 0xdc, 0xca, 0xac, 0xd0, 0xb3, 0xb3, 0xe2, 0xa6, 0x17, 0x9a, 0xbf, 0xff,
+		0x68, 0x3d, 0xd4, 0xef, 0x6b, 0x9c, 0x3c, 0x9a, 0xd3, 0x45, 0x6f, 0xf2, 0x46, 0x0e, 0x79, 0xf1,
+		0xa7, 0xb1, 0xf0, 0xee, 0x35, 0x7e, 0xaa, 0x57, 0xd3, 0x50, 0x1b, 0x7a, 0x8f, 0x85, 0x42, 0x53,
+		0xa7, 0x25, 0x8b, 0x04, 0xa5, 0xae, 0x72, 0x69, 0x2b, 0x9e, 0xf7, 0xe8, 0x3c, 0x4f, 0x85, 0xa2,
+		0x56, 0x46, 0xbc, 0x55, 0xa1, 0xa8, 0x45, 0x90, 0xee, 0xa5, 0x87, 0xa2, 0x8a, 0x44, 0x69, 0xad,
+		0x01, 0xe2, 0x97, 0x19, 0x9f, 0x15, 0x0e, 0x76, 0x6f, 0x1d, 0xfb, 0x58, 0x8e, 0xc9, 0xfa, 0xcc,
+		0x4b, 0x5e, 0x86, 0xff, 0x8b, 0x44, 0x62, 0xf9, 0x77, 0x84, 0x99, 0xd7, 0xeb, 0x43, 0xb1, 0xe2,
+		0xdf, 0xf3, 0x62, 0xb1, 0xc6, 0x88, 0xa2, 0x11, 0xb6, 0x20, 0x3a, 0x66, 0x0c, 0x84, 0x86, 0x4c,
+		0x6f, 0x88, 0xae, 0x96, 0xad, 0x63, 0x5b, 0x89, 0xcc, 0xf2, 0xef, 0xaa, 0x17, 0x99, 0xe5, 0xdf,
+		0x6d, 0x2e, 0x32, 0x8b, 0x31, 0x8f, 0x5c, 0xad, 0x8a, 0x99, 0x5b, 0x9a, 0x89, 0x59, 0x51, 0xc1,
+		0x63, 0xbd, 0x30, 0x46, 0x2e, 0x38, 0x43, 0xc0, 0xdf, 0x99, 0x87, 0x60, 0x5a, 0xdd, 0xef, 0x42,
+		0x64, 0xbb, 0xd7, 0x33, 0x4d, 0xf8, 0x6b, 0x56, 0x33, 0x77, 0x55, 0x45, 0x56, 0x17, 0x40, 0x39,
+		0x5d, 0x04, 0xa8, 0x63, 0x99, 0x4a, 0xf4, 0x1b, 0x3c, 0xc9, 0x2c, 0xba, 0x9a, 0x62, 0xc2, 0x6a,
+		0x39, 0x43, 0x5b, 0xa0, 0x1e, 0xbe, 0xcb, 0x6f, 0xf0, 0x04, 0x08, 0x85, 0x29, 0x21, 0xcd, 0x48,
+		0x28, 0xaf, 0xb1, 0x6c, 0xb9, 0x57, 0x98, 0x86, 0x64, 0x68, 0x49, 0x8e, 0xa6, 0x64, 0x69, 0xab,
+		0x30, 0x8d, 0x15, 0xa6, 0x35, 0x69, 0x9a, 0xcb, 0xa6, 0xbd, 0x1c, 0x1a, 0x14, 0x97, 0xa3, 0x4b,
+		0xc8, 0xd3, 0x32, 0x72, 0xb5, 0xac, 0x7c, 0x9d, 0xbc, 0xc4, 0x96, 0x11, 0x64, 0xe5, 0xed, 0x65,
+		0x61, 0x74, 0x57, 0xae, 0x5e, 0x51, 0x09, 0xb4, 0xb8, 0x24, 0x2a, 0xb8, 0xe2, 0x85, 0xe5, 0xf2,
+		0xa5, 0x29, 0x69, 0x1b, 0xcf, 0x67, 0x4e, 0x76, 0x36, 0x53, 0x6a, 0xb0, 0x0d, 0x4b, 0x55, 0x61,
+		0x29, 0xbe, 0xbc, 0x34, 0x3f, 0x1d, 0x57, 0x41, 0x2d, 0x26, 0x83, 0xd6, 0xb4, 0xdb, 0x98, 0x4c,
+		0x04, 0x99, 0x57, 0x54, 0x5c, 0x9e, 0x69, 0x85, 0xf5, 0xe6, 0xb8, 0x95, 0x08, 0xf7, 0x53, 0x0c,
+		0xeb, 0xb9, 0x32, 0x2c, 0x01, 0x67, 0x47, 0x09, 0xe6, 0x96, 0x1b, 0xe5, 0x02, 0xa0, 0x78, 0xda,
+		0x0b, 0xe6, 0x69, 0x2d, 0xe3, 0x15, 0x32, 0xb5, 0xa2, 0xe0, 0x2f, 0xa5, 0xf4, 0xc4, 0x26, 0x1f,
+		0x11, 0x94, 0xce, 0xb6, 0xff, 0xa4, 0x69, 0x3f, 0xdb, 0x0e, 0x94, 0x26, 0x09, 0x69, 0x7b, 0x90,
+		0x98, 0x5d, 0x28, 0x6f, 0xd4, 0x39, 0x76, 0xa2, 0x2c, 0x7b, 0x51, 0x64, 0xb0, 0xd9, 0x8b, 0xdf,
+		0xf2, 0xac, 0x01, 0xb0, 0xd2, 0x86, 0xf4, 0x25, 0xac, 0x7c, 0x19, 0xbf, 0x1d, 0x4f, 0xdb, 0x50,
+		0xf1, 0xf0, 0x00, 0xca, 0x66, 0x21, 0x43, 0xc8, 0x2a, 0x50, 0x74, 0xa3, 0xe8, 0xaf, 0xe2, 0xe1,
+		0xcb, 0x70, 0x2d, 0x15, 0x0f, 0xaf, 0x60, 0x4e, 0xc1, 0x9c, 0x82, 0x39, 0x00, 0x15, 0x0f, 0x2f,
+		0x09, 0x72, 0x91, 0x7f, 0x2f, 0xde, 0x38, 0x79, 0x31, 0xf1, 0xb3, 0xb2, 0x62, 0x00, 0x67, 0x86,
+		0x40, 0x14, 0x78, 0xa1, 0xc0, 0x9b, 0x04, 0xcc, 0xcc, 0x5a, 0x01, 0xd7, 0xf1, 0x58, 0x15, 0xdc,
+		0x4f, 0x2b, 0x9d, 0x8c, 0x15, 0xc7, 0xb8, 0x55, 0x4e, 0xc8, 0x6d, 0xb9, 0x9f, 0x72, 0xd2, 0x47,
+		0x2c, 0xcd, 0x67, 0x76, 0x1a, 0x89, 0xac, 0xb8, 0xaa, 0x14, 0x71, 0x9c, 0x5c, 0xdc, 0xb6, 0x67,
+		0xf9, 0x25, 0x3c, 0xb8, 0x76, 0x7c, 0xc6, 0xdf, 0x79, 0x1a, 0x13, 0xee, 0x8a, 0xce, 0x60, 0xb4,
+		0x8b, 0x44, 0xf4, 0xa4, 0x66, 0xbd, 0x4c, 0xe2, 0x92, 0x25, 0xb2, 0xc2, 0xc4, 0x56, 0x98, 0xe8,
+		0xa4, 0x89, 0x4f, 0x10, 0xcd, 0x36, 0x6e, 0xaa, 0xb3, 0x9c, 0x31, 0x22, 0x54, 0x17, 0x20, 0x0b,
+		0x50, 0xf6, 0xba, 0x17, 0x6b, 0xaf, 0xeb, 0xec, 0x2b, 0x27, 0xd4, 0x26, 0x9c, 0x50, 0x3c, 0xfc,
+		0x77, 0xea, 0x7e, 0xba, 0x1c, 0xd4, 0xe6, 0x7d, 0x51, 0x03, 0x1e, 0x24, 0x7d, 0xd0, 0x7c, 0xa8,
+		0xbf, 0x9b, 0x7d, 0x3f, 0xe0, 0xe1, 0xcd, 0x6f, 0x8a, 0xd4, 0x7a, 0x57, 0xbf, 0xef, 0xf7, 0x1b,
+		0x4f, 0xe3, 0x95, 0xe2, 0x22, 0x88, 0x4e, 0x83, 0xf1, 0x15, 0xf6, 0xc4, 0xf9, 0x5b, 0xba, 0x52,
+		0x21, 0x1e, 0x97, 0x92, 0x7f, 0x40, 0xac, 0x1d, 0xc5, 0xc9, 0x5e, 0x0f, 0x27, 0x13, 0x27, 0xaf,
+		0x34, 0x69, 0x1c, 0x08, 0x14, 0x15, 0x3b, 0x77, 0x98, 0xbc, 0x1e, 0x8b, 0x8f, 0x19, 0x8a, 0x8f,
+		0x2d, 0x4e, 0xc9, 0x41, 0xa7, 0xb3, 0xdf, 0x51, 0x9e, 0x27, 0x51, 0x80, 0x7f, 0x1a, 0x1f, 0x4c,
+		0xae, 0x3e, 0x0c, 0x02, 0x4e, 0x98, 0xb3, 0x59, 0x23, 0x65, 0xd4, 0x78, 0xc7, 0xc2, 0xb6, 0xd0,
+		0x61, 0x97, 0x69, 0x49, 0x41, 0xff, 0x0b, 0xa1, 0x37, 0xc0, 0x1c, 0x88, 0x86, 0x0c, 0x61, 0xed,
+		0x2a, 0x98, 0x25, 0x5f, 0xb7, 0xca, 0xbe, 0xd9, 0x13, 0x9e, 0x36, 0x46, 0x43, 0x0f, 0x0f, 0x45,
+		0x6c, 0x97, 0x6f, 0xb3, 0x8f, 0x29, 0x44, 0x3b, 0xc5, 0x1f, 0x77, 0x23, 0x72, 0xd1, 0x43, 0x72,
+		0x59, 0xfc, 0x9b, 0x13, 0x5f, 0x99, 0xa3, 0xcc, 0xdc, 0x68, 0x94, 0x7f, 0x90, 0x39, 0xc3, 0xb4,
+		0xb4, 0x14, 0xe4, 0xce, 0x0b, 0x47, 0x91, 0xae, 0x2b, 0xa3, 0xe2, 0x97, 0x66, 0x41, 0x11, 0xf8,
+		0x93, 0xdb, 0xa4, 0x4c, 0x34, 0xc2, 0xd2, 0x72, 0x7b, 0xba, 0x92, 0x9c, 0xdc, 0xee, 0xe1, 0x21,
+		0xf6, 0x30, 0x35, 0x43, 0x3f, 0x0d, 0xb7, 0x62, 0xa6, 0x9a, 0x4a, 0x0c, 0x9b, 0x09, 0x15, 0xf1,
+		0xcf, 0x02, 0x70, 0x29, 0x08, 0x9b, 0xc2, 0xd4, 0x25, 0x43, 0x65, 0x72, 0xd4, 0x26, 0x4b, 0x75,
+		0x85, 0xa9, 0xaf, 0x30, 0x15, 0x4a, 0x53, 0x63, 0x36, 0x55, 0xe6, 0x50, 0xa7, 0x38, 0xc4, 0x16,
+		0x80, 0x5a, 0x19, 0xc8, 0x15, 0x82, 0x5e, 0x4e, 0x96, 0xfc, 0x3d, 0x4d, 0xfc, 0xa5, 0x46, 0x1d,
+		0x9e, 0x27, 0xd1, 0x03, 0xea, 0x33, 0x74, 0x65, 0x0b, 0x8e, 0x7f, 0x1c, 0xf8, 0x6c, 0x1b, 0x7a,
+		0xc7, 0x94, 0x7e, 0x31, 0xd3, 0x4d, 0x87, 0x32, 0x44, 0xa8, 0x5f, 0xdb, 0x4b, 0x4f, 0x41, 0xef,
+		0x5f, 0x0b, 0xbc, 0xe7, 0x9f, 0xff, 0x8f, 0x5d, 0x13, 0x7f, 0xaf, 0xd1, 0xe0, 0xff, 0x92, 0x2f,
+		0x07, 0x7b, 0xe1, 0x34, 0xfd, 0x2b, 0x35, 0x49, 0xbb, 0xd0, 0x10, 0x3d, 0x53, 0xbd, 0x02, 0x31,
+		0x24, 0xe5, 0xfe, 0x34, 0xdb, 0x9a, 0xc3, 0x16, 0x3e, 0x71, 0x70, 0x85, 0x61, 0xe8, 0x39, 0xe3,
+		0x08, 0x78, 0x1c, 0xcf, 0xc3, 0xbe, 0xeb, 0x50, 0x8b, 0xd0, 0x11, 0xa4, 0xc7, 0xa6, 0x49, 0x8a,
+		0xfb, 0x92, 0xbb, 0x79, 0xdd, 0xce, 0xce, 0x8f, 0xe9, 0xd9, 0xca, 0x56, 0x5f, 0xbb, 0xed, 0x4b,
+		0x4d, 0xa1, 0xd4, 0xa3, 0x1f, 0xb6, 0xa2, 0x37, 0x7e, 0xf4, 0x3c, 0xc7, 0x3b, 0xc3, 0xbe, 0x8f,
+		0x46, 0x78, 0x0b, 0x84, 0xe4, 0x7a, 0xd8, 0xc7, 0x34, 0x64, 0x54, 0xeb, 0xe7, 0x61, 0x2f, 0x43,
+		0x7c, 0xda, 0x36, 0x3d, 0x61, 0x3e, 0x7e, 0x7d, 0x1c, 0x4f, 0x40, 0xa5, 0x29, 0x4a, 0x62, 0x2e,
+		0xb7, 0x45, 0x58, 0x4f, 0xad, 0x79, 0x67, 0xc8, 0xa8, 0xe6, 0x35, 0xa2, 0x14, 0xdb, 0xf2, 0x52,
+		0xda, 0x7c, 0xbd, 0xb2, 0x82, 0xda, 0x5c, 0x6b, 0x79, 0xb2, 0x5a, 0xa3, 0x4f, 0xbf, 0x4e, 0x0f,
+		0x11, 0x98, 0x88, 0x5b, 0x0a, 0x00, 0x7f, 0x37, 0x31, 0xb6, 0x62, 0xdf, 0xf5, 0x77, 0x3d, 0x6e,
+		0xd0, 0x4f, 0x9a, 0x9a, 0x5f, 0xf9, 0xe5, 0x86, 0x75, 0x25, 0x04, 0x2a, 0x21, 0x10, 0x64, 0x32,
+		0xc8, 0x2d, 0x12, 0x45, 0x47, 0x59, 0x76, 0xb7, 0x6f, 0xc2, 0x7c, 0x2c, 0xcb, 0x6e, 0xf3, 0x40,
+		0x99, 0x75, 0x9f, 0xbd, 0xba, 0x11, 0x31, 0x93, 0x5a, 0xa3, 0x0e, 0xfd, 0xc0, 0x30, 0xf6, 0xcd,
+		0x7f, 0xc6, 0x5e, 0xbc, 0xe2, 0xaa, 0x47, 0x2f, 0xad, 0x7a, 0xcc, 0xca, 0xa5, 0xbf, 0x1d, 0xec,
+		0xa5, 0xd8, 0xcf, 0x53, 0xa9, 0x26, 0xf3, 0xdc, 0x34, 0x11, 0x84, 0xf0, 0x5f, 0x01, 0xb2, 0xc1,
+		0xf1, 0xc0, 0xc6, 0x3e, 0xcf, 0x7e, 0x8a, 0x68, 0x9a, 0x55, 0x56, 0x57, 0xd6, 0xac, 0xa6, 0xee,
+		0xb2, 0xad, 0x39, 0x7e, 0x61, 0xca, 0xcd, 0xfc, 0x34, 0x45, 0x52, 0x9a, 0x3f, 0x37, 0x27, 0xce,
+		0x50, 0xe9, 0x38, 0x25, 0x28, 0xaf, 0xe0, 0x94, 0xbe, 0x42, 0x55, 0xc7, 0x92, 0x00, 0x58, 0x89,
+		0xc3, 0x50, 0x1b, 0x39, 0x14, 0xb5, 0x28, 0x4d, 0xaa, 0x33, 0xce, 0xe5, 0xb7, 0x51, 0xe5, 0x93,
+		0x72, 0xa8, 0x73, 0xcb, 0xd5, 0x94, 0xa8, 0x1f, 0x4d, 0xcb, 0x10, 0x3e, 0xc4, 0x55, 0x85, 0x59,
+		0xa9, 0x32, 0xb2, 0xf3, 0x9b, 0x33, 0x4d, 0x87, 0x52, 0x6c, 0x32, 0xdd, 0x75, 0x48, 0xc6, 0x39,
+		0x89, 0x65, 0x80, 0x5f, 0xae, 0x2b, 0x87, 0xf3, 0x9f, 0xd3, 0xc6, 0xac, 0xf0, 0xf6, 0xfb, 0xb8,
+		0x35, 0x10, 0x6a, 0x4d, 0xd9, 0x94, 0x5e, 0x8f, 0x4d, 0x69, 0xdb, 0x8e, 0x45, 0x4e, 0xcb, 0xdd,
+		0xc5, 0x2b, 0x65, 0xbb, 0x0b, 0xd7, 0xea, 0x96, 0x1a, 0xb3, 0xd0, 0x21, 0xff, 0x34, 0xc3, 0x10,
+		0x3b, 0xec, 0x9f, 0xc6, 0xd3, 0xc2, 0x87, 0xfe, 0xe5, 0x0e, 0xff, 0x97, 0x44, 0x1c, 0x91, 0x63,
+		0xa7, 0xcb, 0x58, 0x93, 0x7f, 0xfc, 0x74, 0x1d, 0xca, 0x14, 0x3d, 0x86, 0x0a, 0xa0, 0x04, 0x4b,
+		0x00, 0x25, 0x58, 0x02, 0x28, 0xc1, 0x12, 0xe0, 0x95, 0x08, 0x96, 0x87, 0x2a, 0x1f, 0xce, 0x26,
+		0x40, 0xde, 0x77, 0x31, 0xb6, 0xc4, 0xd1, 0x3d, 0x2a, 0x2e, 0x07, 0xeb, 0xc9, 0xe1, 0x5b, 0x6c,
+		0x45, 0x4e, 0x49, 0xa1, 0x36, 0x94, 0xc8, 0xf8, 0x5c, 0x45, 0xc6, 0xed, 0xe6, 0x41, 0x8b, 0xf2,
+		0x7b, 0xb2, 0x89, 0xa4, 0x88, 0x29, 0x22, 0x52, 0x9d, 0xc4, 0x4d, 0xff, 0x8c, 0x7c, 0x89, 0xe5,
+		0x94, 0xd9, 0x19, 0xf3, 0x20, 0xe6, 0x0b, 0xb3, 0x19, 0x90, 0x62, 0x35, 0xcb, 0xdd, 0xd2, 0x9b,
+		0x86, 0x31, 0xd2, 0xb6, 0x81, 0xe1, 0x25, 0x7b, 0x55, 0xc5, 0x4e, 0x55, 0xb0, 0x4f, 0x2d, 0xbd,
+		0x53, 0xc5, 0x5e, 0x55, 0xb1, 0x53, 0xed, 0x4a, 0x52, 0x7a, 0xbb, 0x8a, 0x9d, 0xaa, 0xe2, 0xf2,
+		0xa1, 0x80, 0x39, 0x14, 0xcb, 0x74, 0x4c, 0xa8, 0xe4, 0x60, 0xcb, 0x3c, 0xaf, 0x8a, 0xa1, 0x01,
+		0xe5, 0xa3, 0x90, 0x85, 0x42, 0x01, 0xc2, 0x75, 0xf3, 0x77, 0xe3, 0x54, 0xfe, 0xb5, 0xb0, 0x54,
+		0xfd, 0x89, 0x82, 0x02, 0xc2, 0xbe, 0xa8, 0x48, 0x65, 0x69, 0xa1, 0xb0, 0xe0, 0xe4, 0xbd, 0x2c,
+		0x37, 0xfe, 0xfc, 0xf8, 0x55, 0x74, 0xf2, 0x26, 0xa8, 0x48, 0xc5, 0x25, 0x67, 0xea, 0xde, 0x0c,
+		0x31, 0x09, 0xcb, 0x6a, 0x54, 0x5c, 0x4e, 0xf7, 0xfe, 0xe4, 0x42, 0x58, 0x4d, 0xfe, 0xca, 0x8c,
+		0xa6, 0xa8, 0xe6, 0xdd, 0x52, 0x9a, 0x77, 0x45, 0x34, 0xef, 0xbc, 0xb3, 0x8a, 0xc9, 0x4b, 0x43,
+		0xd6, 0x98, 0x50, 0x9d, 0x13, 0x46, 0xe0, 0xcb, 0x6b, 0xba, 0x73, 0xb5, 0x05, 0x27, 0x62, 0x45,
+		0xf6, 0x11, 0x0b, 0xfb, 0x84, 0xdb, 0x84, 0x22, 0xfa, 0x8c, 0x63, 0xd8, 0x09, 0x65, 0xd8, 0x1b,
+		0x22, 0x13, 0x37, 0x00, 0x4e, 0x28, 0x7c, 0xfe, 0xe5, 0x3d, 0xbc, 0x6d, 0xb5, 0xf6, 0x81, 0x4b,
+		0x15, 0xc0, 0x5d, 0x4b, 0x7d, 0x7a, 0x8d, 0xa2, 0x6b, 0xcd, 0x7d, 0x34, 0xc6, 0xe0, 0x61, 0x64,
+		0x81, 0x8f, 0xc7, 0x88, 0x32, 0x62, 0xfa, 0x80, 0x7c, 0x20, 0xc3, 0x63, 0xde, 0xbf, 0x2f, 0x61,
+		0xf7, 0x1a, 0x00, 0xff, 0xc6, 0x1e, 0xde, 0x05, 0xc2, 0xfa, 0xd4, 0xc3, 0x43, 0x1b, 0x9b, 0x2c,
+		0xaa, 0x1d, 0x0e, 0x82, 0xf8, 0xcc, 0x0b, 0x4d, 0x7a, 0xc9, 0x26, 0xf1, 0xc1, 0xc7, 0x0c, 0xae,
+		0x26, 0x80, 0x29, 0xba, 0xb2, 0x39, 0x50, 0x39, 0x5e, 0x9f, 0x5a, 0xc4, 0x8f, 0xff, 0x9a, 0xef,
+		0xa2, 0xe8, 0xe0, 0xc5, 0x6c, 0x59, 0xcb, 0x3b, 0x4b, 0xb4, 0x82, 0xc4, 0x0e, 0x5b, 0x5a, 0x4c,
+		0xc7, 0xd4, 0xc9, 0x50, 0x46, 0x5a, 0x2b, 0xc8, 0xbe, 0x0a, 0x6f, 0xbe, 0x8d, 0xf1, 0xab, 0xb9,
+		0xcd, 0x18, 0x0d, 0x7b, 0xd3, 0x5c, 0x44, 0x70, 0xbd, 0x24, 0x2c, 0x64, 0xf2, 0x96, 0xb2, 0xa5,
+		0x15, 0xc6, 0x34, 0x18, 0xe3, 0x28, 0xf3, 0xa1, 0xcc, 0x3a, 0x27, 0x6c, 0xa0, 0x2d, 0x23, 0x8d,
+		0xd1, 0x60, 0x2c, 0x4f, 0x19, 0x5f, 0x9d, 0x2f, 0x91, 0x2f, 0xa7, 0x90, 0x48, 0x64, 0xf0, 0x31,
+		0xfe, 0x7e, 0x51, 0x44, 0x02, 0x6a, 0xf2, 0xaa, 0x1f, 0x3e, 0xfd, 0x71, 0x5e, 0xa4, 0x72, 0x2b,
+		0xc4, 0xb1, 0x8f, 0x5f, 0xbe, 0x9e, 0x9c, 0xff, 0x2a, 0x29, 0xbc, 0xec, 0xca, 0xce, 0xcf, 0x89,
+		0x40, 0xb0, 0xc7, 0x6a, 0xd8, 0xe5, 0x83, 0x93, 0x76, 0x1f, 0x45, 0x4f, 0x8d, 0x87, 0x96, 0xcb,
+		0xe0, 0x57, 0xbd, 0xf8, 0x82, 0x74, 0xc1, 0x78, 0x5a, 0x99, 0x4e, 0x70, 0x67, 0x15, 0xd0, 0xdd,
+		0x93, 0xd7, 0xec, 0x90, 0x97, 0x94, 0x29, 0x17, 0xa4, 0xad, 0x24, 0x73, 0x1b, 0x9a, 0x33, 0xc5,
+		0xd6, 0xe1, 0xc1, 0x7e, 0x17, 0xc2, 0x0b, 0x80, 0x13, 0x76, 0xe4, 0xc3, 0xaf, 0x9e, 0x13, 0xb8,
+		0x70, 0x76, 0xf2, 0x33, 0xe8, 0xf3, 0x6c, 0xb0, 0x08, 0x7d, 0x97, 0x50, 0x53, 0x60, 0x01, 0xef,
+		0x67, 0xb3, 0xb4, 0x5b, 0xac, 0xad, 0x4d, 0xa8, 0x2a, 0xb0, 0xc8, 0x02, 0x0a, 0x4c, 0xa3, 0xf4,
+		0x43, 0x1f, 0xb6, 0xb4, 0x03, 0x06, 0x1b, 0xd2, 0x6a, 0x04, 0xf6, 0x88, 0x46, 0x86, 0x84, 0x5a,
+		0xf8, 0xbb, 0xbc, 0x9c, 0x98, 0x54, 0x2c, 0x26, 0x22, 0x7e, 0x99, 0xf8, 0x0c, 0x8f, 0x01, 0xf9,
+		0x3e, 0x19, 0x51, 0x6c, 0xc5, 0xe7, 0x5a, 0x60, 0xe8, 0x78, 0x80, 0x91, 0x79, 0x3d, 0x27, 0x29,
+		0xbe, 0x9f, 0xea, 0x96, 0x3e, 0x30, 0xa7, 0x4f, 0xc9, 0xf0, 0x84, 0x3f, 0x19, 0x9c, 0xab, 0xff,
+		0xc5, 0x66, 0xa8, 0x7d, 0x7e, 0x39, 0x3f, 0xbb, 0x98, 0xad, 0x31, 0x5f, 0x5c, 0x25, 0xbc, 0x01,
+		0x28, 0xe1, 0x4d, 0x9e, 0x5d, 0x14, 0x17, 0xc4, 0xf8, 0x69, 0xcb, 0xfd, 0x56, 0x01, 0x19, 0xec,
+		0xad, 0x44, 0x15, 0xb9, 0xe3, 0x97, 0xc9, 0xab, 0x00, 0x37, 0x2a, 0x12, 0xcf, 0x32, 0xef, 0xff,
+		0x94, 0x0f, 0xc1, 0xd8, 0x58, 0x2c, 0x47, 0xf9, 0x98, 0x0e, 0x49, 0xb2, 0x29, 0x1d, 0xf7, 0xb2,
+		0x34, 0x75, 0xed, 0xd6, 0x51, 0xfb, 0xe8, 0xe0, 0x6d, 0xeb, 0xa8, 0xf3, 0xfc, 0xe7, 0xf0, 0x69,
+		0xb9, 0xe4, 0x4b, 0x97, 0x13, 0x41, 0x5f, 0x2b, 0xe1, 0x28, 0xc1, 0x10, 0x00, 0xd6, 0x09, 0x86,
+		0x59, 0xf3, 0xf6, 0x2a, 0x25, 0x41, 0x1b, 0xf9, 0x2c, 0x3c, 0x7f, 0x37, 0x2a, 0x10, 0x1f, 0x93,
+		0xae, 0x5c, 0xd4, 0x68, 0x48, 0x7c, 0x60, 0x64, 0x8c, 0x7d, 0x86, 0xc6, 0x2e, 0x10, 0x6a, 0x11,
+		0x13, 0x31, 0x1c, 0xd9, 0xf3, 0xf8, 0xf7, 0x89, 0x11, 0x91, 0x3f, 0x2a, 0xb6, 0xe8, 0x45, 0x0f,
+		0xec, 0xd3, 0x45, 0xf3, 0x22, 0xd4, 0x70, 0x63, 0xd4, 0xd8, 0x85, 0xc0, 0xd5, 0x99, 0xa3, 0x5b,
+		0xce, 0x1d, 0x05, 0xe6, 0x21, 0xea, 0x13, 0xfe, 0xb0, 0x7a, 0x03, 0xf8, 0xb3, 0xfa, 0xd4, 0x9c,
+		0x93, 0x2b, 0xa3, 0x16, 0x86, 0xa7, 0xc8, 0x67, 0xef, 0xc3, 0x66, 0x53, 0xf2, 0x25, 0xff, 0xc9,
+		0x67, 0xdc, 0xae, 0xe3, 0x59, 0x7d, 0x4a, 0xd2, 0x82, 0x66, 0xa3, 0x4f, 0xd3, 0xb9, 0x3c, 0xc8,
+		0xac, 0xbf, 0xc9, 0x38, 0x80, 0x22, 0xea, 0xf8, 0xd8, 0x0c, 0x9f, 0xe3, 0x61, 0x3b, 0x44, 0xd3,
+		0x50, 0x90, 0xe5, 0x25, 0x7f, 0xa7, 0xe4, 0x3b, 0x7c, 0x74, 0x1d, 0xf3, 0x1a, 0x6a, 0xff, 0x1f,
+		0x51, 0x68, 0xee, 0x42, 0xf3, 0xe8, 0xad, 0x01, 0x86, 0xd1, 0x0d, 0xff, 0xc1, 0xef, 0x5f, 0xdf,
+		0xd7, 0x95, 0x2d, 0x12, 0x40, 0x89, 0xb3, 0x8f, 0x2a, 0xce, 0x72, 0x0a, 0x66, 0xc4, 0xbc, 0xf1,
+		0x0f, 0xda, 0x05, 0x64, 0xda, 0x43, 0x25, 0xd3, 0x56, 0x57, 0x1e, 0x7b, 0x2a, 0x99, 0xb6, 0xd8,
+		0x59, 0x98, 0xaa, 0xcf, 0xa6, 0x92, 0x6e, 0x37, 0xb9, 0x47, 0x25, 0xad, 0xa0, 0x33, 0x6e, 0xad,
+		0x64, 0x5d, 0x00, 0x28, 0x68, 0x04, 0x4d, 0xcd, 0xe2, 0xab, 0x94, 0x7c, 0x1d, 0x17, 0x7b, 0x85,
+		0xfd, 0xe5, 0xe9, 0xca, 0xc5, 0xdd, 0xe5, 0x66, 0xe0, 0x79, 0x98, 0x32, 0x70, 0xdc, 0xd8, 0x99,
+		0x87, 0xec, 0x75, 0xae, 0xf3, 0x48, 0xe0, 0x8c, 0x1d, 0xe6, 0x30, 0xe7, 0x2f, 0x5f, 0x70, 0x95,
+		0x7f, 0x72, 0xb1, 0x17, 0x7b, 0xca, 0x95, 0x00, 0x09, 0xa0, 0x04, 0x48, 0x00, 0xe5, 0xcc, 0x06,
+		0x80, 0xa7, 0x72, 0x66, 0x37, 0x8b, 0x3b, 0xb3, 0x5b, 0x65, 0x9c, 0xd9, 0xfb, 0x69, 0x67, 0x76,
+		0x81, 0xfa, 0xed, 0xb0, 0xdf, 0xe7, 0xbf, 0x9d, 0x17, 0x7c, 0x7e, 0x27, 0xea, 0xfc, 0xe7, 0xb3,
+		0xe3, 0xf3, 0xaf, 0x45, 0xea, 0x1f, 0xf0, 0xfa, 0xe7, 0x9f, 0xbe, 0x5e, 0x5e, 0x7c, 0xfe, 0xf8,
+		0xe5, 0x63, 0xb1, 0x36, 0xde, 0xf2, 0x36, 0x4e, 0x3f, 0xfd, 0xf1, 0xf1, 0xf3, 0xe5, 0xe9, 0xf1,
+		0xff, 0x7c, 0xfc, 0x7c, 0x19, 0x4e, 0x66, 0x75, 0x3d, 0xfb, 0xd1, 0x64, 0x09, 0x25, 0x65, 0x5c,
+		0x51, 0xfb, 0x8f, 0xf3, 0x82, 0x9e, 0xfd, 0xa5, 0x19, 0x92, 0xf2, 0x4f, 0xcc, 0x36, 0x74, 0x6a,
+		0xb1, 0x84, 0xae, 0x0c, 0x5a, 0x9e, 0xbc, 0x69, 0x80, 0xc2, 0x7e, 0x91, 0x00, 0x85, 0x98, 0x58,
+		0xbb, 0xd0, 0x2e, 0x1a, 0xde, 0xd0, 0x54, 0xe1, 0x0d, 0x6b, 0x5f, 0xdb, 0x17, 0xec, 0x67, 0xa2,
+		0x8b, 0x12, 0xec, 0x01, 0xa0, 0xa0, 0x60, 0x9f, 0x9a, 0xc5, 0x97, 0x27, 0xd8, 0x97, 0x0a, 0xa6,
+		0x15, 0xbc, 0x9c, 0x49, 0xfc, 0x92, 0xa6, 0xe8, 0xfa, 0x03, 0x81, 0x18, 0x6e, 0x10, 0xb8, 0xac,
+		0xe9, 0xc2, 0xf1, 0xd8, 0xe5, 0x97, 0xb0, 0xb1, 0x2d, 0x04, 0xa6, 0xdf, 0xda, 0x88, 0x4a, 0xdc,
+		0xb2, 0x1b, 0x15, 0x97, 0x0b, 0x4c, 0x0f, 0x28, 0x43, 0xa3, 0x11, 0xb6, 0x00, 0x51, 0x0b, 0xe2,
+		0x8f, 0x61, 0x3b, 0xe0, 0x62, 0x0f, 0x04, 0xce, 0x55, 0x48, 0xc7, 0xa9, 0xab, 0x13, 0xe2, 0xcf,
+		0x2e, 0x4e, 0x3d, 0xa2, 0x0b, 0x79, 0x8d, 0x3b, 0xae, 0x57, 0x4c, 0xd9, 0x1e, 0x07, 0x36, 0x23,
+		0xae, 0x8d, 0xe7, 0xa8, 0xf2, 0x25, 0x68, 0xc7, 0x42, 0xc4, 0x5a, 0x94, 0x68, 0x4b, 0x13, 0x6f,
+		0x69, 0x22, 0x2e, 0x4c, 0xcc, 0xe2, 0x1c, 0x05, 0x1e, 0xc5, 0xb1, 0x22, 0x9e, 0x41, 0x6b, 0x09,
+		0x0b, 0x65, 0x22, 0x85, 0x92, 0x8c, 0x5a, 0xd1, 0xc9, 0xcf, 0x46, 0x63, 0xcf, 0xbf, 0xeb, 0x72,
+		0x5a, 0x4f, 0xde, 0x73, 0xf3, 0x68, 0xc9, 0xce, 0x88, 0x54, 0x5e, 0xad, 0xb4, 0x4f, 0x46, 0x2e,
+		0xbf, 0xd6, 0x46, 0xf3, 0x6c, 0x15, 0xcb, 0xb7, 0x25, 0x4e, 0x51, 0x02, 0x73, 0x37, 0x65, 0x95,
+		0xf2, 0x38, 0x38, 0xad, 0x59, 0x0c, 0x09, 0x39, 0x15, 0x84, 0x01, 0x97, 0x49, 0x3b, 0xe0, 0x22,
+		0xf3, 0x06, 0x33, 0x09, 0x33, 0xe6, 0x10, 0x05, 0x36, 0x93, 0xd2, 0x1e, 0xb4, 0xa6, 0x18, 0xd5,
+		0x0d, 0x14, 0x22, 0x2b, 0x44, 0x56, 0x88, 0x5c, 0x14, 0x77, 0x2a, 0xa8, 0x1b, 0x89, 0x0a, 0x5a,
+		0x42, 0xba, 0xd1, 0x7f, 0xc2, 0xc6, 0x1e, 0xe5, 0x1a, 0xdf, 0xdf, 0xf0, 0x64, 0xe1, 0x4e, 0x47,
+		0x10, 0xba, 0x39, 0x48, 0x8c, 0x21, 0x8a, 0x33, 0xc0, 0x52, 0x0c, 0x4f, 0x8c, 0xc1, 0x6d, 0xfd,
+		0x26, 0xe3, 0x1c, 0xc5, 0x4f, 0x64, 0xe9, 0xcb, 0x5c, 0xe9, 0xea, 0x39, 0x19, 0x26, 0xb2, 0x29,
+		0x1c, 0x84, 0xa5, 0xc4, 0xae, 0x74, 0xe5, 0xc6, 0x17, 0x5e, 0x3c, 0x71, 0xd2, 0x45, 0xc3, 0x4c,
+		0x22, 0xc6, 0x86, 0xe8, 0xca, 0x23, 0xa6, 0xba, 0xc5, 0xf8, 0x65, 0xdd, 0x62, 0x2c, 0xe6, 0xa3,
+		0x12, 0xf1, 0x49, 0x89, 0xf9, 0xa0, 0xe4, 0x7c, 0x4e, 0xd1, 0x81, 0xc9, 0x80, 0x5a, 0x78, 0x48,
+		0xa8, 0x90, 0x68, 0xa8, 0x35, 0x13, 0x1e, 0x28, 0x52, 0xb8, 0x15, 0x25, 0xf3, 0x21, 0x14, 0x97,
+		0xcb, 0xc2, 0x2b, 0xee, 0x1b, 0x89, 0xba, 0x26, 0xe4, 0x7d, 0x8c, 0x3b, 0x26, 0xe4, 0xf9, 0x48,
+		0xcd, 0x51, 0xde, 0x51, 0x46, 0x69, 0x4e, 0x22, 0x04, 0x46, 0xd9, 0xe9, 0x21, 0x84, 0xd2, 0x42,
+		0x14, 0x4f, 0x07, 0x21, 0x7c, 0xc5, 0x74, 0x4b, 0xa1, 0x4f, 0x49, 0xf4, 0xc9, 0xbf, 0x62, 0x3a,
+		0xca, 0xb5, 0x2e, 0x93, 0xa7, 0x73, 0x56, 0x45, 0xce, 0x2c, 0x1b, 0x85, 0xa9, 0xa4, 0x2f, 0x27,
+		0xec, 0x42, 0xe0, 0xde, 0xf3, 0x00, 0x69, 0x95, 0xb1, 0x53, 0x25, 0x79, 0x2f, 0x91, 0x7c, 0x79,
+		0x0b, 0x6e, 0x8a, 0xe8, 0x48, 0x81, 0xfc, 0xe6, 0x58, 0xa8, 0x27, 0xb7, 0x43, 0x78, 0x28, 0x1c,
+		0xdc, 0xd0, 0xf0, 0xc4, 0x00, 0x19, 0xe3, 0xb4, 0x54, 0x77, 0x87, 0x7c, 0x10, 0x6e, 0x56, 0x6d,
+		0x97, 0xd7, 0xb3, 0x5d, 0x2c, 0xc4, 0xb0, 0x8e, 0xa8, 0xa5, 0x73, 0x8a, 0xd9, 0x74, 0xca, 0xf2,
+		0x0b, 0xc4, 0x18, 0xf6, 0xa8, 0xb0, 0x91, 0x4d, 0xeb, 0xf7, 0xad, 0x1f, 0xed, 0x07, 0x9d, 0xbf,
+		0xb5, 0x92, 0xb7, 0xaf, 0xd1, 0x5b, 0x77, 0xee, 0xad, 0xd6, 0xef, 0x37, 0xfa, 0x7d, 0xeb, 0xa7,
+		0xfa, 0xbb, 0xda, 0x9f, 0xf7, 0xbd, 0x7e, 0xff, 0xa7, 0x7e, 0x5f, 0x1f, 0xcc, 0x95, 0xa8, 0x6b,
+		0xdb, 0x4b, 0x9c, 0xf5, 0x34, 0x0a, 0x68, 0x9e, 0x83, 0x36, 0x4f, 0x03, 0xcd, 0xf0, 0xc9, 0x0a,
+		0x4a, 0x7d, 0x61, 0x3b, 0xdc, 0xd4, 0x93, 0x2f, 0xf9, 0x4d, 0x8b, 0x8a, 0x49, 0x7f, 0x01, 0x25,
+		0x7f, 0x05, 0x18, 0xa2, 0x14, 0xca, 0x43, 0x12, 0x9f, 0xa6, 0x9f, 0x01, 0x98, 0x52, 0x44, 0x9f,
+		0x5a, 0x14, 0x14, 0x57, 0x32, 0x2d, 0x67, 0x8c, 0x08, 0xcd, 0xbb, 0x65, 0x43, 0x04, 0x48, 0x44,
+		0xef, 0x3c, 0x10, 0xbb, 0x0b, 0x45, 0x9c, 0x4d, 0x48, 0xde, 0x6d, 0x50, 0xf8, 0x44, 0x87, 0xfc,
+		0x09, 0x8e, 0x07, 0xb1, 0x4b, 0x5c, 0xe4, 0x87, 0xda, 0xea, 0xec, 0x57, 0x6f, 0xb0, 0x05, 0xf1,
+		0x39, 0xc3, 0xff, 0x22, 0xcc, 0x92, 0xb4, 0x5a, 0xad, 0xd6, 0x43, 0xfa, 0xdf, 0xc7, 0xfa, 0x9f,
+		0x86, 0x7e, 0x74, 0x39, 0x48, 0xfd, 0xd1, 0xef, 0xeb, 0x97, 0x83, 0xfa, 0x0f, 0x63, 0xf7, 0xa0,
+		0xf9, 0x50, 0x7f, 0x37, 0xfb, 0x7e, 0xd0, 0xef, 0x37, 0xea, 0x6f, 0x8a, 0xd4, 0x7a, 0x57, 0xbf,
+		0xef, 0xf7, 0x1b, 0xda, 0x8e, 0x1c, 0xa3, 0x92, 0x02, 0x6d, 0x17, 0x65, 0xa8, 0x72, 0x8b, 0xb0,
+		0x1d, 0x16, 0x16, 0x03, 0xee, 0x63, 0x88, 0xb8, 0x0b, 0xf0, 0x3a, 0x84, 0x8e, 0x1a, 0x70, 0x1c,
+		0x43, 0x36, 0x98, 0x88, 0x82, 0x8b, 0x3c, 0x46, 0x4c, 0xe2, 0x86, 0x87, 0x01, 0x28, 0x18, 0x7a,
+		0x33, 0x29, 0x58, 0x5a, 0xbb, 0x57, 0x90, 0xbe, 0x75, 0xed, 0x9e, 0x2f, 0x15, 0xb6, 0x62, 0x31,
+		0x44, 0x5c, 0x89, 0x99, 0xaf, 0x26, 0xa7, 0xc3, 0x5c, 0x84, 0x75, 0xa7, 0xc6, 0xe8, 0xa9, 0x3c,
+		0xc0, 0xe9, 0x6a, 0x14, 0x20, 0xcf, 0x02, 0x0f, 0x11, 0x1b, 0xee, 0x88, 0x6d, 0x83, 0x87, 0xff,
+		0x0a, 0x88, 0xc7, 0xf5, 0x1c, 0xc4, 0xf8, 0x81, 0x64, 0xe2, 0x47, 0x97, 0x7c, 0x02, 0xf1, 0xa7,
+		0xe9, 0x5d, 0xef, 0xae, 0x71, 0x64, 0xd0, 0x8e, 0xc9, 0x4e, 0xe7, 0x86, 0x03, 0xb0, 0x89, 0xcf,
+		0xc2, 0xd3, 0x27, 0x88, 0x81, 0x8d, 0xb9, 0xda, 0xd4, 0x04, 0x9c, 0xf8, 0x27, 0x94, 0x76, 0xa4,
+		0xb4, 0xa3, 0x47, 0xba, 0x31, 0xce, 0xbf, 0xeb, 0x4e, 0x65, 0xfc, 0xee, 0x4c, 0x66, 0xde, 0x82,
+		0x41, 0x22, 0x4d, 0xfe, 0x72, 0x3b, 0x79, 0x5a, 0x4b, 0x6e, 0x23, 0x7f, 0x8d, 0x8d, 0x74, 0xe0,
+		0x44, 0xfb, 0xcf, 0x76, 0x4c, 0x7e, 0x2c, 0x2c, 0x1c, 0x23, 0x30, 0x07, 0x70, 0x18, 0xf5, 0x4d,
+		0xfc, 0xeb, 0xe9, 0xee, 0xc4, 0x16, 0xd8, 0x84, 0xde, 0xf4, 0xe9, 0x09, 0x85, 0x61, 0xc0, 0x82,
+		0x70, 0x67, 0x63, 0x0f, 0xc3, 0x18, 0x4d, 0xe0, 0x0a, 0xc3, 0xd8, 0x89, 0xf6, 0x3a, 0x85, 0x66,
+		0xd4, 0x32, 0x73, 0xc0, 0xf5, 0x9c, 0x5b, 0x62, 0x61, 0xf0, 0xb0, 0x15, 0x50, 0x0b, 0x51, 0x73,
+		0xd2, 0xe8, 0xd3, 0x5f, 0x62, 0xd5, 0x81, 0x50, 0xc2, 0x08, 0xb2, 0xe1, 0x16, 0x7b, 0x3e, 0x09,
+		0xbb, 0x41, 0x7c, 0xe0, 0x67, 0xcf, 0xc8, 0x98, 0x30, 0x6c, 0xf1, 0xfa, 0x4d, 0x15, 0xca, 0xf9,
+		0xea, 0x43, 0x39, 0x53, 0xde, 0x71, 0xf9, 0x38, 0xa6, 0x74, 0xe5, 0x62, 0xa1, 0x4c, 0x17, 0xe1,
+		0xad, 0xe2, 0x68, 0x84, 0x93, 0x3c, 0x72, 0x81, 0x8f, 0xad, 0x50, 0xfd, 0x8d, 0x0d, 0x76, 0x61,
+		0x76, 0x5f, 0x07, 0x54, 0x9c, 0xa7, 0xe0, 0x4b, 0x45, 0x15, 0xc9, 0xf2, 0xbb, 0x12, 0x7c, 0xaf,
+		0x08, 0xff, 0x5b, 0xe2, 0x83, 0xe9, 0xa8, 0xa2, 0xe8, 0x2c, 0xc2, 0x5d, 0x37, 0xbd, 0xb1, 0x1e,
+		0x31, 0xa2, 0x71, 0x21, 0x3c, 0x46, 0x1e, 0x0f, 0x44, 0xc2, 0x6b, 0xc4, 0x20, 0x21, 0x6a, 0x69,
+		0x09, 0x15, 0x62, 0x7d, 0xeb, 0x58, 0xe1, 0x81, 0xc2, 0x83, 0xd7, 0x84, 0x07, 0xf3, 0x1b, 0xab,
+		0x12, 0xc1, 0x86, 0x45, 0xc3, 0xeb, 0xe4, 0xc2, 0xec, 0xd2, 0x36, 0xcc, 0x72, 0xf7, 0x39, 0x6f,
+		0xfc, 0xf2, 0xe6, 0xc7, 0x0e, 0xc6, 0x4c, 0xd9, 0xa7, 0xf6, 0x24, 0x74, 0x13, 0x10, 0xf1, 0x8e,
+		0x84, 0x6f, 0xdc, 0xfc, 0x70, 0x79, 0x11, 0xb5, 0x7c, 0x91, 0x7b, 0xf7, 0x4b, 0xf5, 0x5c, 0x44,
+		0xb9, 0xf6, 0x3b, 0xb9, 0xa9, 0x28, 0xe3, 0x2d, 0xe2, 0x31, 0xb3, 0xf9, 0x16, 0xc7, 0xb0, 0x94,
+		0x98, 0xa9, 0x11, 0x45, 0x66, 0x1b, 0x67, 0x08, 0xff, 0x39, 0x3d, 0x3e, 0x57, 0xf6, 0xc3, 0xea,
+		0xdb, 0x0f, 0x2d, 0x89, 0xeb, 0xd3, 0x66, 0xee, 0x23, 0xe1, 0x1b, 0xc8, 0x16, 0x09, 0xc4, 0x76,
+		0xe8, 0x08, 0x52, 0xd5, 0x23, 0x6b, 0xa0, 0xba, 0x99, 0x5d, 0xdd, 0xcc, 0xae, 0x6e, 0x66, 0xdf,
+		0xa2, 0x63, 0xaf, 0xb8, 0x83, 0x4f, 0x70, 0xa1, 0x0b, 0x7b, 0x37, 0x97, 0xa7, 0xc4, 0x68, 0xb5,
+		0xd5, 0xdd, 0xec, 0x82, 0xf5, 0x33, 0x56, 0x45, 0xb3, 0x88, 0xef, 0xda, 0x68, 0xa2, 0xc7, 0x32,
+		0x84, 0x28, 0xb4, 0xa7, 0x6b, 0xc9, 0x61, 0x7b, 0x5c, 0x15, 0x78, 0x55, 0x60, 0x0e, 0x57, 0x87,
+		0x81, 0x50, 0xf8, 0xf5, 0xf7, 0x13, 0x70, 0x3c, 0x78, 0x7f, 0x7a, 0xa2, 0x60, 0x5e, 0xc1, 0xbc,
+		0x82, 0x79, 0x05, 0xf3, 0xbc, 0x5b, 0x87, 0x86, 0x02, 0xf9, 0x0d, 0x80, 0xbc, 0x1f, 0x5c, 0x51,
+		0x2c, 0xe1, 0x2d, 0x8c, 0xcb, 0xcb, 0x01, 0xfb, 0x39, 0x66, 0x77, 0x8e, 0x77, 0x03, 0x51, 0x65,
+		0x3f, 0x34, 0x71, 0x72, 0xfd, 0x4e, 0xb9, 0xe1, 0x95, 0x1b, 0x3e, 0x99, 0x67, 0xe2, 0xde, 0xb6,
+		0x75, 0xe1, 0xb9, 0xd8, 0x6e, 0x88, 0x72, 0xad, 0xd6, 0xe3, 0x51, 0x5b, 0xf7, 0xbd, 0xa6, 0x7e,
+		0x34, 0x88, 0x3e, 0x36, 0xc3, 0xb7, 0xe8, 0x73, 0xab, 0x67, 0xe8, 0xed, 0xe4, 0x73, 0xa7, 0x67,
+		0xe8, 0x9d, 0x41, 0x9d, 0xc7, 0x85, 0xfd, 0xd8, 0x7f, 0x90, 0xaf, 0xb8, 0x17, 0x3f, 0xac, 0x7e,
+		0x5f, 0xeb, 0x35, 0xf5, 0xd6, 0x20, 0xf9, 0x63, 0xbf, 0x67, 0xe8, 0xad, 0x41, 0xbd, 0x7c, 0x88,
+		0xf3, 0x16, 0xcd, 0x93, 0x4d, 0x79, 0xf3, 0x64, 0xa1, 0x34, 0x18, 0x92, 0x16, 0xcb, 0xc2, 0x49,
+		0xa7, 0xb2, 0xc2, 0x9e, 0x97, 0x08, 0x36, 0xa9, 0x20, 0x07, 0x85, 0xec, 0x1a, 0x87, 0xd0, 0x07,
+		0x27, 0x1f, 0x14, 0xfa, 0x29, 0xf4, 0x13, 0x30, 0x91, 0xae, 0xa2, 0x09, 0x81, 0x84, 0x95, 0x92,
+		0xd7, 0x16, 0x3c, 0x96, 0x24, 0x6b, 0x28, 0x49, 0x76, 0x71, 0x4a, 0xda, 0xc6, 0xd1, 0x81, 0x92,
+		0x65, 0x45, 0x21, 0xbc, 0x48, 0x6e, 0x8c, 0x6c, 0xa8, 0x56, 0x89, 0x30, 0x96, 0x9d, 0x4c, 0x39,
+		0x78, 0x94, 0xe7, 0x5d, 0xe2, 0xe9, 0x4f, 0x84, 0xfd, 0x4a, 0x3b, 0x19, 0x23, 0x4b, 0x56, 0x70,
+		0xdd, 0x59, 0xa3, 0xec, 0xb5, 0xcb, 0x5f, 0xb3, 0x42, 0x6b, 0x95, 0xbd, 0x46, 0x8b, 0x23, 0xc8,
+		0x59, 0x93, 0xdc, 0xb5, 0xd0, 0x76, 0x77, 0x24, 0xa7, 0x5f, 0xdb, 0x59, 0x3d, 0xbf, 0xa9, 0x9e,
+		0x25, 0x13, 0x3a, 0x76, 0x2c, 0x6c, 0x2f, 0xf5, 0x6b, 0xf1, 0xac, 0x40, 0x54, 0x6a, 0x77, 0x27,
+		0x4b, 0xb0, 0x39, 0x86, 0xb0, 0x14, 0x38, 0xc3, 0xd5, 0xc7, 0xb9, 0xd6, 0xf8, 0xec, 0xd6, 0x8a,
+		0x2f, 0x59, 0xe2, 0xca, 0xac, 0x7f, 0xe3, 0x55, 0xb3, 0x93, 0x23, 0x8e, 0x08, 0x8b, 0x1f, 0xc2,
+		0xe2, 0xc6, 0xbc, 0x78, 0x31, 0xd6, 0x24, 0x69, 0x7c, 0x9d, 0x8f, 0x4d, 0x9b, 0xa6, 0x49, 0xc8,
+		0xf7, 0xb1, 0xce, 0x8a, 0x8a, 0x3a, 0x5a, 0xc7, 0xc8, 0xe5, 0x8b, 0x15, 0x26, 0x68, 0x4e, 0x25,
+		0x64, 0xe8, 0x42, 0x64, 0x59, 0xd3, 0x33, 0x0d, 0x6c, 0x8f, 0xea, 0x81, 0xcd, 0x34, 0xa9, 0x56,
+		0xd4, 0x03, 0x9b, 0x65, 0x32, 0xdd, 0xb0, 0x07, 0x76, 0xba, 0x76, 0xfa, 0x0d, 0x9e, 0x64, 0x16,
+		0x5d, 0x4d, 0x31, 0x61, 0x35, 0x79, 0x2d, 0xe6, 0x06, 0x4f, 0x80, 0x50, 0x98, 0x12, 0x52, 0x6e,
+		0x4e, 0x8f, 0x45, 0xda, 0x51, 0x66, 0xfa, 0xe2, 0xb4, 0x26, 0x4d, 0x73, 0xd9, 0xb4, 0x97, 0x43,
+		0x83, 0x25, 0x14, 0x9b, 0x09, 0xa2, 0x23, 0x7d, 0x76, 0xc4, 0x47, 0xd9, 0xeb, 0xab, 0x26, 0xcf,
+		0x3f, 0x9e, 0x96, 0xf3, 0xfa, 0x74, 0x9c, 0x6d, 0xd8, 0x26, 0xe3, 0x53, 0xa5, 0x97, 0x83, 0xf9,
+		0x63, 0xa7, 0x8d, 0xc1, 0x1b, 0x51, 0xac, 0x69, 0xdc, 0x37, 0x1a, 0xf7, 0xbd, 0x6f, 0xdf, 0xff,
+		0x3b, 0x68, 0xbc, 0xb9, 0x6f, 0xf4, 0xbe, 0x8d, 0xcf, 0xc2, 0x0f, 0x8d, 0xde, 0x37, 0xfb, 0x74,
+		0xd0, 0x78, 0xa3, 0x3d, 0x89, 0x1f, 0xe2, 0x36, 0x26, 0x13, 0x51, 0xe3, 0x5b, 0x58, 0x5c, 0x9e,
+		0x69, 0x85, 0xf5, 0xe6, 0xb8, 0x95, 0x08, 0xf7, 0x53, 0x0c, 0xeb, 0xb9, 0x32, 0x2c, 0x89, 0xeb,
+		0xa1, 0x94, 0x0f, 0x3a, 0x1b, 0xc0, 0x15, 0x4f, 0x5b, 0x9a, 0x92, 0x96, 0xa1, 0x9c, 0xd0, 0xa2,
+		0xf5, 0x0b, 0x19, 0xee, 0x44, 0x50, 0x5a, 0x99, 0xef, 0x66, 0x26, 0xa3, 0xc8, 0x52, 0xb3, 0x37,
+		0xf7, 0x47, 0x9e, 0x65, 0x00, 0x32, 0x0c, 0x4a, 0x67, 0xbc, 0x85, 0xcb, 0xf4, 0xe7, 0xe3, 0x69,
+		0x6b, 0x25, 0xc2, 0xc6, 0x45, 0x62, 0x86, 0x25, 0x62, 0x85, 0x4b, 0xc5, 0x08, 0x3f, 0x6a, 0x7a,
+		0xa1, 0xd7, 0x6d, 0xc9, 0x10, 0x4f, 0x2f, 0x94, 0xcb, 0x58, 0x55, 0x66, 0xa1, 0x4a, 0x65, 0x16,
+		0x12, 0x8f, 0xb9, 0x7d, 0x0e, 0xa9, 0x85, 0xca, 0x20, 0x9b, 0x48, 0xcc, 0xac, 0x4c, 0xac, 0xec,
+		0xa6, 0x62, 0x64, 0x15, 0xcc, 0x29, 0x98, 0x53, 0x30, 0x57, 0x12, 0xe6, 0x0e, 0x8d, 0xea, 0x8d,
+		0xf5, 0x09, 0x40, 0xce, 0x25, 0x2e, 0xb6, 0xa3, 0x44, 0xe6, 0x39, 0x00, 0x37, 0x2d, 0x29, 0x06,
+		0x6e, 0x17, 0x71, 0x71, 0x30, 0x43, 0x34, 0x0a, 0xa2, 0x2c, 0xf6, 0xa0, 0x83, 0x15, 0x20, 0x1b,
+		0x1c, 0x0f, 0xfe, 0x0a, 0x50, 0x25, 0xe4, 0xb8, 0x95, 0x7e, 0xc7, 0x8a, 0x03, 0xdc, 0x2a, 0xbf,
+		0x64, 0x31, 0x80, 0x7b, 0x8d, 0xf7, 0x15, 0x84, 0x29, 0x97, 0x85, 0x6f, 0x2b, 0xe0, 0xf4, 0x2a,
+		0x7c, 0x5b, 0x41, 0x48, 0xd4, 0x8f, 0x75, 0x59, 0x41, 0xd8, 0x33, 0xb1, 0xc8, 0xcd, 0xb0, 0x5f,
+		0xa2, 0x77, 0x15, 0x44, 0xf3, 0xf3, 0x34, 0x37, 0x15, 0x64, 0xe6, 0xbb, 0x9a, 0x61, 0xd1, 0xfa,
+		0x33, 0xe4, 0xcb, 0xb1, 0x0c, 0xbc, 0x70, 0xe4, 0xdd, 0x14, 0xca, 0x4d, 0xdb, 0x54, 0xa0, 0xb3,
+		0x6d, 0xd0, 0xc9, 0xbf, 0xa6, 0x40, 0x22, 0xc5, 0x53, 0x81, 0xd4, 0x4e, 0x8b, 0x34, 0x32, 0xf3,
+		0x69, 0x26, 0xd7, 0x15, 0xf0, 0xb6, 0x40, 0x07, 0xf7, 0x7a, 0xe2, 0x13, 0x9e, 0x07, 0x2d, 0xc9,
+		0x8c, 0x26, 0x96, 0x23, 0xb1, 0x12, 0xd1, 0xbe, 0xe3, 0x17, 0x18, 0xed, 0x3b, 0xae, 0x9e, 0x53,
+		0x3c, 0x20, 0x94, 0x1d, 0x4a, 0xb8, 0x0d, 0x3a, 0x2a, 0xdc, 0x57, 0xf0, 0xf5, 0x1c, 0x9c, 0x06,
+		0x9d, 0x8e, 0x72, 0x1a, 0x08, 0xd6, 0xcf, 0x3c, 0x9e, 0xac, 0x12, 0x4f, 0x88, 0xa1, 0x8d, 0xf2,
+		0x1c, 0x3f, 0x0e, 0xaa, 0x2b, 0x6f, 0xf0, 0xeb, 0x06, 0x76, 0x95, 0x78, 0x62, 0x53, 0xc8, 0xae,
+		0x12, 0x4f, 0x28, 0x98, 0x57, 0x30, 0xaf, 0x60, 0x5e, 0x25, 0x9e, 0x78, 0xb9, 0x20, 0x3f, 0x46,
+		0xdf, 0xf5, 0x38, 0x91, 0xa6, 0x38, 0xc6, 0xa7, 0x2b, 0xc9, 0x41, 0xfc, 0x31, 0xf8, 0xae, 0x4d,
+		0x78, 0xac, 0x6d, 0x78, 0x67, 0x89, 0x45, 0xc2, 0x94, 0xf2, 0xc9, 0x2d, 0x93, 0x40, 0x28, 0x30,
+		0x27, 0x49, 0xec, 0xe9, 0x37, 0xfa, 0x94, 0xa7, 0xb6, 0xb7, 0xf0, 0x10, 0x05, 0x36, 0x8b, 0x83,
+		0x46, 0x0d, 0x20, 0xd4, 0x22, 0x26, 0x62, 0xd8, 0x4f, 0xd5, 0xf3, 0x81, 0x3a, 0xd3, 0x3c, 0xbe,
+		0xe4, 0x6f, 0x74, 0x65, 0x0b, 0x30, 0x9f, 0xb0, 0x59, 0xa1, 0xbd, 0xae, 0x19, 0xda, 0x4e, 0x89,
+		0x10, 0x63, 0x65, 0x55, 0xda, 0x12, 0x63, 0x52, 0x56, 0xa5, 0x55, 0x2f, 0x65, 0x55, 0x92, 0x5c,
+		0xe5, 0xcd, 0x71, 0xa5, 0xa6, 0x3a, 0x42, 0x2e, 0x5a, 0x3f, 0x33, 0x1d, 0x92, 0x8b, 0xb1, 0xe5,
+		0x4b, 0xa4, 0x43, 0x8a, 0xca, 0xcb, 0xf1, 0xa2, 0x90, 0x73, 0x84, 0x35, 0x95, 0x53, 0x40, 0xc1,
+		0x77, 0x32, 0xcf, 0x91, 0x43, 0x89, 0x4d, 0x24, 0xef, 0x22, 0x12, 0x41, 0xf1, 0x93, 0xb8, 0xe9,
+		0x9f, 0x91, 0x8f, 0xe5, 0xaf, 0x3b, 0x10, 0xa1, 0xd4, 0x79, 0x3c, 0xf2, 0x85, 0x39, 0x06, 0x48,
+		0x71, 0x8d, 0xe5, 0x6e, 0xe9, 0x4d, 0xc3, 0x18, 0x69, 0xdb, 0x80, 0xe3, 0x92, 0xbd, 0xaa, 0x62,
+		0xa7, 0x2a, 0xd8, 0xa7, 0x96, 0xde, 0xa9, 0x62, 0xaf, 0xaa, 0xd8, 0xa9, 0x76, 0x25, 0x29, 0xbd,
+		0x5d, 0xc5, 0x4e, 0x55, 0x71, 0xf9, 0x50, 0xc0, 0x1c, 0x8a, 0x47, 0x9b, 0xbe, 0x17, 0x64, 0xb0,
+		0x65, 0x3e, 0xf6, 0xaa, 0xd2, 0xc3, 0x15, 0x39, 0xa2, 0x94, 0x1f, 0x41, 0xa2, 0x0e, 0x28, 0x65,
+		0x1f, 0x50, 0xca, 0xb9, 0xdc, 0x43, 0xfc, 0x6c, 0xd2, 0xfa, 0xcb, 0x3c, 0xa4, 0xae, 0xd1, 0x0d,
+		0x7b, 0x25, 0x73, 0x03, 0xfa, 0xb4, 0xc2, 0x0b, 0xba, 0x07, 0xfd, 0x55, 0xc7, 0x9a, 0x89, 0x07,
+		0xaf, 0x8a, 0xa7, 0xb7, 0x50, 0xa1, 0xfc, 0x15, 0x3b, 0xb1, 0x64, 0xa8, 0xbb, 0xd0, 0x37, 0x92,
+		0x57, 0xa2, 0x78, 0x3e, 0x89, 0xc1, 0x16, 0x32, 0xc4, 0xad, 0xc1, 0xe2, 0x97, 0x9c, 0x27, 0x6e,
+		0x05, 0x4f, 0x95, 0xc8, 0x19, 0xb7, 0xc4, 0x46, 0xd7, 0x66, 0x8f, 0xdb, 0x49, 0xf5, 0x7a, 0x5d,
+		0x6f, 0x35, 0xe2, 0xff, 0x82, 0x6e, 0xf0, 0x67, 0xc7, 0x59, 0x06, 0xf0, 0xc5, 0x11, 0x68, 0xbb,
+		0x3b, 0x6b, 0x3a, 0xf7, 0x01, 0xdf, 0x12, 0x13, 0x6b, 0xd1, 0x03, 0x77, 0x1e, 0xfe, 0x0f, 0x00,
+		0x00, 0xff, 0xff, 0x03, 0x00, 0xf1, 0x46, 0x19, 0x0e, 0xea, 0x73, 0x01, 0x00,
 	}
 )
 
@@ -13,6 +13,23 @@ import (
 	"testing"
 )
 
+// mustViolation is the path and the message of a must violation
+type mustViolation struct {
+	path    string
+	message string
+}
+
+func assertMustViolations(t *testing.T, err error, expected []mustViolation) {
+	violations, ok := err.(navigator.MustViolations)
+	if !assert.True(t, ok, err) || !assert.Len(t, violations, len(expected)) {
+		return
+	}
+	for i, violation := range violations {
+		assert.Equal(t, expected[i].path, violation.Path)
+		assert.Equal(t, expected[i].message, violation.Error())
+	}
+}
+
 func Test_WalkAndValidateMustSucceed(t *testing.T) {
 	sampleConfig, err := ioutil.ReadFile("../examples/full-config-example-1.json")
 	if err != nil {
@@ -54,7 +71,10 @@ func Test_WalkAndValidateMustFailPortChannel(t *testing.T) {
 	ynn, ynnOk := nn.(*navigator.YangNodeNavigator)
 	assert.True(t, ynnOk)
 	validateErr := ynn.WalkAndValidateMust()
-	assert.EqualError(t, validateErr, `port channel-number exceeds max-channel of corresponding switch-model/port. Must statement 'number(.) <= number(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/max-channel)' to true. Container(s): [context: channel-number=4 cage-number=4]`)
+	assertMustViolations(t, validateErr, []mustViolation{
+		{"/switch[switch-id=test-switch-1]/port[cage-number=4][channel-number=4]/channel-number",
+			`port channel-number exceeds max-channel of corresponding switch-model/port. Must statement 'number(.) <= number(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/max-channel)' to true. Container(s): [context: channel-number=4 cage-number=4]`},
+	})
 }
 
 func Test_WalkAndValidateMustFailPortCage(t *testing.T) {
@@ -76,7 +96,17 @@ func Test_WalkAndValidateMustFailPortCage(t *testing.T) {
 	ynn, ynnOk := nn.(*navigator.YangNodeNavigator)
 	assert.True(t, ynnOk)
 	validateErr := ynn.WalkAndValidateMust()
-	assert.EqualError(t, validateErr, `port cage-number must be present in corresponding switch-model/port. Must statement 'set-contains(/switch-model[@switch-model-id=$this/../../model-id]/port/@cage-number, .)' to true. Container(s): [context: cage-number=3 switch-model-id=super-switch-2100]`)
+	// Cages 3 and 4 are not in the switch model, so their speeds are not either
+	assertMustViolations(t, validateErr, []mustViolation{
+		{"/switch[switch-id=test-switch-1]/port[cage-number=3][channel-number=0]/cage-number",
+			`port cage-number must be present in corresponding switch-model/port. Must statement 'set-contains(/switch-model[@switch-model-id=$this/../../model-id]/port/@cage-number, .)' to true. Container(s): [context: cage-number=3 channel-number=0]`},
+		{"/switch[switch-id=test-switch-1]/port[cage-number=3][channel-number=0]/speed",
+			`port speed must be present in corresponding switch-model/port. Must statement 'contains(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/speeds, string($this))' to true. Container(s): [context: speed=speed-10g cage-number=3 channel-number=0]`},
+		{"/switch[switch-id=test-switch-1]/port[cage-number=4][channel-number=0]/cage-number",
+			`port cage-number must be present in corresponding switch-model/port. Must statement 'set-contains(/switch-model[@switch-model-id=$this/../../model-id]/port/@cage-number, .)' to true. Container(s): [context: cage-number=4 channel-number=0]`},
+		{"/switch[switch-id=test-switch-1]/port[cage-number=4][channel-number=0]/speed",
+			`port speed must be present in corresponding switch-model/port. Must statement 'contains(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/speeds, string($this))' to true. Container(s): [context: speed=speed-10g cage-number=4 channel-number=0]`},
+	})
 }
 
 func Test_WalkAndValidateMustFailPortSpeed(t *testing.T) {
@@ -98,5 +128,8 @@ func Test_WalkAndValidateMustFailPortSpeed(t *testing.T) {
 	ynn, ynnOk := nn.(*navigator.YangNodeNavigator)
 	assert.True(t, ynnOk)
 	validateErr := ynn.WalkAndValidateMust()
-	assert.EqualError(t, validateErr, `port speed must be present in corresponding switch-model/port. Must statement 'contains(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/speeds, string($this))' to true. Container(s): [context: speed=speed-100g cage-number=4]`)
+	assertMustViolations(t, validateErr, []mustViolation{
+		{"/switch[switch-id=test-switch-1]/port[cage-number=4][channel-number=0]/speed",
+			`port speed must be present in corresponding switch-model/port. Must statement 'contains(/switch-model[@switch-model-id=$this/../../model-id]/port[@cage-number=$this/../@cage-number]/speeds, string($this))' to true. Container(s): [context: speed=speed-100g cage-number=4 channel-number=0]`},
+	})
 }
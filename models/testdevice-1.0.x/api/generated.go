@@ -5,12 +5,12 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.24.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/onf-test1@2018-02-20.yang
-	- /config-model/yang/onf-test1-extra@2021-04-01.yang
+	- yang/onf-test1@2018-02-20.yang
+	- yang/onf-test1-extra@2021-04-01.yang
 Imported modules were sourced from:
-	- "/config-model/yang"/...
+	- yang/...
 */
 package api
 
@@ -266,6 +266,7 @@ type OnfTest1_Cont1A_Cont2A struct {
 	Leaf2E	[]int16	`path:"leaf2e" module:"onf-test1"`
 	Leaf2F	Binary	`path:"leaf2f" module:"onf-test1"`
 	Leaf2G	*bool	`path:"leaf2g" module:"onf-test1"`
+	Leaf2H	OnfTest1_Cont1A_Cont2A_Leaf2H_Union	`path:"leaf2h" module:"onf-test1"`
 }
 
 // IsYANGGoStruct ensures that OnfTest1_Cont1A_Cont2A implements the yang.GoStruct
@@ -296,6 +297,46 @@ func (*OnfTest1_Cont1A_Cont2A) ΛBelongingModule() string {
 	return "onf-test1"
 }
 
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union is an interface that is implemented by valid types for the union
+// for the leaf /onf-test1/cont1a/cont2a/leaf2h within the YANG schema.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface {
+	Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union()
+}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H is used when /onf-test1/cont1a/cont2a/leaf2h
+// is to be set to a E_OnfTest1_Cont1A_Cont2A_Leaf2H value.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H struct {
+	E_OnfTest1_Cont1A_Cont2A_Leaf2H	E_OnfTest1_Cont1A_Cont2A_Leaf2H
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H) Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union() {}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16 is used when /onf-test1/cont1a/cont2a/leaf2h
+// is to be set to a uint16 value.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16 struct {
+	Uint16	uint16
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16) Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union() {}
+
+// To_OnfTest1_Cont1A_Cont2A_Leaf2H_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *OnfTest1_Cont1A_Cont2A) To_OnfTest1_Cont1A_Cont2A_Leaf2H_Union(i interface{}) (OnfTest1_Cont1A_Cont2A_Leaf2H_Union, error) {
+	switch v := i.(type) {
+	case E_OnfTest1_Cont1A_Cont2A_Leaf2H:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H{v}, nil
+	case uint16:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to OnfTest1_Cont1A_Cont2A_Leaf2H_Union, unknown union type, got: %T, want any of [E_OnfTest1_Cont1A_Cont2A_Leaf2H, uint16]", i, i)
+	}
+}
+
 
 // OnfTest1_Cont1A_List2A represents the /onf-test1/cont1a/list2a YANG schema element.
 type OnfTest1_Cont1A_List2A struct {
@@ -642,6 +683,48 @@ func (*OnfTest1_Cont1BState_List2B) ΛBelongingModule() string {
 }
 
 
+// E_OnfTest1_Cont1A_Cont2A_Leaf2H is a derived int64 type which is used to represent
+// the enumerated node OnfTest1_Cont1A_Cont2A_Leaf2H. An additional value named
+// OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfTest1_Cont1A_Cont2A_Leaf2H int64
+
+// IsYANGGoEnum ensures that OnfTest1_Cont1A_Cont2A_Leaf2H implements the yang.GoEnum
+// interface. This ensures that OnfTest1_Cont1A_Cont2A_Leaf2H can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfTest1_Cont1A_Cont2A_Leaf2H) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfTest1_Cont1A_Cont2A_Leaf2H.
+func (E_OnfTest1_Cont1A_Cont2A_Leaf2H) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfTest1_Cont1A_Cont2A_Leaf2H.
+func (e E_OnfTest1_Cont1A_Cont2A_Leaf2H) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfTest1_Cont1A_Cont2A_Leaf2H")
+}
+
+const (
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET corresponds to the value UNSET of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET E_OnfTest1_Cont1A_Cont2A_Leaf2H = 0
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_auto corresponds to the value auto of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_auto E_OnfTest1_Cont1A_Cont2A_Leaf2H = 1
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_off corresponds to the value off of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_off E_OnfTest1_Cont1A_Cont2A_Leaf2H = 2
+)
+
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_OnfTest1_Cont1A_Cont2A_Leaf2H": {
+		1: {Name: "auto"},
+		2: {Name: "off"},
+	},
+}
+
 
 var (
 	// ySchema is a byte slice contain a gzip compressed representation of the
@@ -651,208 +734,219 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5b, 0x6f, 0xdb, 0x38,
-		0x16, 0x7e, 0xcf, 0xaf, 0x38, 0xd0, 0x4b, 0x93, 0x45, 0x9c, 0xd8, 0x8a, 0x9d, 0xb6, 0x01, 0x06,
-		0x18, 0xf7, 0xb6, 0x0b, 0x4c, 0xdb, 0x29, 0x3a, 0xc1, 0x00, 0xbb, 0xdd, 0x60, 0x41, 0x5b, 0x47,
-		0x36, 0x11, 0x99, 0xf2, 0x48, 0x54, 0x1a, 0x63, 0x90, 0xff, 0xbe, 0xd0, 0xc5, 0xf2, 0x4d, 0x96,
-		0x0e, 0x75, 0x71, 0xec, 0x98, 0xf3, 0x30, 0x75, 0x64, 0x4a, 0x16, 0x79, 0xae, 0xfc, 0xce, 0x47,
-		0xf2, 0xef, 0x13, 0x00, 0x00, 0xe3, 0x2b, 0x9b, 0xa0, 0x71, 0x03, 0x86, 0x85, 0x0f, 0x7c, 0x88,
-		0xc6, 0x79, 0x7c, 0xf5, 0x37, 0x2e, 0x2c, 0xe3, 0x06, 0x3a, 0xc9, 0x9f, 0xef, 0x5d, 0x61, 0xf3,
-		0x91, 0x71, 0x03, 0xed, 0xe4, 0xc2, 0x07, 0xee, 0x19, 0x37, 0x10, 0x3f, 0x22, 0xba, 0x30, 0x74,
-		0x85, 0xec, 0xb0, 0x95, 0x6b, 0x2b, 0x8f, 0x4f, 0xbe, 0x3f, 0x5f, 0xfd, 0xf6, 0x03, 0xfa, 0x43,
-		0x8f, 0x4f, 0x25, 0x77, 0x45, 0xd8, 0xe8, 0x76, 0x8c, 0x20, 0xdd, 0x29, 0x38, 0xf8, 0x80, 0x0e,
-		0x84, 0xb7, 0x30, 0x2e, 0xd0, 0x5b, 0xbf, 0x6b, 0xf5, 0xe5, 0xd2, 0xcb, 0xeb, 0x2f, 0x99, 0x7e,
-		0xf1, 0xcd, 0x43, 0x9b, 0x3f, 0x6e, 0xbc, 0xdb, 0xca, 0xfb, 0xc9, 0xce, 0xda, 0xaf, 0x44, 0xdf,
-		0xfe, 0xe1, 0x06, 0xde, 0x10, 0x33, 0xef, 0x8c, 0xdf, 0x04, 0x67, 0x3f, 0x5d, 0x2f, 0x7c, 0x19,
-		0x63, 0x1a, 0xff, 0xc8, 0x79, 0x76, 0xc3, 0x7f, 0x31, 0xbf, 0xef, 0x8d, 0x82, 0x09, 0x0a, 0x69,
-		0xdc, 0x80, 0xf4, 0x02, 0xdc, 0xd2, 0x70, 0xa9, 0x55, 0xf8, 0x4e, 0x1b, 0x8d, 0x9e, 0x56, 0xae,
-		0x3c, 0xad, 0x8f, 0xe7, 0x9a, 0x58, 0x56, 0xc4, 0x63, 0xb2, 0xed, 0x1d, 0x59, 0x16, 0x93, 0xc9,
-		0xb6, 0xf5, 0x22, 0x43, 0x5c, 0xa6, 0xb0, 0x0a, 0xc4, 0x55, 0x20, 0xb6, 0x42, 0xf1, 0x51, 0xc4,
-		0x48, 0x13, 0x27, 0x55, 0xac, 0xca, 0xe2, 0x55, 0x16, 0x33, 0x59, 0xdc, 0xd9, 0x62, 0xdf, 0x22,
-		0xfe, 0x42, 0x35, 0x48, 0x1b, 0x38, 0xc8, 0xec, 0x1c, 0x75, 0xd8, 0x18, 0xce, 0xa4, 0x7d, 0x41,
-		0x67, 0xd6, 0xd4, 0xe3, 0x6b, 0x30, 0x41, 0x8f, 0x0f, 0x21, 0xbc, 0x19, 0xb8, 0xf0, 0xb9, 0x85,
-		0xf0, 0x7e, 0xae, 0x24, 0x40, 0x79, 0x9c, 0xcd, 0x02, 0x27, 0x1c, 0x9a, 0x1f, 0xb9, 0x0d, 0xa3,
-		0xc6, 0xa6, 0x91, 0xdb, 0xe6, 0xae, 0xe0, 0xb7, 0x12, 0xdd, 0x6c, 0x17, 0x34, 0x2b, 0xd2, 0x51,
-		0x15, 0x5d, 0x55, 0xd3, 0x59, 0x55, 0xdd, 0x2d, 0xad, 0xc3, 0xa5, 0x75, 0x59, 0x59, 0xa7, 0xf3,
-		0x75, 0xbb, 0x40, 0xc7, 0xd3, 0x5f, 0xbb, 0x9d, 0x4d, 0x51, 0x6d, 0x9c, 0x03, 0x2e, 0xe4, 0x1b,
-		0xca, 0x50, 0x27, 0x4a, 0xd1, 0x23, 0x34, 0xfd, 0xce, 0xc4, 0x08, 0x49, 0x9a, 0x1a, 0xfe, 0x47,
-		0x13, 0x5d, 0xf4, 0xe0, 0x2f, 0x5c, 0x90, 0x65, 0x9d, 0xde, 0xf4, 0x27, 0x73, 0x02, 0xdc, 0xee,
-		0x6a, 0xb7, 0xde, 0xf7, 0xc9, 0x63, 0xc3, 0xd0, 0x7a, 0x3f, 0xf0, 0x11, 0x97, 0x7e, 0xb1, 0x9a,
-		0x6f, 0x0e, 0x31, 0x8e, 0x98, 0xe4, 0x0f, 0xe1, 0x6f, 0xdb, 0xcc, 0xf1, 0x91, 0x7c, 0xf7, 0xd3,
-		0xb9, 0xc2, 0x90, 0xb0, 0xc7, 0xf2, 0x43, 0x72, 0x75, 0x38, 0x43, 0x72, 0x52, 0xe3, 0xc0, 0xed,
-		0x4c, 0xe3, 0xb4, 0xca, 0x6d, 0x8e, 0xc9, 0x8b, 0xd3, 0xb9, 0xc2, 0x56, 0x77, 0x95, 0x5c, 0x3a,
-		0x3e, 0x4a, 0x8f, 0xb5, 0x02, 0xe1, 0x4b, 0x36, 0x70, 0x88, 0xce, 0xdd, 0x43, 0x1b, 0x3d, 0x14,
-		0xc3, 0x46, 0x9c, 0xf0, 0x3c, 0x72, 0x7c, 0xff, 0xf4, 0x1e, 0xae, 0xdb, 0xdd, 0xb6, 0xa1, 0xa0,
-		0x3a, 0x8a, 0xf1, 0x3a, 0x2b, 0x6e, 0x2f, 0xfa, 0xa6, 0xa8, 0x07, 0x65, 0x43, 0x78, 0x66, 0x28,
-		0x4f, 0x3b, 0xbf, 0x6f, 0xda, 0x74, 0x52, 0x42, 0xcf, 0xe2, 0x8c, 0x76, 0xa0, 0x98, 0x01, 0x0f,
-		0x14, 0x33, 0xe0, 0x3f, 0x5d, 0x47, 0xb2, 0x11, 0x96, 0xce, 0x80, 0x75, 0x56, 0x7a, 0xa8, 0x59,
-		0xe9, 0x17, 0x26, 0x2c, 0x26, 0x5d, 0x6f, 0x56, 0x9c, 0x85, 0x95, 0xc8, 0x60, 0x2d, 0x1c, 0xf2,
-		0x09, 0x73, 0xae, 0xbb, 0x0a, 0x59, 0x6c, 0xc7, 0x24, 0xb4, 0xdd, 0x88, 0x3c, 0x57, 0xc7, 0x9b,
-		0xfb, 0x5e, 0xbd, 0xb8, 0x44, 0xc4, 0x6c, 0xb7, 0xdb, 0x87, 0x33, 0x2a, 0xfb, 0x1e, 0x3c, 0x86,
-		0x8a, 0xc1, 0x63, 0xa8, 0x18, 0x3c, 0xbe, 0x23, 0xb3, 0xc0, 0x15, 0xce, 0x6c, 0x67, 0xe1, 0xc3,
-		0xd4, 0xe1, 0xe3, 0x60, 0x41, 0x0d, 0x5f, 0x7a, 0x5c, 0x8c, 0x54, 0xe2, 0xc1, 0x9b, 0xa6, 0x0c,
-		0xc3, 0x52, 0x34, 0x0c, 0x4b, 0xd1, 0x30, 0xfa, 0xc2, 0x95, 0x63, 0xf4, 0x20, 0x89, 0x82, 0x3a,
-		0xb1, 0xd2, 0x96, 0xa1, 0x93, 0x25, 0x9d, 0x2c, 0xe9, 0x64, 0x69, 0x8f, 0x93, 0x25, 0x54, 0x8c,
-		0x09, 0xa8, 0x18, 0x13, 0xa2, 0x14, 0xc9, 0xe1, 0xbe, 0xd4, 0xd1, 0x40, 0x47, 0x83, 0xbc, 0x71,
-		0xe6, 0x42, 0x76, 0xae, 0x15, 0x22, 0x81, 0x79, 0xb8, 0x3e, 0xbd, 0xba, 0xff, 0xaa, 0x80, 0x3b,
-		0x87, 0x2a, 0xb3, 0x97, 0x4e, 0x5d, 0x63, 0xf1, 0x4a, 0x16, 0xf6, 0x99, 0xfb, 0xb2, 0x2f, 0xa5,
-		0x47, 0xb3, 0xb2, 0x2f, 0x5c, 0x7c, 0x74, 0x30, 0xb4, 0x7f, 0xe2, 0x50, 0x85, 0xe2, 0x5c, 0xba,
-		0xa3, 0xf3, 0xa6, 0xdb, 0xbd, 0x7e, 0xdd, 0xed, 0xb6, 0x5f, 0x5f, 0xbd, 0x6e, 0xbf, 0xed, 0xf5,
-		0x3a, 0xd7, 0x1d, 0x4a, 0xf5, 0xf5, 0x77, 0xcf, 0x42, 0x0f, 0xad, 0x77, 0x33, 0xe3, 0x06, 0x44,
-		0xe0, 0x38, 0x4d, 0x45, 0x31, 0x5b, 0x31, 0x8a, 0xd9, 0x8a, 0x51, 0x6c, 0xc0, 0x05, 0xf3, 0x56,
-		0xe7, 0xfb, 0x43, 0x1d, 0xc7, 0x74, 0x1c, 0xcb, 0x18, 0xe7, 0x58, 0x55, 0x14, 0x02, 0xd9, 0x5b,
-		0x42, 0xd3, 0xcf, 0x28, 0x46, 0x72, 0xbc, 0x77, 0x91, 0xcc, 0x6c, 0xeb, 0xa2, 0xf2, 0x21, 0x8f,
-		0xc9, 0xbe, 0x4f, 0x4e, 0x46, 0x8a, 0x6e, 0x7d, 0xa4, 0xe8, 0xd6, 0xdf, 0xb9, 0xae, 0x83, 0x4c,
-		0xe8, 0x32, 0xa0, 0xf6, 0xeb, 0xc5, 0x7e, 0x3d, 0xd6, 0x15, 0x15, 0xac, 0xaa, 0x53, 0xd6, 0x2e,
-		0x94, 0x38, 0xa5, 0x7d, 0x21, 0x5c, 0xc9, 0x12, 0x95, 0xce, 0xa1, 0x96, 0xfa, 0xc3, 0x31, 0x4e,
-		0xd8, 0x94, 0x45, 0x71, 0xc4, 0xb8, 0x74, 0x85, 0xdd, 0x92, 0xe8, 0xcb, 0xce, 0x65, 0xcc, 0x00,
-		0xbf, 0xcc, 0x65, 0x18, 0xc7, 0x4f, 0x90, 0x5e, 0x30, 0x94, 0x22, 0x19, 0x90, 0xdf, 0x85, 0x7d,
-		0x1b, 0xde, 0xff, 0xbf, 0xd0, 0x62, 0x3a, 0xfd, 0xe8, 0x1f, 0xb3, 0x9f, 0x2d, 0xb8, 0xcd, 0x1e,
-		0x65, 0xf4, 0x26, 0xb2, 0xe1, 0x0e, 0x81, 0x0b, 0x9d, 0xb4, 0xa3, 0x71, 0xa1, 0x3f, 0x67, 0xda,
-		0xf6, 0xf6, 0xdb, 0xf3, 0x6d, 0x5a, 0x93, 0xa1, 0xeb, 0x23, 0x43, 0x17, 0xda, 0x20, 0xbd, 0x86,
-		0xb2, 0xa8, 0x9d, 0xe4, 0xb4, 0x21, 0x26, 0x51, 0xb4, 0xd9, 0x14, 0xdd, 0x95, 0xce, 0x13, 0x83,
-		0x1e, 0xd1, 0x11, 0x96, 0xcd, 0x07, 0xd4, 0xf3, 0x80, 0x27, 0xda, 0x34, 0x50, 0xbd, 0xab, 0x9d,
-		0xf6, 0xfe, 0xf5, 0xb5, 0xa4, 0x2f, 0xbe, 0xab, 0xe2, 0xcf, 0xb8, 0x4f, 0x5a, 0xdb, 0x91, 0xb4,
-		0xa3, 0xf9, 0xb3, 0x3e, 0xf8, 0x7c, 0x32, 0x75, 0x30, 0x06, 0x55, 0x5d, 0x3b, 0x9c, 0x88, 0xda,
-		0x7c, 0x14, 0x78, 0x51, 0x08, 0x00, 0x2e, 0x71, 0xe2, 0xeb, 0x85, 0x1e, 0x7b, 0xbf, 0xd0, 0x23,
-		0x89, 0xa2, 0xc4, 0xec, 0x36, 0x6a, 0xad, 0x96, 0xdb, 0xde, 0x8e, 0x13, 0x15, 0xe1, 0x3e, 0xdc,
-		0xe3, 0x0c, 0x2d, 0x18, 0xcc, 0x80, 0xf2, 0x1c, 0x9d, 0xd4, 0x1e, 0x4f, 0x52, 0x5b, 0x82, 0x9c,
-		0x70, 0xb8, 0x68, 0x45, 0x57, 0x83, 0x15, 0xeb, 0x43, 0xf2, 0x46, 0x63, 0x15, 0x35, 0x60, 0x15,
-		0x1e, 0x13, 0x23, 0x6c, 0x4d, 0x08, 0x82, 0x48, 0x0d, 0x6f, 0x71, 0x8b, 0x22, 0xc5, 0x06, 0x26,
-		0xec, 0x11, 0x1e, 0x42, 0xf1, 0x81, 0xed, 0x7a, 0x20, 0xc7, 0x08, 0xd1, 0xb3, 0xb4, 0x57, 0xd7,
-		0x5e, 0xfd, 0xf8, 0xd6, 0xd1, 0x69, 0xfc, 0x79, 0x13, 0x7f, 0xee, 0xf5, 0xb4, 0x53, 0xaf, 0xcf,
-		0xa9, 0x13, 0xb4, 0x73, 0xdd, 0xa9, 0x73, 0xa1, 0xec, 0xd4, 0x93, 0x29, 0x5d, 0xf4, 0x00, 0x90,
-		0x2e, 0x48, 0xf4, 0x25, 0x78, 0x81, 0x83, 0x3e, 0x70, 0x01, 0xff, 0xee, 0x7f, 0xfd, 0xe7, 0x05,
-		0x7c, 0xe1, 0x02, 0x26, 0x81, 0x2f, 0x61, 0x80, 0xf0, 0xdf, 0xa0, 0xdd, 0xbe, 0x1a, 0xfe, 0x02,
-		0x84, 0x00, 0xa2, 0x1d, 0xff, 0xa1, 0x3a, 0xfe, 0x66, 0x97, 0xaa, 0xe8, 0x20, 0xa1, 0x83, 0x84,
-		0x0e, 0x12, 0x55, 0x83, 0x04, 0x2a, 0xb1, 0xea, 0xe3, 0xe6, 0xaa, 0xc1, 0x21, 0x5d, 0x65, 0x1a,
-		0x46, 0x86, 0x98, 0x98, 0x1f, 0x46, 0x85, 0x30, 0xf5, 0x37, 0x59, 0xe1, 0xee, 0x2e, 0x3a, 0x10,
-		0x1c, 0xe1, 0x0c, 0x20, 0xd4, 0x12, 0x0f, 0x6d, 0x15, 0x60, 0xe7, 0x35, 0xa1, 0xed, 0xb7, 0x79,
-		0xf1, 0x70, 0xa5, 0x64, 0x78, 0x99, 0x2c, 0x16, 0x69, 0xc0, 0xbe, 0xe4, 0x63, 0x6b, 0xea, 0xfe,
-		0x44, 0x8f, 0x6e, 0x62, 0xe9, 0x1d, 0x8a, 0x68, 0xa9, 0xc7, 0x84, 0x3f, 0xe1, 0x12, 0x48, 0x37,
-		0x6b, 0x53, 0x3a, 0xae, 0xc9, 0xb4, 0x12, 0x31, 0xf9, 0x5a, 0xef, 0x4a, 0xf3, 0x82, 0x12, 0x25,
-		0xcd, 0xe6, 0x22, 0xfb, 0x71, 0xa5, 0x02, 0xd9, 0x6f, 0x38, 0x2b, 0x28, 0x6c, 0xd1, 0x18, 0xce,
-		0x74, 0x66, 0xf3, 0x1a, 0xa3, 0x39, 0xa7, 0x1a, 0x40, 0xa3, 0x2d, 0x6f, 0xeb, 0x99, 0xc2, 0x26,
-		0x29, 0x46, 0x38, 0x9f, 0xaf, 0x83, 0x90, 0x90, 0xd6, 0x09, 0x83, 0xc9, 0x00, 0xbd, 0xd3, 0x8b,
-		0xcb, 0x14, 0x8a, 0x38, 0x4b, 0xb1, 0x82, 0xf5, 0xef, 0xd8, 0xe3, 0x19, 0xc5, 0xab, 0xad, 0x06,
-		0x4a, 0x62, 0x18, 0x49, 0x83, 0xd4, 0x98, 0xfb, 0xc0, 0x7d, 0x60, 0x31, 0x72, 0xe1, 0x4b, 0x26,
-		0x23, 0x01, 0x50, 0xa3, 0x4a, 0x89, 0x3d, 0x5a, 0x96, 0x43, 0x98, 0xb5, 0xf4, 0xee, 0x0a, 0x1e,
-		0xa3, 0xca, 0xee, 0x2c, 0xab, 0xf1, 0x6c, 0x5b, 0xf7, 0x6b, 0x32, 0x55, 0x0a, 0x81, 0xe3, 0xa3,
-		0xe7, 0xb9, 0x5e, 0x7f, 0x3a, 0xbd, 0x65, 0x23, 0x75, 0xf9, 0xc5, 0x80, 0x54, 0xa4, 0xa5, 0xbb,
-		0x91, 0x18, 0x86, 0x6f, 0xdb, 0x62, 0xd3, 0x69, 0x4b, 0xb2, 0xd1, 0xb3, 0xc8, 0x6c, 0xa9, 0xcb,
-		0xbb, 0x96, 0xd2, 0x17, 0xf4, 0x7d, 0x36, 0xc2, 0x92, 0x62, 0x0a, 0xad, 0x3d, 0x05, 0x08, 0x1d,
-		0xf4, 0x7d, 0x90, 0x63, 0x26, 0xc0, 0xf5, 0x00, 0xff, 0x0a, 0x98, 0x13, 0x4e, 0x1e, 0xa9, 0x55,
-		0xa7, 0x7a, 0xa5, 0x39, 0x49, 0xba, 0xf5, 0x6c, 0xd2, 0x54, 0x1a, 0x99, 0xba, 0x84, 0x5e, 0x2f,
-		0xdf, 0xa8, 0x61, 0xee, 0x67, 0x2e, 0x03, 0x09, 0x8a, 0xb9, 0x9f, 0x61, 0xa8, 0xae, 0xc8, 0xfd,
-		0xe4, 0xbe, 0xec, 0xd2, 0xa8, 0x52, 0x5d, 0x32, 0x53, 0x2a, 0xe2, 0xbf, 0xfc, 0xe4, 0x72, 0x0c,
-		0x0c, 0x92, 0x29, 0x31, 0x70, 0x61, 0xe1, 0xe3, 0x7e, 0x10, 0xa4, 0xf0, 0x10, 0x19, 0x52, 0xb8,
-		0x33, 0x8a, 0x14, 0x57, 0x40, 0xd6, 0xb8, 0x2a, 0xac, 0xf6, 0x99, 0x8b, 0xfb, 0x08, 0x4f, 0x8b,
-		0x34, 0x3f, 0x22, 0x46, 0xf9, 0x87, 0x31, 0xed, 0xc7, 0x97, 0x38, 0xef, 0xc7, 0xe3, 0xc3, 0xd0,
-		0x64, 0xe7, 0x26, 0xf1, 0xbe, 0xb2, 0x73, 0x13, 0xab, 0x61, 0xf8, 0x29, 0x72, 0xb1, 0x0d, 0x2d,
-		0xa8, 0xe9, 0x2a, 0xee, 0xab, 0xd7, 0x1d, 0x94, 0x59, 0xed, 0xdf, 0x65, 0xe0, 0x8a, 0xc8, 0xb0,
-		0xba, 0x0c, 0x70, 0x3e, 0xe3, 0xd2, 0xb6, 0xa5, 0x6d, 0xeb, 0x38, 0x79, 0x87, 0x1a, 0x56, 0xd3,
-		0xb0, 0x5a, 0x43, 0x8b, 0x24, 0x23, 0x1f, 0xab, 0xe0, 0xd3, 0xe3, 0xf6, 0xaa, 0x05, 0xc8, 0x34,
-		0x8d, 0xe6, 0x22, 0x4c, 0xa4, 0xd3, 0xa4, 0xda, 0x84, 0x7b, 0x9c, 0xf9, 0xc0, 0xfc, 0x78, 0x01,
-		0xa5, 0x87, 0x36, 0xd5, 0xcd, 0x77, 0xb4, 0x9b, 0x3f, 0x3c, 0x37, 0x5f, 0x94, 0xb0, 0xa7, 0x0d,
-		0x2d, 0xee, 0x4f, 0x1d, 0x36, 0x23, 0x2d, 0x71, 0xd8, 0x90, 0xce, 0xf2, 0xcd, 0xc4, 0x71, 0x58,
-		0x53, 0xd8, 0x70, 0x6a, 0x1f, 0xfd, 0xc1, 0x1c, 0x48, 0x9e, 0x16, 0xe5, 0xf7, 0xc0, 0xa4, 0xf4,
-		0xf8, 0x20, 0x90, 0x38, 0x57, 0x5f, 0x8b, 0xdb, 0x51, 0x69, 0x5d, 0x82, 0x13, 0x85, 0x8e, 0x18,
-		0x06, 0xf0, 0xa9, 0xbf, 0x4b, 0xcb, 0x59, 0x94, 0x95, 0xba, 0x8c, 0x72, 0x97, 0x54, 0xf2, 0x2a,
-		0x40, 0x4f, 0x25, 0xa5, 0xaf, 0x05, 0xe9, 0x29, 0x67, 0x04, 0x8a, 0x8e, 0x9d, 0x28, 0x2b, 0x72,
-		0x0e, 0x54, 0x3e, 0x17, 0x2a, 0x93, 0x13, 0x95, 0xcd, 0x8d, 0xd4, 0x73, 0xa4, 0x4a, 0xb9, 0x52,
-		0xd5, 0x9c, 0xa9, 0xb6, 0x3c, 0xa1, 0x7a, 0xbe, 0x50, 0x22, 0x97, 0xaa, 0x94, 0x53, 0x6d, 0x0c,
-		0x5d, 0xef, 0xf0, 0x87, 0xee, 0xa4, 0xc1, 0x81, 0x7e, 0x36, 0x8d, 0x6e, 0x6b, 0x95, 0x2e, 0x3b,
-		0x76, 0x66, 0xfb, 0xd8, 0x74, 0x9a, 0xdc, 0xfa, 0x6e, 0x87, 0x45, 0x2a, 0xfb, 0x1e, 0x67, 0x1d,
-		0xf5, 0x8c, 0x2e, 0xbe, 0xad, 0x5c, 0x2e, 0x67, 0xbb, 0x1e, 0xf2, 0x91, 0x08, 0x27, 0x1a, 0xd0,
-		0x81, 0x56, 0x38, 0xc7, 0x98, 0xa3, 0xb6, 0x3d, 0x76, 0xa9, 0xf2, 0x60, 0x9d, 0xac, 0xe9, 0x64,
-		0xad, 0x7a, 0xb2, 0x46, 0x07, 0x85, 0xcb, 0x80, 0xc3, 0xf9, 0x20, 0x31, 0x46, 0x28, 0x71, 0x2f,
-		0xfa, 0x14, 0x69, 0xfe, 0x8e, 0x4d, 0xdf, 0x2c, 0x67, 0xfa, 0x66, 0x0d, 0xa6, 0x6f, 0x66, 0x99,
-		0xbe, 0xa9, 0x4d, 0x5f, 0x9b, 0xfe, 0x51, 0x9a, 0xbe, 0xb9, 0x23, 0x96, 0xc2, 0x79, 0x61, 0xe9,
-		0x39, 0x8d, 0xef, 0x40, 0x31, 0xf5, 0x97, 0xb8, 0x69, 0x69, 0x03, 0x47, 0xa6, 0x91, 0x58, 0x81,
-		0xea, 0xf3, 0x99, 0xe5, 0xb3, 0x84, 0x87, 0x4c, 0x9e, 0xbe, 0x8a, 0xd2, 0x6b, 0x78, 0x75, 0x0e,
-		0x31, 0x04, 0x71, 0x7a, 0x71, 0x71, 0xf9, 0x2b, 0xb7, 0xce, 0xce, 0xe1, 0x55, 0x6b, 0xe9, 0xe2,
-		0xe5, 0xaf, 0x91, 0x80, 0xb3, 0x2f, 0x9b, 0x67, 0x67, 0xf0, 0xcb, 0xe2, 0xda, 0x12, 0x78, 0x77,
-		0xa6, 0x62, 0x28, 0x65, 0x48, 0x86, 0x9b, 0xae, 0x34, 0x65, 0xdb, 0xf9, 0x2c, 0x5a, 0x4e, 0x59,
-		0x8a, 0x73, 0x58, 0xd5, 0xd5, 0x42, 0x2d, 0x1c, 0xc4, 0xda, 0xfc, 0x2e, 0x6c, 0xe7, 0x24, 0x66,
-		0x8f, 0x52, 0x43, 0xf3, 0x14, 0x95, 0xd2, 0x52, 0x19, 0xca, 0x62, 0x1e, 0x8e, 0x0c, 0xb6, 0xeb,
-		0x4d, 0xd8, 0x73, 0x29, 0x40, 0x59, 0x4a, 0x63, 0x73, 0x2a, 0x90, 0x31, 0x34, 0xfb, 0x22, 0x75,
-		0x55, 0x0a, 0x64, 0xae, 0xd8, 0xe7, 0x94, 0xbf, 0xb8, 0x8f, 0x12, 0xad, 0xc4, 0x51, 0x81, 0xc3,
-		0xef, 0x11, 0x12, 0xf7, 0x17, 0xd3, 0xa3, 0x2f, 0x2e, 0x2e, 0xb9, 0x15, 0x7d, 0xc4, 0x56, 0x7c,
-		0x25, 0x72, 0x7a, 0x1b, 0x57, 0xcc, 0xf8, 0xca, 0xab, 0x67, 0xd5, 0x25, 0x75, 0x42, 0xe5, 0x6e,
-		0x74, 0xa9, 0xc9, 0xf1, 0x6e, 0x4a, 0x41, 0xf7, 0x62, 0x2f, 0x77, 0x22, 0x8d, 0x33, 0x6d, 0x4f,
-		0xa1, 0x73, 0x76, 0x2f, 0x49, 0xd5, 0x5e, 0xa0, 0x91, 0x3b, 0xbb, 0xf1, 0xff, 0xfb, 0xc6, 0x2e,
-		0x17, 0x87, 0x6c, 0x25, 0xf5, 0x35, 0xbc, 0x34, 0x44, 0x2d, 0x6f, 0xac, 0xb6, 0x5a, 0xa4, 0x4e,
-		0x06, 0x6f, 0xb7, 0x1a, 0x81, 0xb7, 0x5b, 0x95, 0xbf, 0xdb, 0xa3, 0xf1, 0x77, 0x7b, 0x25, 0xf8,
-		0xbb, 0x31, 0xd5, 0x40, 0xd3, 0x76, 0x0f, 0x80, 0xb6, 0x4b, 0x02, 0x89, 0xd3, 0xe1, 0x24, 0x40,
-		0xb8, 0xeb, 0x7a, 0x11, 0x4d, 0x37, 0xdd, 0xe4, 0x54, 0xa1, 0x1e, 0xb4, 0x80, 0x54, 0x41, 0xd5,
-		0x0c, 0xc3, 0x1a, 0xd3, 0x01, 0xcd, 0x30, 0xd4, 0x0c, 0x43, 0xcd, 0x30, 0xac, 0x6b, 0x4c, 0xf6,
-		0x98, 0x61, 0x48, 0x82, 0xfd, 0x97, 0x9d, 0xb9, 0xa9, 0xee, 0xcc, 0xcd, 0x15, 0x67, 0x1e, 0x2f,
-		0x53, 0xd5, 0xce, 0x5c, 0x3b, 0xf3, 0x63, 0xdc, 0xab, 0xca, 0xd4, 0x9e, 0x7c, 0x23, 0xb8, 0x69,
-		0x4f, 0x5e, 0x87, 0x27, 0x77, 0x90, 0xd9, 0x3d, 0xa6, 0xb6, 0xfe, 0xa7, 0xa7, 0xca, 0x15, 0x17,
-		0x6e, 0x5c, 0xaf, 0x5d, 0x30, 0x6d, 0x75, 0x9e, 0xae, 0x5d, 0xbb, 0xce, 0xd3, 0x75, 0x9e, 0xae,
-		0xf3, 0xf4, 0xea, 0xde, 0xbd, 0x0c, 0x86, 0x1a, 0x81, 0x25, 0x39, 0x79, 0xf9, 0x0b, 0x82, 0x52,
-		0x9f, 0x73, 0xe3, 0x9d, 0x79, 0x49, 0xbd, 0xc7, 0x60, 0xa5, 0x46, 0x9e, 0x5d, 0x39, 0xdf, 0x2c,
-		0x9c, 0xc7, 0xd1, 0x76, 0x97, 0x1b, 0xf2, 0x54, 0xa9, 0x91, 0xbf, 0x9c, 0x7d, 0x79, 0x2a, 0xd4,
-		0xc0, 0xf7, 0x60, 0x7b, 0x9e, 0x58, 0x6b, 0xd4, 0xca, 0xdb, 0x87, 0xbf, 0x43, 0xcf, 0x6a, 0xaf,
-		0x0f, 0x66, 0x93, 0x9e, 0xe4, 0xb5, 0x0b, 0x8a, 0xa4, 0x3d, 0x96, 0x54, 0x48, 0x37, 0xca, 0xa1,
-		0xea, 0xd5, 0xe7, 0xc3, 0xdf, 0xbe, 0xa7, 0xce, 0x31, 0x3b, 0xda, 0x8d, 0x7d, 0x7a, 0xd5, 0xca,
-		0x82, 0x3d, 0x72, 0x59, 0xf0, 0x24, 0xa7, 0x67, 0x46, 0x3f, 0x18, 0x85, 0x52, 0x45, 0x2b, 0x33,
-		0xec, 0x16, 0x94, 0x0d, 0x17, 0x64, 0x48, 0x6a, 0xed, 0xd0, 0xb2, 0x80, 0xc1, 0xd0, 0x0d, 0x42,
-		0xef, 0xee, 0xda, 0x20, 0xf0, 0x67, 0x34, 0x0f, 0xf5, 0x41, 0xba, 0x90, 0xff, 0x24, 0x5d, 0x4c,
-		0xac, 0x32, 0x8f, 0xac, 0xb9, 0x98, 0x98, 0xbf, 0x2d, 0xd4, 0xa6, 0x8f, 0x2d, 0xa8, 0x82, 0x43,
-		0x2d, 0xdb, 0x44, 0x11, 0x55, 0x45, 0x43, 0x15, 0xfb, 0x0c, 0x55, 0x14, 0x71, 0x4c, 0xd4, 0x26,
-		0x48, 0xea, 0x13, 0xa5, 0x5a, 0x26, 0x4c, 0x6a, 0x13, 0xa7, 0x8a, 0x00, 0x62, 0x2e, 0xc3, 0x23,
-		0xd3, 0x14, 0x7b, 0x15, 0x4c, 0x31, 0x97, 0xf1, 0xa1, 0x2d, 0xf0, 0xe5, 0x58, 0x60, 0x11, 0x42,
-		0xf1, 0x92, 0x0d, 0xf1, 0x80, 0x17, 0x0f, 0x34, 0x8f, 0x74, 0x54, 0x42, 0x3c, 0x1a, 0x42, 0x3e,
-		0xaa, 0x4c, 0xad, 0xea, 0x43, 0x42, 0x6a, 0x99, 0x68, 0x35, 0x87, 0x8c, 0xa8, 0x01, 0xa6, 0xf0,
-		0x1c, 0xab, 0x03, 0xca, 0x20, 0x27, 0x75, 0xcb, 0x7e, 0xff, 0x16, 0x06, 0x94, 0x40, 0x56, 0x76,
-		0x20, 0xeb, 0xca, 0x6b, 0x02, 0x76, 0x8f, 0xbc, 0x34, 0xa3, 0x2a, 0xfb, 0xc4, 0xfb, 0xdf, 0x39,
-		0x32, 0x53, 0x42, 0xd1, 0xf6, 0xb3, 0x5e, 0x53, 0x80, 0x9b, 0xdc, 0xad, 0xe1, 0x26, 0x84, 0x1c,
-		0x20, 0x3f, 0xe6, 0x13, 0x60, 0x08, 0xe1, 0xca, 0xd3, 0x78, 0x07, 0xd2, 0x1f, 0x3e, 0xca, 0x56,
-		0x72, 0xa4, 0x94, 0x7f, 0x6a, 0xbb, 0x8e, 0xe3, 0xfe, 0xe4, 0x62, 0xd4, 0xf2, 0xf9, 0xc0, 0xe1,
-		0x62, 0x74, 0x93, 0x6e, 0x54, 0x9a, 0x1c, 0xb3, 0x73, 0x0e, 0xf3, 0x4f, 0x67, 0x77, 0x79, 0x31,
-		0x5d, 0x29, 0x86, 0xa7, 0xef, 0xf5, 0xc0, 0x1c, 0x6e, 0xc5, 0xe7, 0xda, 0xdb, 0x8c, 0x3b, 0x3e,
-		0x70, 0x3b, 0xfd, 0xbd, 0x30, 0x4a, 0x09, 0x57, 0x42, 0x20, 0xf8, 0x5f, 0x01, 0xce, 0xb7, 0xa7,
-		0x2b, 0xdc, 0xc8, 0x5a, 0xd5, 0x2a, 0xcb, 0x07, 0xeb, 0x52, 0x36, 0xb7, 0x62, 0x63, 0xa5, 0xbb,
-		0xdf, 0xc0, 0xec, 0x4f, 0x25, 0xea, 0xae, 0xcc, 0x01, 0x4d, 0x46, 0x39, 0x3f, 0xa0, 0xac, 0x48,
-		0x54, 0x63, 0x68, 0x75, 0xa1, 0x2c, 0xf7, 0xa9, 0xa9, 0x71, 0xa6, 0x46, 0xbc, 0x8d, 0x53, 0xaf,
-		0x62, 0x7f, 0x1c, 0xea, 0xc5, 0x00, 0xc1, 0xc3, 0x29, 0xb2, 0xd0, 0x25, 0x37, 0x6d, 0x17, 0xaa,
-		0xd1, 0xa9, 0xba, 0x10, 0xa8, 0xfd, 0x6d, 0xd8, 0x73, 0xdf, 0x65, 0x2c, 0x84, 0x99, 0x7a, 0xe8,
-		0xa3, 0x18, 0x62, 0x15, 0x7f, 0x7c, 0xeb, 0x4e, 0xc1, 0xc1, 0x07, 0x74, 0x42, 0x2b, 0x8f, 0x1f,
-		0x28, 0xeb, 0xc7, 0x89, 0xe3, 0xd7, 0x6c, 0x12, 0x29, 0xce, 0xec, 0x47, 0xf5, 0x51, 0xcf, 0xaf,
-		0x33, 0xe4, 0x57, 0x4e, 0x8a, 0x2a, 0x26, 0x19, 0xe3, 0x91, 0x5f, 0x23, 0x59, 0xed, 0xcf, 0xe2,
-		0x6d, 0x97, 0xde, 0xcb, 0x88, 0x1e, 0x3d, 0x68, 0x45, 0xd3, 0xa8, 0x8d, 0xb7, 0x5a, 0x9e, 0x59,
-		0x2f, 0x5a, 0xad, 0xf5, 0x6a, 0xf3, 0xc4, 0x60, 0x1c, 0xba, 0xc2, 0x02, 0x99, 0x8e, 0x70, 0x7a,
-		0x0e, 0x24, 0xb4, 0x20, 0x9a, 0xc5, 0xb9, 0x22, 0x3e, 0x22, 0x3e, 0x7a, 0xde, 0x82, 0x91, 0xe7,
-		0x5f, 0xc0, 0x47, 0x8b, 0x4b, 0xf0, 0x67, 0x93, 0x81, 0xeb, 0x80, 0x3f, 0x76, 0x03, 0xc7, 0x9a,
-		0x9b, 0xd0, 0x03, 0x0f, 0x03, 0xfd, 0xc6, 0xaf, 0x67, 0xa3, 0x6c, 0x0b, 0x54, 0x6d, 0x8d, 0xb5,
-		0x9a, 0x87, 0xa2, 0xe5, 0x9f, 0x5f, 0x57, 0xa4, 0xc9, 0x64, 0x50, 0x8c, 0xac, 0xb7, 0x85, 0xe7,
-		0xcf, 0xe5, 0x57, 0xb5, 0xb6, 0x15, 0x30, 0x8c, 0xe4, 0x4c, 0xc4, 0xe2, 0x05, 0x71, 0x71, 0x3b,
-		0xea, 0x8a, 0xb8, 0x35, 0x61, 0x16, 0xd4, 0xb0, 0xda, 0x45, 0x35, 0x2c, 0xb3, 0x96, 0x1a, 0xd6,
-		0x21, 0x96, 0xb0, 0xea, 0xaa, 0x60, 0x15, 0x32, 0x1e, 0xe9, 0x07, 0x09, 0x12, 0x0e, 0x10, 0x24,
-		0xb2, 0xd6, 0x69, 0xf0, 0x28, 0x1d, 0x8a, 0x5e, 0x50, 0xb1, 0xdb, 0x6d, 0xea, 0xa6, 0x48, 0x65,
-		0x79, 0x7a, 0xea, 0xfc, 0xbc, 0x27, 0x1a, 0xb6, 0xab, 0xde, 0x5d, 0x73, 0x3f, 0xbb, 0x5b, 0x2f,
-		0x01, 0x81, 0xbc, 0xba, 0xd7, 0x1c, 0xd0, 0x96, 0xf7, 0x9a, 0x03, 0xba, 0x37, 0x8b, 0x8f, 0xbd,
-		0x8f, 0x8a, 0x3e, 0xae, 0x9d, 0x38, 0x37, 0x2e, 0x71, 0x52, 0x79, 0xa5, 0xaf, 0x76, 0x6c, 0x55,
-		0x1d, 0x5b, 0xf1, 0xf1, 0x3c, 0x51, 0x85, 0x9c, 0x7e, 0x42, 0x0f, 0xa5, 0xa0, 0xbe, 0x7e, 0x2a,
-		0xef, 0x38, 0x51, 0x0e, 0x95, 0x6a, 0xbc, 0x3e, 0x95, 0xb7, 0xf6, 0x52, 0xa0, 0x5e, 0x11, 0x56,
-		0xa9, 0x74, 0x56, 0x69, 0xcd, 0x40, 0x5b, 0xaf, 0x19, 0xd8, 0x88, 0xcb, 0xbd, 0x9e, 0x5e, 0x34,
-		0x50, 0x03, 0xd6, 0x14, 0xce, 0x3d, 0xae, 0x86, 0x6a, 0x4b, 0xc2, 0xae, 0x86, 0xca, 0x9c, 0x8e,
-		0xa4, 0x22, 0xb0, 0x58, 0x13, 0xc6, 0x05, 0xc8, 0xc4, 0xb3, 0xd7, 0xed, 0xd3, 0x4d, 0xed, 0xd3,
-		0x0f, 0xd6, 0xa7, 0xeb, 0xa5, 0x60, 0x47, 0xee, 0xd6, 0xf5, 0x52, 0xb0, 0x8a, 0x00, 0x75, 0xc1,
-		0x76, 0x5a, 0x79, 0x27, 0x9f, 0xea, 0x1d, 0xb5, 0x56, 0x81, 0xe0, 0x04, 0x87, 0xbd, 0xcc, 0x9d,
-		0xd1, 0x16, 0xa2, 0xc3, 0xef, 0xfe, 0x08, 0x1f, 0x12, 0x1f, 0x8f, 0xfb, 0xae, 0x26, 0x1e, 0x7d,
-		0x25, 0x7c, 0x3b, 0x1b, 0x5e, 0x26, 0xf6, 0x83, 0x02, 0x75, 0x87, 0x39, 0x42, 0x5f, 0xde, 0xba,
-		0xd3, 0xcf, 0xf8, 0x80, 0xce, 0x76, 0xb0, 0x7b, 0xad, 0x5d, 0x11, 0xdc, 0x1d, 0x1d, 0x30, 0xc6,
-		0x64, 0x94, 0x37, 0x2c, 0x30, 0xef, 0x53, 0xe1, 0x4a, 0xf0, 0x70, 0xe8, 0x4e, 0x26, 0x28, 0x2c,
-		0xb4, 0x60, 0x10, 0xc8, 0x94, 0x92, 0xe0, 0x07, 0xd3, 0xa9, 0xeb, 0x49, 0xb4, 0xce, 0xb6, 0xc0,
-		0xd9, 0xed, 0x6d, 0x70, 0x76, 0xfb, 0x68, 0xe1, 0xec, 0xad, 0x41, 0xbb, 0x38, 0x48, 0xe7, 0x05,
-		0x65, 0xe3, 0x1b, 0x93, 0x12, 0x3d, 0xb1, 0x35, 0x0a, 0x1b, 0x3f, 0xfa, 0xad, 0xff, 0xdc, 0xfd,
-		0x7d, 0xf5, 0xd4, 0xfa, 0xd1, 0x6e, 0xbd, 0xbd, 0xfb, 0x87, 0x51, 0x54, 0xf4, 0x39, 0x59, 0xfd,
-		0x94, 0xf4, 0x63, 0x9b, 0x71, 0x18, 0xdc, 0xff, 0xc4, 0xee, 0xf1, 0xbb, 0xeb, 0x6e, 0x8e, 0xde,
-		0xba, 0xc1, 0x18, 0xcb, 0x5f, 0xad, 0x98, 0xc4, 0x07, 0x7c, 0xe0, 0xc3, 0xc4, 0x08, 0x9e, 0x4e,
-		0x9e, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x28, 0xed, 0xc2, 0x7a,
-		0xa7, 0xd3, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xeb, 0x6f, 0xe3, 0x36,
+		0x12, 0xff, 0xee, 0xbf, 0x62, 0xa0, 0x2f, 0xbb, 0x39, 0xd8, 0x89, 0xad, 0xd8, 0xd9, 0x6d, 0x80,
+		0x02, 0xf5, 0x76, 0xb7, 0x77, 0x87, 0xee, 0xb6, 0xc5, 0x36, 0x57, 0xe0, 0x6e, 0x2f, 0x38, 0xd0,
+		0x16, 0xe5, 0x10, 0x2b, 0x93, 0xae, 0x44, 0x65, 0x13, 0x14, 0xf9, 0xdf, 0x0f, 0x94, 0x64, 0xc7,
+		0x4f, 0x69, 0x46, 0x0f, 0xc7, 0x4e, 0xe8, 0x0f, 0x6d, 0x56, 0x26, 0x65, 0x92, 0xf3, 0x9b, 0x07,
+		0xe7, 0x41, 0xfe, 0xd5, 0x02, 0x00, 0x70, 0x7e, 0x61, 0x53, 0xee, 0x5c, 0x82, 0xe3, 0xf1, 0x5b,
+		0x31, 0xe6, 0x4e, 0x3b, 0x7d, 0xfa, 0xb3, 0x90, 0x9e, 0x73, 0x09, 0xbd, 0xec, 0x9f, 0x3f, 0x2a,
+		0xe9, 0x8b, 0x89, 0x73, 0x09, 0xdd, 0xec, 0xc1, 0x7b, 0x11, 0x3a, 0x97, 0x90, 0xbe, 0x02, 0x00,
+		0xc0, 0x19, 0x2b, 0xa9, 0x7b, 0x6c, 0xe5, 0xd9, 0xca, 0xeb, 0xb3, 0xef, 0xdb, 0xab, 0xdf, 0xbe,
+		0xe7, 0xd1, 0x38, 0x14, 0x33, 0x2d, 0x94, 0x34, 0x8d, 0xae, 0x6e, 0x38, 0x68, 0x35, 0x83, 0x80,
+		0xdf, 0xf2, 0x00, 0x4c, 0x17, 0x26, 0x24, 0x0f, 0xd7, 0x7b, 0xad, 0x0e, 0x6e, 0xf1, 0x78, 0x7d,
+		0x90, 0x8b, 0x2f, 0x7e, 0x0b, 0xb9, 0x2f, 0xee, 0x36, 0xc6, 0xb6, 0x32, 0x3e, 0xdd, 0x73, 0xda,
+		0x9b, 0xdf, 0xfe, 0xae, 0xe2, 0x70, 0xcc, 0xb7, 0xf6, 0x4c, 0x47, 0xc2, 0xef, 0xbf, 0xa9, 0xd0,
+		0x0c, 0xc6, 0x99, 0xa5, 0x3f, 0xd2, 0xde, 0xde, 0xf0, 0x1f, 0x2c, 0x1a, 0x86, 0x93, 0x78, 0xca,
+		0xa5, 0x76, 0x2e, 0x41, 0x87, 0x31, 0xdf, 0xd1, 0x70, 0xa9, 0x95, 0x19, 0xd3, 0x46, 0xa3, 0x87,
+		0x95, 0x27, 0x0f, 0xeb, 0xeb, 0xb9, 0x46, 0x96, 0x15, 0xf2, 0xb8, 0x6c, 0xf7, 0x44, 0x96, 0xc9,
+		0xe4, 0xb2, 0x5d, 0xb3, 0xd8, 0x42, 0x2e, 0x57, 0x7a, 0x05, 0xe4, 0x2a, 0x20, 0x5b, 0x21, 0xf9,
+		0x30, 0x64, 0xc4, 0x91, 0x13, 0x4b, 0x56, 0x32, 0x79, 0xc9, 0x64, 0x46, 0x93, 0x7b, 0x3b, 0xd9,
+		0x77, 0x90, 0xbf, 0x10, 0x06, 0xf3, 0x8f, 0x13, 0x70, 0xe6, 0xbb, 0x2c, 0xb7, 0xcd, 0xca, 0x72,
+		0x66, 0xed, 0x0b, 0x26, 0xb3, 0x06, 0x8f, 0x5f, 0xe2, 0x29, 0x0f, 0xc5, 0x18, 0x4c, 0x67, 0x10,
+		0x32, 0x12, 0x1e, 0x87, 0x1f, 0xe7, 0x20, 0x01, 0xcc, 0xeb, 0x7c, 0x16, 0x07, 0x66, 0x69, 0xbe,
+		0xe4, 0x36, 0x04, 0x00, 0x70, 0x5c, 0x27, 0xb7, 0xcd, 0x75, 0xc1, 0x6f, 0x65, 0xd8, 0xec, 0x16,
+		0x34, 0x2b, 0xc2, 0x28, 0x05, 0xab, 0x34, 0xcc, 0x52, 0xb1, 0x5b, 0x1a, 0xc3, 0xa5, 0xb1, 0x4c,
+		0xc6, 0x74, 0x3e, 0xb6, 0x0b, 0x30, 0x3e, 0xff, 0x38, 0x57, 0xf7, 0x33, 0x4e, 0x5b, 0xe7, 0x58,
+		0x48, 0xfd, 0x16, 0xb3, 0xd4, 0x19, 0x28, 0x06, 0x88, 0xa6, 0x9f, 0x99, 0x9c, 0x70, 0x14, 0x52,
+		0x01, 0x00, 0x49, 0x3a, 0x00, 0x00, 0xe7, 0x93, 0x90, 0xce, 0x25, 0xa1, 0x03, 0x00, 0x80, 0xf3,
+		0x07, 0x0b, 0x62, 0xbe, 0x5b, 0xd4, 0xee, 0xfa, 0x38, 0x3f, 0x85, 0x6c, 0x6c, 0xb8, 0xf7, 0xbd,
+		0x98, 0x08, 0x1d, 0x15, 0xc3, 0x7c, 0x73, 0x89, 0xf9, 0x84, 0x69, 0x71, 0x6b, 0x7e, 0xdb, 0x67,
+		0x41, 0xc4, 0xd1, 0xbd, 0x1f, 0xda, 0x84, 0x25, 0x61, 0x77, 0xe5, 0x97, 0xe4, 0xfc, 0x78, 0x96,
+		0xa4, 0x55, 0xe3, 0xc2, 0xed, 0x0d, 0x71, 0x16, 0x72, 0x9b, 0x6b, 0xf2, 0xec, 0x30, 0x57, 0xd8,
+		0xea, 0xba, 0x92, 0x48, 0xe7, 0x77, 0x3a, 0x64, 0x9d, 0x58, 0x46, 0x9a, 0x8d, 0x02, 0xa4, 0x70,
+		0x0f, 0xb9, 0xcf, 0x43, 0x2e, 0xc7, 0x8d, 0x08, 0xe1, 0xb9, 0xe6, 0xf8, 0xfc, 0xd3, 0x8f, 0x70,
+		0xd1, 0xed, 0x77, 0x1d, 0x02, 0x74, 0x88, 0xfa, 0x7a, 0x9b, 0xde, 0x7e, 0x9c, 0x1b, 0x11, 0x07,
+		0x65, 0x55, 0xf8, 0x56, 0x55, 0xbe, 0x98, 0xfc, 0xa1, 0xa1, 0xa9, 0x55, 0x02, 0x67, 0xa9, 0x45,
+		0x3b, 0x22, 0x5a, 0xc0, 0x23, 0xa2, 0x05, 0xfc, 0x87, 0x0a, 0x34, 0x9b, 0xf0, 0xd2, 0x16, 0xb0,
+		0xb5, 0x4a, 0x6b, 0x83, 0xf4, 0x9e, 0xad, 0xd2, 0x4f, 0x4c, 0x7a, 0x4c, 0xab, 0xf0, 0xbe, 0xd8,
+		0x0a, 0x2b, 0x61, 0xc1, 0x7a, 0x7c, 0x2c, 0xa6, 0x2c, 0xb8, 0xe8, 0x13, 0xac, 0xd8, 0x9e, 0xdb,
+		0x6e, 0xd1, 0x35, 0xcf, 0xf9, 0xcb, 0xb5, 0x7d, 0xcf, 0x9f, 0x9d, 0x21, 0xe2, 0x76, 0xbb, 0xdd,
+		0xe3, 0x59, 0x95, 0x43, 0x57, 0x1e, 0x63, 0xa2, 0xf2, 0x18, 0x13, 0x95, 0xc7, 0x67, 0xce, 0x3c,
+		0x50, 0x32, 0xb8, 0xdf, 0x9b, 0xfa, 0x70, 0xad, 0xfa, 0x38, 0x10, 0xf5, 0x41, 0x57, 0x09, 0x91,
+		0x0e, 0x85, 0x9c, 0x50, 0xf4, 0xc1, 0xdb, 0xa6, 0x18, 0xc3, 0x23, 0x32, 0x86, 0x47, 0x64, 0x8c,
+		0xa1, 0x54, 0xfa, 0x86, 0x87, 0x90, 0x69, 0x41, 0x6b, 0x58, 0x59, 0xce, 0xc8, 0x5b, 0x67, 0x6b,
+		0x2c, 0x15, 0x5b, 0x06, 0xd6, 0x58, 0x02, 0xb0, 0xc6, 0x52, 0x83, 0xc6, 0x12, 0x27, 0xea, 0x04,
+		0x4e, 0xd4, 0x09, 0xa6, 0x13, 0x04, 0x22, 0xd2, 0x56, 0x1b, 0x00, 0x58, 0x6d, 0xb0, 0x7b, 0x9d,
+		0x85, 0xd4, 0xbd, 0x0b, 0x82, 0x26, 0x70, 0x8f, 0x57, 0xa6, 0x57, 0x97, 0x5f, 0x15, 0xfc, 0xce,
+		0x06, 0x32, 0x07, 0x29, 0xd4, 0xad, 0x2f, 0x9e, 0xc4, 0x61, 0x1f, 0x45, 0xa4, 0x87, 0x5a, 0x87,
+		0x38, 0x2e, 0xfb, 0x24, 0xe4, 0x87, 0x80, 0x1b, 0xfe, 0x47, 0x2e, 0x95, 0x21, 0xe7, 0x52, 0x8f,
+		0xde, 0xdb, 0x7e, 0xff, 0xe2, 0x4d, 0xbf, 0xdf, 0x7d, 0x73, 0xfe, 0xa6, 0xfb, 0xdd, 0x60, 0xd0,
+		0xbb, 0xe8, 0x61, 0xa2, 0xaf, 0xbf, 0x86, 0x1e, 0x0f, 0xb9, 0xf7, 0xee, 0xde, 0xb9, 0x04, 0x19,
+		0x07, 0x41, 0x53, 0x5a, 0xcc, 0x27, 0x6a, 0x31, 0x9f, 0xa8, 0xc5, 0x46, 0x42, 0xb2, 0x70, 0x75,
+		0xbf, 0x3f, 0xb6, 0x7a, 0xcc, 0xea, 0xb1, 0x2d, 0xeb, 0x9c, 0x42, 0x85, 0xa0, 0xc8, 0xbe, 0x43,
+		0x34, 0xfd, 0xc8, 0xe5, 0x44, 0xdf, 0x1c, 0x9c, 0x26, 0x73, 0xbb, 0x36, 0xa8, 0x7c, 0xcc, 0x6b,
+		0x72, 0xe8, 0x9b, 0x93, 0x09, 0x51, 0xac, 0x4f, 0x88, 0x62, 0xfd, 0x9d, 0x52, 0x01, 0x67, 0xd2,
+		0x86, 0x01, 0xad, 0x5c, 0x2f, 0x96, 0xeb, 0x29, 0x56, 0x28, 0xbe, 0xaa, 0x5e, 0x53, 0x7c, 0x71,
+		0x43, 0xe4, 0x8b, 0x1b, 0x22, 0x5f, 0xfc, 0x4b, 0x0a, 0x65, 0xb9, 0xc2, 0x72, 0x45, 0xf1, 0x3a,
+		0xc7, 0x06, 0x29, 0x14, 0x9e, 0xc0, 0x58, 0x3b, 0xd9, 0x30, 0x1a, 0xcb, 0x16, 0x8a, 0xb1, 0xbe,
+		0x86, 0xf5, 0xd1, 0x5f, 0x10, 0xba, 0xd0, 0x7c, 0x0f, 0xf4, 0xd9, 0x54, 0xb2, 0xe0, 0x36, 0xac,
+		0x16, 0xa2, 0x9f, 0xb9, 0x36, 0xe3, 0xa5, 0xba, 0x11, 0x83, 0xc4, 0x76, 0x6d, 0x86, 0xde, 0xe6,
+		0xd2, 0x75, 0xbb, 0xc7, 0xbf, 0x78, 0xad, 0x66, 0x5a, 0x5f, 0x3f, 0x51, 0xaa, 0xec, 0x9c, 0xd3,
+		0xb9, 0x34, 0xa5, 0x0e, 0x4c, 0xe3, 0x84, 0xd4, 0x86, 0xb0, 0xea, 0x13, 0xfa, 0x7c, 0x90, 0xf1,
+		0x94, 0xbe, 0x6b, 0xb8, 0x52, 0xbf, 0xa7, 0xf1, 0xe1, 0xcb, 0x32, 0xac, 0xdf, 0x35, 0x73, 0x64,
+		0xb1, 0x56, 0x4e, 0x09, 0xf0, 0xf7, 0x4c, 0x67, 0xe5, 0xfb, 0x4e, 0xab, 0x41, 0x36, 0x73, 0xae,
+		0xd4, 0x3f, 0xa5, 0x2e, 0x37, 0xbb, 0x64, 0x62, 0xa5, 0x98, 0x22, 0x99, 0xd6, 0x25, 0xf4, 0x1a,
+		0x42, 0xf5, 0x93, 0xef, 0x9b, 0x48, 0x35, 0x47, 0x43, 0x29, 0x95, 0x66, 0x99, 0x69, 0xb7, 0x9b,
+		0x0c, 0x4e, 0x34, 0xbe, 0xe1, 0x53, 0x36, 0x63, 0x89, 0x9f, 0xc1, 0x39, 0x53, 0xd2, 0xef, 0x68,
+		0x1e, 0xe9, 0xde, 0x59, 0x5a, 0x21, 0x78, 0x96, 0x5b, 0x81, 0x96, 0xbe, 0x41, 0x87, 0xf1, 0x58,
+		0xcb, 0x8c, 0xf7, 0x7e, 0x95, 0xfe, 0x95, 0xe9, 0xff, 0x3f, 0x63, 0x3b, 0xf6, 0x86, 0xc9, 0xff,
+		0xdc, 0xa1, 0xd3, 0xc2, 0xcd, 0x68, 0xcb, 0x6c, 0x12, 0x5b, 0xb6, 0x87, 0xa8, 0x95, 0xcb, 0xda,
+		0xe1, 0x6a, 0xe5, 0x3e, 0x6e, 0xb5, 0x72, 0x77, 0x77, 0xcf, 0xb7, 0x6e, 0x6d, 0xb1, 0x5c, 0x7d,
+		0xc5, 0x72, 0x85, 0xd6, 0x28, 0x3e, 0xc7, 0xe6, 0x31, 0xb7, 0xa6, 0xdd, 0xaa, 0xea, 0x64, 0xc3,
+		0x79, 0xdb, 0xf1, 0x9b, 0x8a, 0xb9, 0x1d, 0x31, 0x68, 0xb7, 0x1a, 0xb5, 0x1a, 0xe8, 0x56, 0xc2,
+		0x03, 0x2e, 0x4c, 0x40, 0x9f, 0x6a, 0xaf, 0x7b, 0x78, 0x73, 0x2d, 0x29, 0x8b, 0xaf, 0xab, 0xc8,
+		0x33, 0x11, 0xa1, 0x6a, 0x7f, 0xb3, 0x76, 0x38, 0x79, 0x36, 0x84, 0x48, 0x4c, 0x67, 0x01, 0x4f,
+		0x83, 0xee, 0xca, 0x37, 0x81, 0x0a, 0x5f, 0x4c, 0xe2, 0xd4, 0x0a, 0x02, 0xa1, 0xf9, 0x34, 0xb2,
+		0x85, 0xc0, 0x07, 0x5f, 0x08, 0x9c, 0x69, 0x51, 0xa4, 0x97, 0x27, 0x69, 0x4d, 0xf3, 0xf1, 0x5c,
+		0xdd, 0x64, 0x10, 0x11, 0x11, 0x7c, 0xe5, 0xf7, 0xdc, 0x83, 0xd1, 0x3d, 0x60, 0xde, 0x63, 0xdd,
+		0x3b, 0x95, 0x41, 0x45, 0x06, 0x17, 0x52, 0x4a, 0x1d, 0x42, 0xf2, 0xea, 0xf1, 0x46, 0xb3, 0xfa,
+		0x36, 0x98, 0xb5, 0xbe, 0x24, 0x6f, 0x6d, 0x2c, 0x0b, 0xd9, 0x3f, 0x87, 0x24, 0x4e, 0x68, 0x5c,
+		0x81, 0x9d, 0x29, 0x82, 0x10, 0x0b, 0xc6, 0x7b, 0xec, 0x42, 0x4c, 0xc1, 0x86, 0x29, 0xbb, 0x83,
+		0x5b, 0x43, 0x3e, 0xf0, 0x55, 0x08, 0xfa, 0x86, 0x43, 0xf2, 0x2e, 0x2b, 0xd5, 0xad, 0x54, 0x7f,
+		0x79, 0xe7, 0x2c, 0xd8, 0xfc, 0x84, 0x8d, 0x25, 0x71, 0x07, 0x03, 0x2b, 0xd4, 0xeb, 0x13, 0xea,
+		0x08, 0x74, 0xae, 0x0b, 0x75, 0x21, 0xc9, 0x42, 0x3d, 0xdb, 0xd2, 0x25, 0x2f, 0x00, 0xad, 0xc0,
+		0x38, 0xe6, 0x20, 0x8c, 0x03, 0x1e, 0x81, 0x90, 0xf0, 0xef, 0xe1, 0x2f, 0x7f, 0x3f, 0x85, 0x4f,
+		0x42, 0xc2, 0x34, 0x8e, 0x34, 0x8c, 0x38, 0xfc, 0x37, 0xee, 0x76, 0xcf, 0xc7, 0xdf, 0x03, 0x42,
+		0x81, 0x58, 0xc1, 0x0f, 0x60, 0x4b, 0x99, 0x01, 0xac, 0x92, 0x38, 0x02, 0x79, 0x68, 0x95, 0x44,
+		0x19, 0xf1, 0x0f, 0x4f, 0xa7, 0x24, 0x38, 0xa9, 0xea, 0x32, 0x6d, 0x4e, 0x55, 0x0e, 0x8b, 0x53,
+		0x48, 0x40, 0x2b, 0x48, 0x0b, 0x37, 0x41, 0xc8, 0xc4, 0xf4, 0x77, 0x59, 0xe1, 0xe9, 0x7f, 0xeb,
+		0x7c, 0x6c, 0x15, 0xc1, 0xd1, 0x29, 0x02, 0xba, 0x70, 0x37, 0x28, 0x09, 0xb9, 0x4f, 0x71, 0xec,
+		0xbc, 0x41, 0xb4, 0xfd, 0x6d, 0x1e, 0x3c, 0x5c, 0x09, 0x19, 0x9e, 0xa5, 0x98, 0x74, 0x1a, 0xe0,
+		0x2f, 0x7d, 0xd7, 0x99, 0xa9, 0x6f, 0x3c, 0xc4, 0xb3, 0xd8, 0xa2, 0x07, 0xd1, 0x5b, 0x1a, 0x32,
+		0x19, 0x4d, 0x85, 0x06, 0x54, 0x67, 0xcb, 0x4a, 0x2f, 0x87, 0x95, 0x62, 0x6a, 0xe1, 0xda, 0x85,
+		0x3d, 0xb5, 0xb0, 0x79, 0x9b, 0xc0, 0x66, 0xfb, 0x13, 0x19, 0x63, 0x0f, 0x76, 0x12, 0x29, 0x40,
+		0xf6, 0x33, 0xbf, 0x2f, 0x08, 0x6c, 0xe1, 0x2a, 0xe0, 0xf0, 0x95, 0x6f, 0x6b, 0x15, 0x6f, 0x39,
+		0xd1, 0x00, 0x5c, 0x59, 0xdb, 0xae, 0x99, 0x11, 0x0e, 0xd1, 0x73, 0xcc, 0x7e, 0xbe, 0x8e, 0x84,
+		0x84, 0x45, 0x9c, 0x30, 0x9e, 0x8e, 0x78, 0xf8, 0xfa, 0xf4, 0x6c, 0xe1, 0x8a, 0x38, 0x59, 0xf8,
+		0x0a, 0xd6, 0xbf, 0x63, 0x77, 0x27, 0x18, 0xa9, 0xb6, 0xaa, 0x28, 0x91, 0x6a, 0x64, 0xa1, 0xa4,
+		0x6e, 0x44, 0x04, 0x22, 0x02, 0x96, 0x7a, 0x2e, 0x22, 0xcd, 0x74, 0x42, 0x00, 0xac, 0x56, 0x21,
+		0xaa, 0x2f, 0x58, 0x53, 0x61, 0xde, 0xd2, 0xd8, 0x09, 0x12, 0xa3, 0xac, 0x2e, 0x83, 0x0d, 0x7d,
+		0xb6, 0x6b, 0xfa, 0x35, 0xb1, 0x2a, 0x26, 0x81, 0xe3, 0x43, 0x18, 0xaa, 0x70, 0x38, 0x9b, 0x5d,
+		0xb1, 0x09, 0x9d, 0x7e, 0x09, 0x54, 0x92, 0xc1, 0xef, 0x89, 0x62, 0xdc, 0x8c, 0xb6, 0xc3, 0x66,
+		0xb3, 0x8e, 0x66, 0x93, 0x27, 0xa1, 0xd9, 0xd2, 0x94, 0xf7, 0x4d, 0xa5, 0x4f, 0x3c, 0x8a, 0xd8,
+		0x84, 0x97, 0x24, 0x93, 0xe1, 0xf6, 0x85, 0x83, 0x30, 0xe0, 0x51, 0x04, 0xfa, 0x86, 0x49, 0x50,
+		0x21, 0xf0, 0x3f, 0x63, 0x16, 0x80, 0x56, 0x80, 0x8d, 0x3a, 0xd5, 0x4b, 0xcd, 0x69, 0x36, 0xad,
+		0x27, 0xa3, 0x26, 0x69, 0x65, 0xea, 0x22, 0x7a, 0xbd, 0xf9, 0x46, 0x0d, 0xe7, 0x7e, 0xe6, 0x66,
+		0x20, 0x41, 0x71, 0xee, 0xa7, 0x51, 0xd5, 0x15, 0x73, 0x3f, 0x45, 0xa4, 0xfb, 0xb8, 0x54, 0xa9,
+		0x3e, 0x3a, 0x53, 0xca, 0xb4, 0x86, 0x6f, 0x42, 0xdf, 0x00, 0x83, 0x6c, 0x4b, 0x0c, 0x42, 0x7a,
+		0xfc, 0xee, 0x30, 0x12, 0xa4, 0xf8, 0x31, 0x66, 0x48, 0xf1, 0xbd, 0xa5, 0x48, 0x09, 0x82, 0x67,
+		0x4d, 0x50, 0xdd, 0x6a, 0x1f, 0x85, 0xfc, 0x9a, 0xf8, 0xd3, 0x12, 0xe4, 0x27, 0x89, 0x51, 0xd1,
+		0x71, 0x6c, 0xfb, 0xf9, 0x73, 0xdc, 0xf7, 0xf3, 0x97, 0xe7, 0x43, 0xd3, 0xbd, 0xcb, 0x4c, 0xfa,
+		0xea, 0xde, 0x65, 0x0a, 0x43, 0xf3, 0x57, 0x22, 0x62, 0x1b, 0x2a, 0x2c, 0xed, 0x13, 0xcf, 0x5d,
+		0xee, 0x8f, 0xca, 0x9c, 0x06, 0xd5, 0x67, 0x60, 0xaa, 0x4b, 0x8d, 0xa4, 0x66, 0xc0, 0xe7, 0x3b,
+		0x2e, 0xcb, 0x5b, 0x96, 0xb7, 0x5e, 0x66, 0xde, 0xa1, 0x75, 0xab, 0x01, 0x58, 0xb7, 0x5a, 0x23,
+		0x87, 0x68, 0x24, 0x32, 0x96, 0x20, 0xd3, 0xd3, 0xf6, 0xd4, 0x00, 0xe4, 0xc2, 0x8c, 0x16, 0x12,
+		0xd8, 0xe3, 0xbf, 0xc0, 0x35, 0x89, 0xe5, 0x11, 0xb0, 0x28, 0x31, 0xae, 0x4d, 0x9c, 0x12, 0x2b,
+		0xe6, 0x7b, 0x56, 0xcc, 0x1f, 0x9f, 0x98, 0x2f, 0x32, 0xd8, 0xe7, 0x1f, 0xc7, 0x13, 0xd1, 0x2c,
+		0x60, 0xf7, 0xa8, 0x12, 0x87, 0x0d, 0xea, 0x2c, 0x77, 0x46, 0xae, 0xc3, 0x1a, 0x60, 0xcd, 0xd6,
+		0x3e, 0xf9, 0x07, 0x0b, 0x20, 0x7b, 0x5b, 0x62, 0xdf, 0x03, 0xd3, 0x3a, 0x14, 0xa3, 0x58, 0xf3,
+		0x39, 0x7c, 0x3d, 0xe1, 0x27, 0xa1, 0x75, 0x0d, 0x41, 0xa2, 0x3a, 0x52, 0x37, 0x40, 0x84, 0xfd,
+		0x5d, 0x9c, 0xcd, 0x42, 0x06, 0x75, 0x19, 0x70, 0x97, 0x04, 0x79, 0x59, 0xb0, 0x57, 0x06, 0x7d,
+		0x65, 0xf0, 0x97, 0x67, 0x02, 0xa2, 0x60, 0x47, 0xd2, 0x0a, 0x6d, 0x03, 0x95, 0xb7, 0x85, 0xca,
+		0xd8, 0x44, 0x65, 0x6d, 0xa3, 0xf9, 0xc7, 0x9e, 0x57, 0x71, 0x74, 0xe7, 0x55, 0x0c, 0x5e, 0xda,
+		0x69, 0x15, 0xed, 0xe3, 0x40, 0x74, 0xd7, 0x42, 0xba, 0xec, 0xda, 0xb9, 0xf6, 0x04, 0x96, 0x72,
+		0xdb, 0x0a, 0xfc, 0xfb, 0x10, 0xb4, 0x75, 0xfc, 0xaf, 0xfc, 0xbe, 0x47, 0xb7, 0xe8, 0xd2, 0x6e,
+		0xe5, 0x6c, 0x39, 0x5f, 0x85, 0x5c, 0x4c, 0xa4, 0xd9, 0x68, 0x40, 0x0f, 0x3a, 0x66, 0x8f, 0x31,
+		0xf7, 0xda, 0x0e, 0xd8, 0x19, 0xe5, 0xc5, 0xd6, 0x58, 0xb3, 0xc6, 0x1a, 0x00, 0x40, 0x35, 0x63,
+		0x0d, 0xef, 0x14, 0x2e, 0xe3, 0x1c, 0xce, 0x77, 0x12, 0xf3, 0xc4, 0x4b, 0x3c, 0x48, 0xfe, 0x4a,
+		0x90, 0xbf, 0x67, 0xd6, 0x77, 0xcb, 0xb1, 0xbe, 0x5b, 0x03, 0xeb, 0xbb, 0xdb, 0x58, 0xdf, 0xb5,
+		0xac, 0x6f, 0x59, 0xff, 0x45, 0xb2, 0xbe, 0xbb, 0xa7, 0x2c, 0x85, 0x76, 0x61, 0xe8, 0x79, 0xa1,
+		0xdf, 0x01, 0xc3, 0xea, 0xcf, 0xf1, 0x50, 0xfb, 0x06, 0xae, 0xd4, 0x45, 0x65, 0x05, 0xce, 0x3f,
+		0x25, 0x90, 0x3c, 0x56, 0x72, 0xcc, 0xf4, 0xeb, 0x57, 0x89, 0x79, 0x0d, 0xaf, 0xda, 0x90, 0xba,
+		0x20, 0x5e, 0x9f, 0x9e, 0x9e, 0xfd, 0x20, 0xbc, 0x93, 0x36, 0xbc, 0xea, 0x2c, 0x3d, 0x3c, 0xfb,
+		0x21, 0x21, 0xf0, 0xf6, 0xc7, 0xee, 0xc9, 0x09, 0x7c, 0xff, 0xf8, 0x6c, 0xc9, 0x79, 0x77, 0x42,
+		0x61, 0x94, 0x32, 0x49, 0x86, 0x9b, 0xa2, 0x74, 0x91, 0x6d, 0x17, 0xb1, 0xa4, 0x9c, 0xb2, 0x54,
+		0xce, 0x61, 0x55, 0x51, 0x0b, 0xb5, 0xe4, 0x20, 0xd6, 0x26, 0x77, 0x61, 0x77, 0x4e, 0xe2, 0xf6,
+		0x55, 0x6a, 0xea, 0x4c, 0x3d, 0xca, 0x51, 0x8b, 0x25, 0x52, 0x16, 0x37, 0xd0, 0xb0, 0x04, 0x45,
+		0xf0, 0x55, 0x38, 0x65, 0x4f, 0x05, 0x80, 0xb2, 0x29, 0x8d, 0xcd, 0x41, 0x60, 0xcb, 0xd2, 0x1c,
+		0x0a, 0xd5, 0xa9, 0x29, 0x90, 0xb9, 0x64, 0x9f, 0xa7, 0xfc, 0xa5, 0x73, 0xd4, 0xdc, 0xcb, 0x04,
+		0x15, 0x04, 0xe2, 0x2b, 0x87, 0x4c, 0xfc, 0xa5, 0xe9, 0xd1, 0xa7, 0xa7, 0x67, 0xc2, 0x4b, 0xfe,
+		0xe4, 0x9d, 0xf4, 0x49, 0x22, 0xf4, 0x36, 0x9e, 0xb8, 0xe9, 0x93, 0x57, 0x4f, 0x8a, 0x25, 0x7a,
+		0x42, 0xe5, 0x7e, 0xb0, 0xd4, 0xe4, 0x7a, 0x1f, 0xf7, 0x51, 0x9f, 0x45, 0x19, 0x76, 0xb8, 0x34,
+		0x4e, 0x52, 0x3a, 0x67, 0xff, 0x0c, 0x15, 0xed, 0x05, 0x5c, 0x72, 0x67, 0x3f, 0xfd, 0xef, 0xd0,
+		0xd9, 0x67, 0x71, 0xc8, 0xce, 0xa4, 0xbe, 0x86, 0x4b, 0x43, 0x68, 0x76, 0x63, 0xb5, 0x6a, 0x91,
+		0x3a, 0x33, 0x78, 0xfb, 0xd5, 0x12, 0x78, 0xfb, 0x55, 0xf3, 0x77, 0x07, 0xb8, 0xfc, 0xdd, 0x41,
+		0x89, 0xfc, 0xdd, 0x34, 0xd5, 0xc0, 0xa6, 0xed, 0x1e, 0x41, 0xda, 0x2e, 0xca, 0x49, 0xbc, 0x58,
+		0x4e, 0x84, 0x0b, 0x77, 0x1d, 0x17, 0xa6, 0x0b, 0xa8, 0xec, 0xd6, 0xc9, 0x01, 0x74, 0x00, 0x15,
+		0x41, 0xb5, 0x19, 0x86, 0x35, 0x80, 0x8a, 0x0e, 0x2e, 0x9c, 0x0a, 0xb5, 0x19, 0x86, 0x36, 0xc3,
+		0xb0, 0x96, 0xd0, 0xa1, 0xcd, 0x30, 0xa4, 0xf7, 0xcf, 0xa1, 0x89, 0x83, 0x72, 0xfb, 0x2f, 0x0b,
+		0x73, 0x97, 0x2e, 0xcc, 0xdd, 0x15, 0x61, 0x9e, 0x96, 0xa9, 0x5a, 0x61, 0x6e, 0x85, 0xf9, 0x4b,
+		0x3c, 0xab, 0xca, 0xb5, 0x92, 0xbc, 0x6a, 0xe2, 0x8c, 0x95, 0xe4, 0xdb, 0x3e, 0x49, 0xf8, 0x6a,
+		0xc0, 0x68, 0xf5, 0x3f, 0x03, 0x6a, 0xae, 0xb8, 0x54, 0x69, 0xbc, 0xf6, 0x31, 0xd3, 0xd6, 0xda,
+		0xe9, 0x56, 0xb4, 0xe7, 0xad, 0xb4, 0xb5, 0xd3, 0x01, 0xac, 0x9d, 0x6e, 0xa5, 0x3b, 0x46, 0xba,
+		0x97, 0xf1, 0xa1, 0x26, 0xce, 0x92, 0x1c, 0xbb, 0xfc, 0x19, 0xb9, 0x52, 0x9f, 0xf2, 0xe0, 0x9d,
+		0x79, 0x48, 0x7d, 0xc0, 0x60, 0x25, 0x46, 0xbe, 0x3d, 0x72, 0xbe, 0x19, 0x38, 0x4f, 0xb5, 0xed,
+		0x3e, 0x0f, 0xe4, 0xa9, 0x12, 0x23, 0x7f, 0x3e, 0xe7, 0xf2, 0x54, 0x88, 0x81, 0x1f, 0xc0, 0xf1,
+		0x3c, 0x29, 0x6a, 0x68, 0xe1, 0xed, 0xe3, 0x3f, 0xa1, 0x67, 0x75, 0xd6, 0x47, 0x73, 0x48, 0x4f,
+		0x36, 0xec, 0x82, 0x20, 0xe9, 0x80, 0x65, 0x11, 0xd2, 0x8d, 0x70, 0x28, 0x3d, 0xfa, 0x7c, 0xfc,
+		0xc7, 0xf7, 0xd4, 0xb9, 0x66, 0x2f, 0xf6, 0x60, 0x9f, 0x41, 0xb5, 0xb0, 0xe0, 0x00, 0x1d, 0x16,
+		0x6c, 0xe5, 0xcc, 0xcc, 0x19, 0xc6, 0x13, 0x43, 0x55, 0xee, 0x6d, 0x55, 0xbb, 0x05, 0x61, 0xc3,
+		0xc7, 0x64, 0x48, 0x6c, 0xec, 0xd0, 0xf3, 0x80, 0xc1, 0x58, 0xc5, 0x46, 0xba, 0x2b, 0x1f, 0x24,
+		0xff, 0x96, 0xec, 0x43, 0x23, 0xd0, 0x0a, 0xf2, 0xdf, 0x64, 0x83, 0x89, 0x55, 0xf6, 0x91, 0x35,
+		0x07, 0x13, 0xf3, 0x8f, 0x85, 0xda, 0x94, 0xb1, 0x05, 0x51, 0x70, 0xa8, 0xe5, 0x98, 0x28, 0x24,
+		0x54, 0xd0, 0x90, 0xa1, 0x40, 0x87, 0x08, 0xa1, 0xb2, 0xaa, 0xe0, 0xf9, 0xbb, 0x2a, 0x8a, 0x72,
+		0x4c, 0x68, 0x1b, 0x24, 0xfa, 0x46, 0xa9, 0x96, 0x0d, 0x13, 0x6d, 0xe3, 0x54, 0xbc, 0x70, 0x45,
+		0x87, 0x4d, 0x0c, 0x68, 0xac, 0x38, 0xa8, 0xc0, 0x8a, 0xb9, 0x19, 0x1f, 0x96, 0x03, 0x9f, 0x0f,
+		0x07, 0x16, 0x79, 0x28, 0x9e, 0x33, 0x23, 0x1e, 0x71, 0xf1, 0x40, 0xf3, 0x9e, 0x8e, 0x1d, 0x52,
+		0xc2, 0x56, 0x07, 0x54, 0x67, 0xf5, 0xdd, 0x6c, 0xff, 0x42, 0xaa, 0x03, 0xca, 0x78, 0x4e, 0xea,
+		0xa6, 0xfd, 0xe1, 0x15, 0x06, 0x94, 0xf0, 0xac, 0xec, 0x81, 0xd6, 0x95, 0x6b, 0x02, 0xf6, 0xef,
+		0x79, 0x69, 0x06, 0x2a, 0x87, 0x94, 0xf7, 0xbf, 0x77, 0xcf, 0x4c, 0x09, 0xa0, 0x1d, 0x66, 0xbc,
+		0xa6, 0xc0, 0x6f, 0x72, 0xdd, 0x6e, 0x51, 0x6d, 0x80, 0x7c, 0x9d, 0x8f, 0x70, 0x43, 0x48, 0xa5,
+		0x5f, 0xa7, 0x27, 0x90, 0x7e, 0x89, 0xb8, 0xee, 0x64, 0x57, 0x4a, 0x45, 0xaf, 0x7d, 0x15, 0x04,
+		0xea, 0x9b, 0x90, 0x93, 0x4e, 0x24, 0x46, 0x81, 0x90, 0x93, 0xcb, 0xc5, 0x41, 0xa5, 0xd9, 0x35,
+		0x3b, 0x6d, 0x98, 0xff, 0x75, 0x72, 0x9d, 0xa7, 0xd3, 0x49, 0x3a, 0x7c, 0x31, 0xae, 0x5b, 0x16,
+		0x08, 0x2f, 0xf1, 0x82, 0x81, 0xcf, 0x44, 0x10, 0x81, 0xf0, 0x17, 0xbf, 0x07, 0x22, 0x02, 0xa9,
+		0x34, 0xc4, 0x52, 0xfc, 0x19, 0xf3, 0xf9, 0xf1, 0x74, 0x85, 0x07, 0x59, 0x53, 0xb9, 0xb2, 0xbc,
+		0xb2, 0x2e, 0xc5, 0x73, 0x2b, 0x3c, 0x56, 0x7a, 0xfa, 0x0d, 0xec, 0xfe, 0x28, 0x5a, 0x77, 0x65,
+		0x0f, 0xe8, 0x32, 0xcc, 0xfd, 0x01, 0x65, 0x49, 0x42, 0xd5, 0xa1, 0xd5, 0x89, 0xb2, 0x3c, 0xa7,
+		0xa6, 0xd6, 0x19, 0xab, 0xf1, 0x36, 0x6e, 0xbd, 0x4a, 0x86, 0x95, 0xe0, 0x62, 0xc4, 0x21, 0xe4,
+		0x33, 0xce, 0x8c, 0x48, 0x6e, 0x9a, 0x2f, 0xa8, 0xda, 0xa9, 0x3a, 0x11, 0xb0, 0xf3, 0x6d, 0x58,
+		0x72, 0x5f, 0x6f, 0x0e, 0xdb, 0xec, 0xd5, 0x23, 0x73, 0x59, 0x5f, 0x15, 0x79, 0x7c, 0xa5, 0x66,
+		0x10, 0xf0, 0x5b, 0x1e, 0x80, 0x88, 0x20, 0x7d, 0xa1, 0xae, 0xdf, 0x4f, 0x9c, 0x0e, 0xb3, 0x49,
+		0x4f, 0xf1, 0xd6, 0x79, 0x54, 0x5f, 0xf5, 0xfc, 0x38, 0x43, 0x7e, 0xe4, 0xa4, 0x28, 0x62, 0xb2,
+		0x65, 0x3d, 0xf2, 0x63, 0x24, 0x4e, 0x6b, 0xfb, 0x68, 0x97, 0xc6, 0xe5, 0x24, 0xaf, 0x1e, 0x75,
+		0x92, 0x6d, 0xd4, 0xc6, 0xa8, 0x96, 0x77, 0xd6, 0x8f, 0xad, 0xda, 0xad, 0x1c, 0xf5, 0xe9, 0x0c,
+		0x21, 0xe2, 0x63, 0x25, 0x3d, 0xd0, 0x8b, 0x15, 0x5e, 0xdc, 0x03, 0x09, 0x1d, 0x48, 0x76, 0x71,
+		0x4a, 0x26, 0x66, 0x59, 0xba, 0x79, 0x7b, 0xcc, 0xc8, 0x8b, 0x4e, 0xe1, 0x83, 0x27, 0x34, 0x44,
+		0xf7, 0xd3, 0x91, 0x0a, 0x20, 0xba, 0x51, 0x71, 0xe0, 0xcd, 0x59, 0xe8, 0x56, 0x18, 0x45, 0xbf,
+		0xf1, 0xeb, 0xdb, 0xbd, 0x6c, 0x8f, 0x5e, 0xb5, 0xb5, 0xac, 0xd5, 0x3c, 0x2f, 0x5a, 0xfe, 0xfd,
+		0x75, 0x45, 0x48, 0x46, 0x3b, 0xc5, 0xd0, 0xb8, 0x2d, 0xbc, 0x7f, 0x2e, 0x3f, 0xaa, 0xb5, 0x2b,
+		0x80, 0xe1, 0x64, 0x77, 0x22, 0x16, 0x17, 0xc4, 0xa5, 0xed, 0x90, 0x51, 0xad, 0x75, 0x62, 0x16,
+		0xc4, 0xb0, 0xba, 0x45, 0x31, 0x2c, 0xb7, 0x96, 0x18, 0xd6, 0x31, 0x86, 0xb0, 0xea, 0x8a, 0x60,
+		0x15, 0x66, 0x3c, 0xe2, 0x2f, 0x12, 0x44, 0x5c, 0x20, 0x88, 0xcc, 0x5a, 0xc7, 0xb9, 0x47, 0xf1,
+		0xae, 0xe8, 0xc7, 0x54, 0xec, 0x6e, 0x17, 0x7b, 0x28, 0x52, 0xd9, 0x3c, 0x3d, 0x7a, 0x7e, 0xde,
+		0x03, 0xce, 0xb7, 0x4b, 0x9f, 0xae, 0x7b, 0x98, 0xd3, 0xad, 0x37, 0x01, 0x01, 0x5d, 0xdd, 0xeb,
+		0x8e, 0x70, 0xe5, 0xbd, 0xee, 0x08, 0x2f, 0xcd, 0xd2, 0x6b, 0xef, 0x4d, 0x2f, 0x50, 0x7e, 0x26,
+		0xdc, 0x84, 0xe6, 0xd3, 0xca, 0x95, 0xbe, 0x56, 0xb0, 0x55, 0x15, 0x6c, 0xc5, 0xd7, 0xf3, 0x24,
+		0x11, 0x72, 0xfc, 0x0d, 0x3d, 0x98, 0x80, 0xfa, 0xfa, 0xad, 0xbc, 0x37, 0x19, 0x38, 0x28, 0xd1,
+		0x78, 0x7b, 0x2b, 0x6f, 0x79, 0x2c, 0x91, 0x31, 0x85, 0x14, 0x4a, 0xb6, 0x22, 0xac, 0x74, 0x82,
+		0x7c, 0xd7, 0xd6, 0x0c, 0xac, 0x2f, 0x89, 0x3b, 0x18, 0xd8, 0xa2, 0x01, 0x64, 0xff, 0xa2, 0x92,
+		0xb0, 0xf3, 0x31, 0xad, 0x24, 0xec, 0x7c, 0x4c, 0xce, 0xe9, 0xc8, 0x22, 0x02, 0x8b, 0x4d, 0x0b,
+		0x08, 0x09, 0x3a, 0x93, 0xec, 0x75, 0xcb, 0x74, 0xd7, 0xca, 0xf4, 0xa3, 0x95, 0xe9, 0xb6, 0x14,
+		0xec, 0x85, 0x8b, 0x75, 0x5b, 0x0a, 0x86, 0x96, 0xea, 0xa5, 0x8e, 0xd3, 0xca, 0xbb, 0xf9, 0xd4,
+		0x9e, 0xa8, 0xb5, 0xea, 0x08, 0xce, 0xfc, 0xb0, 0x67, 0xb9, 0x3b, 0x5a, 0x28, 0xf2, 0x0e, 0xbf,
+		0xfb, 0xdd, 0xbc, 0x24, 0xbd, 0x1e, 0xf7, 0x5d, 0x4d, 0x79, 0xf4, 0x95, 0xfc, 0xdb, 0xdb, 0xdd,
+		0xcb, 0xc8, 0x79, 0x60, 0x5c, 0xdd, 0xc6, 0x46, 0x18, 0xea, 0x2b, 0x35, 0xfb, 0x68, 0xbc, 0xd2,
+		0xbb, 0x9d, 0xdd, 0x6b, 0xed, 0x8a, 0xdc, 0xdd, 0xa6, 0x39, 0x30, 0x9d, 0xd8, 0x0d, 0x8f, 0x3e,
+		0xef, 0xd7, 0x52, 0x69, 0x08, 0xf9, 0x58, 0x4d, 0xa7, 0x5c, 0x7a, 0xdc, 0x83, 0x51, 0xac, 0x17,
+		0x29, 0x09, 0x51, 0x3c, 0x9b, 0xa9, 0x50, 0x73, 0xef, 0x64, 0x87, 0x3b, 0xbb, 0xbb, 0xcb, 0x9d,
+		0xdd, 0x7d, 0xb1, 0xee, 0xec, 0x9d, 0x4a, 0xbb, 0x58, 0x49, 0xe7, 0x29, 0x65, 0x73, 0xa8, 0xb5,
+		0xe6, 0xa1, 0xdc, 0xa9, 0x85, 0x9d, 0x2f, 0xc3, 0xce, 0x7f, 0xae, 0xff, 0x3a, 0x7f, 0xe8, 0x7c,
+		0xe9, 0x76, 0xbe, 0xbb, 0xfe, 0x9b, 0x53, 0x14, 0xf4, 0x69, 0xad, 0xfe, 0x95, 0xcd, 0x63, 0x17,
+		0x73, 0x38, 0x22, 0xfa, 0x89, 0x7d, 0xe5, 0x9f, 0x95, 0xda, 0x5c, 0xbd, 0x75, 0x86, 0x71, 0xda,
+		0xad, 0x1d, 0x2c, 0xf1, 0x9e, 0xdf, 0x8a, 0x71, 0xc6, 0x04, 0x0f, 0xad, 0x87, 0xff, 0x03, 0x00,
+		0x00, 0xff, 0xff, 0x03, 0x00, 0x5b, 0x77, 0xa9, 0x4d, 0xc7, 0xdd, 0x00, 0x00,
 	}
 )
 
@@ -862,6 +956,9 @@ var (
 // of the map ensures that there are no clashes with valid YANG identifiers.
 func initΛEnumTypes(){
   ΛEnumTypes = map[string][]reflect.Type{
+	"/cont1a/cont2a/leaf2h": []reflect.Type{
+		reflect.TypeOf((E_OnfTest1_Cont1A_Cont2A_Leaf2H)(0)),
+	},
   }
 }
 
@@ -5,12 +5,12 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.24.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
-	- /config-model/yang/onf-test1@2019-06-10.yang
-	- /config-model/yang/onf-test1-augmented@2020-02-29.yang
+	- yang/onf-test1@2019-06-10.yang
+	- yang/onf-test1-augmented@2020-02-29.yang
 Imported modules were sourced from:
-	- "/config-model/yang"/...
+	- yang/...
 */
 package api
 
@@ -201,6 +201,8 @@ type OnfTest1_Cont1A_Cont2A struct {
 	Leaf2E	[]int16	`path:"leaf2e" module:"onf-test1"`
 	Leaf2F	Binary	`path:"leaf2f" module:"onf-test1"`
 	Leaf2G	*bool	`path:"leaf2g" module:"onf-test1"`
+	Leaf2H	OnfTest1_Cont1A_Cont2A_Leaf2H_Union	`path:"leaf2h" module:"onf-test1"`
+	Leaf2I	[]OnfTest1_Cont1A_Cont2A_Leaf2I_Union	`path:"leaf2i" module:"onf-test1"`
 }
 
 // IsYANGGoStruct ensures that OnfTest1_Cont1A_Cont2A implements the yang.GoStruct
@@ -231,6 +233,110 @@ func (*OnfTest1_Cont1A_Cont2A) ΛBelongingModule() string {
 	return "onf-test1"
 }
 
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union is an interface that is implemented by valid types for the union
+// for the leaf /onf-test1/cont1a/cont2a/leaf2h within the YANG schema.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface {
+	Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union()
+}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H is used when /onf-test1/cont1a/cont2a/leaf2h
+// is to be set to a E_OnfTest1_Cont1A_Cont2A_Leaf2H value.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H struct {
+	E_OnfTest1_Cont1A_Cont2A_Leaf2H	E_OnfTest1_Cont1A_Cont2A_Leaf2H
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H) Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union() {}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union_String is used when /onf-test1/cont1a/cont2a/leaf2h
+// is to be set to a string value.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union_String struct {
+	String	string
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2H_Union_String
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2H_Union_String) Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union() {}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16 is used when /onf-test1/cont1a/cont2a/leaf2h
+// is to be set to a uint16 value.
+type OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16 struct {
+	Uint16	uint16
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16) Is_OnfTest1_Cont1A_Cont2A_Leaf2H_Union() {}
+
+// To_OnfTest1_Cont1A_Cont2A_Leaf2H_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the OnfTest1_Cont1A_Cont2A_Leaf2H_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *OnfTest1_Cont1A_Cont2A) To_OnfTest1_Cont1A_Cont2A_Leaf2H_Union(i interface{}) (OnfTest1_Cont1A_Cont2A_Leaf2H_Union, error) {
+	switch v := i.(type) {
+	case E_OnfTest1_Cont1A_Cont2A_Leaf2H:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2H_Union_E_OnfTest1_Cont1A_Cont2A_Leaf2H{v}, nil
+	case string:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2H_Union_String{v}, nil
+	case uint16:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2H_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to OnfTest1_Cont1A_Cont2A_Leaf2H_Union, unknown union type, got: %T, want any of [E_OnfTest1_Cont1A_Cont2A_Leaf2H, string, uint16]", i, i)
+	}
+}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2I_Union is an interface that is implemented by valid types for the union
+// for the leaf /onf-test1/cont1a/cont2a/leaf2i within the YANG schema.
+type OnfTest1_Cont1A_Cont2A_Leaf2I_Union interface {
+	Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union()
+}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Bool is used when /onf-test1/cont1a/cont2a/leaf2i
+// is to be set to a bool value.
+type OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Bool struct {
+	Bool	bool
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Bool
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2I_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Bool) Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union() {}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Int32 is used when /onf-test1/cont1a/cont2a/leaf2i
+// is to be set to a int32 value.
+type OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Int32 struct {
+	Int32	int32
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Int32
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2I_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Int32) Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union() {}
+
+// OnfTest1_Cont1A_Cont2A_Leaf2I_Union_String is used when /onf-test1/cont1a/cont2a/leaf2i
+// is to be set to a string value.
+type OnfTest1_Cont1A_Cont2A_Leaf2I_Union_String struct {
+	String	string
+}
+
+// Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union ensures that OnfTest1_Cont1A_Cont2A_Leaf2I_Union_String
+// implements the OnfTest1_Cont1A_Cont2A_Leaf2I_Union interface.
+func (*OnfTest1_Cont1A_Cont2A_Leaf2I_Union_String) Is_OnfTest1_Cont1A_Cont2A_Leaf2I_Union() {}
+
+// To_OnfTest1_Cont1A_Cont2A_Leaf2I_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the OnfTest1_Cont1A_Cont2A_Leaf2I_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *OnfTest1_Cont1A_Cont2A) To_OnfTest1_Cont1A_Cont2A_Leaf2I_Union(i interface{}) (OnfTest1_Cont1A_Cont2A_Leaf2I_Union, error) {
+	switch v := i.(type) {
+	case bool:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Bool{v}, nil
+	case int32:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2I_Union_Int32{v}, nil
+	case string:
+		return &OnfTest1_Cont1A_Cont2A_Leaf2I_Union_String{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to OnfTest1_Cont1A_Cont2A_Leaf2I_Union, unknown union type, got: %T, want any of [bool, int32, string]", i, i)
+	}
+}
+
 
 // OnfTest1_Cont1A_Cont2D represents the /onf-test1/cont1a/cont2d YANG schema element.
 type OnfTest1_Cont1A_Cont2D struct {
@@ -507,6 +613,36 @@ const (
 )
 
 
+// E_OnfTest1_Cont1A_Cont2A_Leaf2H is a derived int64 type which is used to represent
+// the enumerated node OnfTest1_Cont1A_Cont2A_Leaf2H. An additional value named
+// OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OnfTest1_Cont1A_Cont2A_Leaf2H int64
+
+// IsYANGGoEnum ensures that OnfTest1_Cont1A_Cont2A_Leaf2H implements the yang.GoEnum
+// interface. This ensures that OnfTest1_Cont1A_Cont2A_Leaf2H can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OnfTest1_Cont1A_Cont2A_Leaf2H) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OnfTest1_Cont1A_Cont2A_Leaf2H.
+func (E_OnfTest1_Cont1A_Cont2A_Leaf2H) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum; }
+
+// String returns a logging-friendly string for E_OnfTest1_Cont1A_Cont2A_Leaf2H.
+func (e E_OnfTest1_Cont1A_Cont2A_Leaf2H) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OnfTest1_Cont1A_Cont2A_Leaf2H")
+}
+
+const (
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET corresponds to the value UNSET of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_UNSET E_OnfTest1_Cont1A_Cont2A_Leaf2H = 0
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_auto corresponds to the value auto of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_auto E_OnfTest1_Cont1A_Cont2A_Leaf2H = 1
+	// OnfTest1_Cont1A_Cont2A_Leaf2H_off corresponds to the value off of OnfTest1_Cont1A_Cont2A_Leaf2H
+	OnfTest1_Cont1A_Cont2A_Leaf2H_off E_OnfTest1_Cont1A_Cont2A_Leaf2H = 2
+)
+
+
 // E_OnfTest1_Cont1A_Cont2D_Chocolate is a derived int64 type which is used to represent
 // the enumerated node OnfTest1_Cont1A_Cont2D_Chocolate. An additional value named
 // OnfTest1_Cont1A_Cont2D_Chocolate_UNSET is added to the enumeration which is used as
@@ -549,6 +685,10 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		1: {Name: "IDTYPE1", DefiningModule: "onf-test1-identities"},
 		2: {Name: "IDTYPE2", DefiningModule: "onf-test1-identities"},
 	},
+	"E_OnfTest1_Cont1A_Cont2A_Leaf2H": {
+		1: {Name: "auto"},
+		2: {Name: "off"},
+	},
 	"E_OnfTest1_Cont1A_Cont2D_Chocolate": {
 		1: {Name: "dark"},
 		2: {Name: "milk"},
@@ -565,178 +705,198 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5f, 0x6f, 0xdb, 0x38,
-		0x12, 0x7f, 0xef, 0xa7, 0x18, 0xf8, 0x25, 0xdb, 0x43, 0xdc, 0xc8, 0x72, 0x92, 0xa6, 0x01, 0xee,
-		0xc1, 0x69, 0x52, 0x5c, 0xb1, 0xed, 0x6e, 0xd1, 0x06, 0x7b, 0xd8, 0xeb, 0x05, 0x07, 0x5a, 0x1a,
-		0xdb, 0x44, 0x65, 0xd2, 0x47, 0x52, 0x6e, 0x7c, 0x8b, 0x7c, 0xf7, 0x03, 0x25, 0xd9, 0xf1, 0x5f,
-		0x89, 0x94, 0x64, 0xc7, 0x4e, 0xb8, 0x2f, 0xdb, 0xd8, 0x24, 0x4d, 0x0e, 0x67, 0x7e, 0x33, 0x1c,
-		0xce, 0x0c, 0xff, 0x7a, 0x05, 0x00, 0xd0, 0xf8, 0x8d, 0x0c, 0xb1, 0x71, 0x09, 0x8d, 0x10, 0xc7,
-		0x34, 0xc0, 0xc6, 0x71, 0xfa, 0xe9, 0xaf, 0x94, 0x85, 0x8d, 0x4b, 0x68, 0x65, 0x7f, 0xbe, 0xe7,
-		0xac, 0x47, 0xfb, 0x8d, 0x4b, 0xf0, 0xb2, 0x0f, 0xae, 0xa9, 0x68, 0x5c, 0x42, 0x3a, 0x44, 0xf2,
-		0x41, 0xc0, 0x99, 0x6a, 0x91, 0x85, 0xcf, 0x16, 0x86, 0xcf, 0xbe, 0x3f, 0x5e, 0xfc, 0xf6, 0x1a,
-		0x65, 0x20, 0xe8, 0x48, 0x51, 0xce, 0x74, 0xa3, 0xdb, 0x01, 0x82, 0xe2, 0x23, 0x88, 0x70, 0x8c,
-		0x11, 0xe8, 0x2e, 0x84, 0x32, 0x14, 0xcb, 0xbd, 0x16, 0x27, 0x37, 0xfb, 0x78, 0x79, 0x92, 0xb3,
-		0x2f, 0xbe, 0x08, 0xec, 0xd1, 0xfb, 0x95, 0xb9, 0x2d, 0xcc, 0x4f, 0xb5, 0x96, 0x7e, 0x25, 0xf9,
-		0xf6, 0x1b, 0x8f, 0x45, 0x80, 0x6b, 0x7b, 0xa6, 0x33, 0xc1, 0xc9, 0x4f, 0x2e, 0xf4, 0x64, 0x1a,
-		0xa3, 0xf4, 0x47, 0x8e, 0xd7, 0x37, 0xfc, 0x07, 0x91, 0x1d, 0xd1, 0x8f, 0x87, 0xc8, 0x54, 0xe3,
-		0x12, 0x94, 0x88, 0x71, 0x43, 0xc3, 0xb9, 0x56, 0x7a, 0x4e, 0x2b, 0x8d, 0x1e, 0x16, 0x3e, 0x79,
-		0x58, 0xa6, 0xe7, 0xd2, 0xb6, 0x2c, 0x6c, 0x8f, 0x4f, 0x36, 0x2f, 0x64, 0x7e, 0x9b, 0x7c, 0xb2,
-		0x69, 0x15, 0x6b, 0xb6, 0xcb, 0x67, 0x61, 0xc1, 0x76, 0x15, 0x6c, 0x5b, 0xe1, 0xf6, 0x99, 0x6c,
-		0xa3, 0xd9, 0x76, 0x9a, 0x6e, 0xab, 0xf5, 0xf6, 0x5a, 0x6f, 0xb3, 0xf1, 0x76, 0xaf, 0xdf, 0xf6,
-		0x0d, 0xdb, 0x5f, 0xc8, 0x06, 0xb3, 0x06, 0x11, 0x92, 0x5e, 0x0e, 0x3b, 0xac, 0x90, 0x33, 0x6b,
-		0x5f, 0xb0, 0x98, 0x25, 0xf6, 0xf8, 0x2d, 0x1e, 0xa2, 0xa0, 0x01, 0xe8, 0xce, 0x40, 0x99, 0xa4,
-		0x21, 0xc2, 0xfb, 0x29, 0x93, 0x80, 0xc9, 0x70, 0x3d, 0x12, 0x47, 0x9a, 0x34, 0xdf, 0x73, 0x1b,
-		0x26, 0x8d, 0xfd, 0x46, 0x6e, 0x9b, 0xbb, 0x82, 0xdf, 0xca, 0x78, 0xd3, 0x2b, 0x68, 0x56, 0xc4,
-		0xa3, 0x36, 0xbc, 0x6a, 0xc7, 0xb3, 0xb6, 0xbc, 0x5b, 0x9a, 0x87, 0x4b, 0xf3, 0xb2, 0x35, 0x4f,
-		0xe7, 0xf3, 0x76, 0x01, 0x8f, 0xcf, 0x7e, 0xed, 0x76, 0x32, 0x42, 0x3b, 0x3a, 0xc7, 0x94, 0xa9,
-		0x0b, 0x13, 0x52, 0x67, 0x4c, 0x71, 0x66, 0xd0, 0xf4, 0x2b, 0x61, 0x7d, 0x34, 0xe2, 0x54, 0xfd,
-		0x9f, 0xd9, 0xd6, 0x25, 0x03, 0x7f, 0xa6, 0xcc, 0x78, 0xaf, 0x67, 0x9d, 0xfe, 0x20, 0x51, 0x8c,
-		0x9b, 0xa1, 0x76, 0x63, 0xbf, 0x0f, 0x82, 0x04, 0x5a, 0x7a, 0xaf, 0x69, 0x9f, 0x2a, 0x59, 0xcc,
-		0xe6, 0xab, 0x24, 0xc6, 0x3e, 0x51, 0x74, 0xac, 0x7f, 0xbb, 0x47, 0x22, 0x89, 0xc6, 0xbd, 0x1f,
-		0x8e, 0x2d, 0x48, 0x42, 0xee, 0xcb, 0x93, 0xa4, 0x7d, 0x38, 0x24, 0x79, 0x55, 0x23, 0xe1, 0x76,
-		0xc6, 0x71, 0x8e, 0xe5, 0x56, 0x69, 0xf2, 0xec, 0x78, 0xae, 0xb0, 0xd5, 0x5d, 0x25, 0x48, 0xc7,
-		0x7b, 0x25, 0x48, 0x33, 0x66, 0x52, 0x91, 0x6e, 0x64, 0x08, 0xee, 0x02, 0x7b, 0x28, 0x90, 0x05,
-		0x5b, 0x01, 0xe1, 0xa9, 0xe6, 0xf8, 0xfa, 0xe1, 0x3d, 0x9c, 0x7b, 0xa7, 0x5e, 0xc3, 0x82, 0x75,
-		0x2c, 0xf5, 0xf5, 0x3a, 0xbd, 0xfd, 0xb8, 0x36, 0x4b, 0x3e, 0x28, 0xab, 0xc2, 0xd7, 0xaa, 0xf2,
-		0xd9, 0xe2, 0xf7, 0x8d, 0x9b, 0x5e, 0x95, 0xe0, 0xb3, 0xd4, 0xa2, 0xed, 0x5a, 0x5a, 0xc0, 0x5d,
-		0x4b, 0x0b, 0xf8, 0x0f, 0x1e, 0x29, 0xd2, 0xc7, 0xd2, 0x16, 0xb0, 0xb3, 0x4a, 0x0f, 0xd5, 0x2a,
-		0xfd, 0x4c, 0x58, 0x48, 0x14, 0x17, 0x93, 0x62, 0x2b, 0xac, 0x84, 0x05, 0x1b, 0x62, 0x40, 0x87,
-		0x24, 0x3a, 0x3f, 0xb5, 0xb0, 0x62, 0x5b, 0xbe, 0x41, 0xdb, 0x15, 0xcd, 0xd3, 0x7e, 0xb9, 0xb6,
-		0x6f, 0xbb, 0xbc, 0xd2, 0xd5, 0x6c, 0xb9, 0x7f, 0x76, 0x88, 0xef, 0x79, 0xde, 0x13, 0x12, 0x65,
-		0x2f, 0x2d, 0x91, 0xf2, 0xba, 0x23, 0xb0, 0xd4, 0x1d, 0x81, 0xa5, 0xee, 0xf8, 0x8a, 0x24, 0x04,
-		0xce, 0xa2, 0xc9, 0xce, 0xb4, 0x87, 0xef, 0xb4, 0xc7, 0xc1, 0xfa, 0x34, 0xa4, 0x12, 0x94, 0xf5,
-		0x6d, 0xd4, 0xc1, 0xc5, 0xb6, 0x04, 0x23, 0xb4, 0x14, 0x8c, 0xd0, 0x52, 0x30, 0x3a, 0x8c, 0xab,
-		0x01, 0x0a, 0xc8, 0x94, 0xa0, 0xb3, 0xab, 0x9c, 0x64, 0x38, 0x5b, 0xe9, 0x70, 0x6d, 0xa5, 0x3d,
-		0x75, 0xda, 0x38, 0x63, 0xa9, 0x4e, 0x9d, 0x80, 0x96, 0x3a, 0x01, 0x2d, 0x75, 0x42, 0x62, 0x22,
-		0x45, 0x54, 0x2a, 0xa7, 0x0d, 0x9c, 0x36, 0xc8, 0xa3, 0x33, 0x65, 0xaa, 0x75, 0x6e, 0xa1, 0x09,
-		0xfc, 0xc3, 0xc5, 0xf4, 0xea, 0xf8, 0xe5, 0x3d, 0xc3, 0x13, 0xb0, 0x73, 0xc5, 0x5b, 0x49, 0xd8,
-		0x27, 0x2a, 0x55, 0x47, 0x29, 0x61, 0x26, 0x65, 0x9f, 0x29, 0xbb, 0x89, 0x50, 0xcb, 0xbf, 0x21,
-		0xa9, 0xf4, 0x76, 0xce, 0xf5, 0x68, 0x5d, 0x9c, 0x9e, 0x9e, 0xbf, 0x3d, 0x3d, 0xf5, 0xde, 0xb6,
-		0xdf, 0x7a, 0xef, 0xce, 0xce, 0x5a, 0xe7, 0x2d, 0x93, 0xcb, 0xd7, 0xdf, 0x45, 0x88, 0x02, 0xc3,
-		0xab, 0x49, 0xe3, 0x12, 0x58, 0x1c, 0x45, 0xdb, 0xd2, 0x62, 0x3d, 0x4b, 0x2d, 0xd6, 0xb3, 0xd4,
-		0x62, 0x5d, 0xca, 0x88, 0x98, 0x38, 0x6f, 0xb1, 0xd3, 0x63, 0x85, 0x74, 0x4e, 0x59, 0xc5, 0x42,
-		0x91, 0xbd, 0x33, 0x68, 0xfa, 0x09, 0x59, 0x5f, 0x0d, 0xf6, 0x4e, 0x93, 0xf9, 0x9e, 0xbb, 0x53,
-		0x3e, 0x64, 0x9a, 0xec, 0xfb, 0xe1, 0xa4, 0x6f, 0x09, 0xeb, 0x7d, 0x4b, 0x58, 0xbf, 0xe2, 0x3c,
-		0x42, 0xc2, 0x1c, 0xae, 0x3b, 0x5c, 0x2f, 0xc6, 0xf5, 0x94, 0x57, 0x6c, 0x7c, 0x55, 0xad, 0xb2,
-		0x72, 0x61, 0x15, 0x52, 0x6a, 0x11, 0x93, 0xd1, 0x18, 0xc6, 0xb2, 0x38, 0x6a, 0xd3, 0xda, 0xb9,
-		0xfd, 0x4b, 0x2a, 0x7c, 0xaf, 0xe1, 0xef, 0x70, 0xa4, 0x77, 0xfc, 0x08, 0xb8, 0x00, 0x16, 0x0f,
-		0xbb, 0x28, 0x7e, 0x79, 0x73, 0x92, 0x46, 0xa8, 0xbe, 0x86, 0x7f, 0xc7, 0x9e, 0xd7, 0x0e, 0xc0,
-		0xc8, 0xdb, 0x77, 0x23, 0x04, 0x17, 0x9f, 0x51, 0x4a, 0xd2, 0xb7, 0x60, 0xda, 0xe9, 0xac, 0x3e,
-		0xf6, 0xe0, 0x93, 0x96, 0x68, 0x9f, 0x00, 0x95, 0x40, 0xba, 0x7c, 0x8c, 0x70, 0x0a, 0x6a, 0x80,
-		0xa9, 0xa4, 0xfb, 0x7d, 0xd0, 0x74, 0x80, 0x2e, 0x26, 0xfc, 0x09, 0x3d, 0x2e, 0xf4, 0x97, 0x30,
-		0x26, 0x11, 0x0d, 0x89, 0xc6, 0x06, 0x50, 0x1c, 0x46, 0x44, 0x4a, 0x53, 0xa6, 0x2f, 0x11, 0x49,
-		0x32, 0x2f, 0x61, 0xa8, 0x57, 0xdb, 0x1c, 0x66, 0xcb, 0xb5, 0x50, 0x54, 0x55, 0xa2, 0x48, 0x16,
-		0x04, 0xae, 0x3e, 0x8a, 0xd5, 0xa4, 0x52, 0x1e, 0x4a, 0x8a, 0xce, 0x9d, 0x95, 0xe8, 0x74, 0x18,
-		0xe3, 0x8a, 0x64, 0xda, 0x20, 0x47, 0x6c, 0x64, 0x30, 0xc0, 0x21, 0x19, 0x91, 0xc4, 0x04, 0x6b,
-		0x9c, 0x70, 0xd6, 0x6b, 0x2a, 0x94, 0xaa, 0x75, 0x92, 0xe6, 0x4e, 0x9c, 0xe4, 0xc6, 0xe6, 0xa7,
-		0x23, 0x28, 0x11, 0x07, 0x8a, 0x65, 0x0c, 0xfa, 0x3b, 0xeb, 0xdd, 0xea, 0xfe, 0xff, 0xd1, 0xca,
-		0xa6, 0xd5, 0x49, 0xfe, 0xe7, 0x77, 0xd6, 0xd3, 0x6e, 0x75, 0xad, 0x6b, 0x56, 0x93, 0x66, 0x07,
-		0x84, 0x86, 0x59, 0x04, 0xa1, 0x61, 0x16, 0xc1, 0x9c, 0x2a, 0x0c, 0xf7, 0x23, 0x75, 0x80, 0x1c,
-		0x62, 0xee, 0x00, 0xd9, 0x6d, 0xf2, 0x40, 0xd8, 0xb6, 0xbd, 0x00, 0xd7, 0x3d, 0xec, 0x0c, 0xa7,
-		0x14, 0xf7, 0x53, 0xbb, 0x89, 0xb3, 0x79, 0x9b, 0x29, 0x04, 0x12, 0xf7, 0xf5, 0xda, 0x31, 0xd4,
-		0x98, 0xb0, 0x36, 0xb9, 0x68, 0x4f, 0xed, 0x28, 0xf2, 0x1c, 0x0d, 0x29, 0xe2, 0x6e, 0xc4, 0x37,
-		0x20, 0x32, 0x23, 0xc1, 0x0f, 0x73, 0x41, 0x49, 0x9b, 0x5b, 0x4b, 0x09, 0xa1, 0xfd, 0x81, 0x82,
-		0x9e, 0xe0, 0x43, 0xf8, 0xfa, 0xe1, 0x7d, 0xf3, 0xdc, 0xf3, 0x3d, 0x43, 0x61, 0x38, 0x73, 0xc2,
-		0x70, 0x78, 0xc2, 0x50, 0x84, 0xd0, 0x8f, 0x48, 0x4d, 0x14, 0x36, 0x99, 0x66, 0x0e, 0x7b, 0xf3,
-		0x76, 0xae, 0xaf, 0x21, 0x15, 0x32, 0x96, 0x3a, 0x35, 0x6c, 0x6e, 0xca, 0x5a, 0x65, 0x58, 0xac,
-		0x24, 0xab, 0x55, 0x31, 0xb5, 0x2b, 0xb1, 0x5e, 0x2d, 0xb6, 0x76, 0x39, 0x56, 0xb4, 0x74, 0xd3,
-		0x18, 0xee, 0x95, 0x29, 0x8b, 0x3e, 0x9a, 0x96, 0x03, 0x1e, 0x70, 0xcd, 0x73, 0xf6, 0x44, 0x9f,
-		0x59, 0x9d, 0xb3, 0x21, 0x2c, 0x69, 0xb6, 0x04, 0xa8, 0xc8, 0xe2, 0x21, 0x0a, 0xa2, 0x6d, 0x8b,
-		0xcc, 0x65, 0x03, 0x01, 0x91, 0x08, 0x8f, 0x12, 0x51, 0x83, 0x35, 0x52, 0xd2, 0x3a, 0xa9, 0x2c,
-		0x45, 0x55, 0xa4, 0xa9, 0xa2, 0x54, 0x55, 0x95, 0xae, 0xda, 0xa4, 0xac, 0x36, 0x69, 0xab, 0x2e,
-		0x75, 0x76, 0xd2, 0x57, 0xc2, 0xcd, 0x6c, 0x67, 0x3d, 0x6d, 0xdc, 0xe9, 0xa9, 0x4c, 0x68, 0x11,
-		0x29, 0xb1, 0xe3, 0x53, 0x13, 0xeb, 0xb4, 0x44, 0xdf, 0x1b, 0x16, 0x0f, 0xcb, 0xf3, 0xca, 0x2d,
-		0xff, 0x96, 0x1a, 0x82, 0x65, 0x47, 0x48, 0x46, 0xf1, 0x92, 0x48, 0x32, 0x22, 0x7e, 0x94, 0xe4,
-		0xb4, 0x64, 0x90, 0x96, 0x1e, 0x64, 0x48, 0xa3, 0x4a, 0x83, 0xf8, 0x7a, 0x90, 0x1e, 0x15, 0x52,
-		0x35, 0xc9, 0x98, 0xd0, 0x28, 0xf1, 0xc6, 0x95, 0x1a, 0xee, 0xe1, 0xb8, 0x2c, 0x45, 0x3f, 0x32,
-		0x55, 0x8d, 0x9c, 0x09, 0x25, 0xad, 0x21, 0x6b, 0x61, 0x88, 0x65, 0x12, 0x18, 0x85, 0x6a, 0x6c,
-		0xf6, 0x58, 0xea, 0x5d, 0xb9, 0x84, 0x56, 0x39, 0x42, 0x6e, 0x5b, 0xda, 0x9f, 0x48, 0x87, 0x1b,
-		0xfa, 0xad, 0xca, 0xf9, 0xb1, 0xc2, 0x93, 0xe4, 0xac, 0x73, 0x32, 0x67, 0x66, 0xd6, 0xe5, 0xd4,
-		0x33, 0x38, 0x52, 0xc8, 0x11, 0x17, 0x4a, 0x36, 0x89, 0x40, 0x46, 0xec, 0x4d, 0xe3, 0x85, 0xde,
-		0xce, 0x38, 0x76, 0xc6, 0xf1, 0xb6, 0x8d, 0xe3, 0x2e, 0xa2, 0x28, 0x6f, 0x17, 0x27, 0xbd, 0xab,
-		0x99, 0xc4, 0x3e, 0x0b, 0x01, 0x87, 0x23, 0x35, 0x59, 0xb4, 0x88, 0xe7, 0x05, 0xc1, 0xd9, 0xc4,
-		0xce, 0x26, 0x7e, 0x61, 0x36, 0xb1, 0x16, 0x88, 0x2a, 0xd6, 0x70, 0x7b, 0x5b, 0x66, 0x80, 0x05,
-		0x98, 0x8f, 0x04, 0xaa, 0xff, 0x61, 0x54, 0x1e, 0x5d, 0xa6, 0x03, 0x54, 0x3c, 0x73, 0x3b, 0x70,
-		0x71, 0xe0, 0xe2, 0xc0, 0xe5, 0x10, 0xc0, 0xe5, 0xf0, 0xce, 0x18, 0x0b, 0xf6, 0xfa, 0x6e, 0x42,
-		0x07, 0x8a, 0xae, 0x6e, 0xed, 0x96, 0x6d, 0xb5, 0xdc, 0xc6, 0x4e, 0xe2, 0x81, 0x6a, 0x0d, 0x6a,
-		0x08, 0x2b, 0x06, 0x35, 0x5c, 0x57, 0x09, 0x6a, 0xd0, 0x4a, 0xa7, 0x65, 0x50, 0x1a, 0x31, 0x6b,
-		0x67, 0x16, 0xd4, 0xf0, 0x69, 0x6d, 0xac, 0xdf, 0xe6, 0xee, 0xf9, 0xca, 0xc8, 0xd5, 0x46, 0xac,
-		0xaf, 0x36, 0x62, 0x21, 0x34, 0x9b, 0xdf, 0x20, 0x3f, 0xde, 0x1c, 0xe7, 0xb4, 0x31, 0x0c, 0xaa,
-		0x36, 0xcb, 0xae, 0x30, 0xf7, 0x55, 0x4c, 0x03, 0x85, 0xcf, 0x0c, 0x81, 0xb8, 0x6c, 0x7c, 0xb0,
-		0x7d, 0x5c, 0xf0, 0x83, 0x59, 0x5a, 0x88, 0xfd, 0x52, 0x5b, 0xde, 0xfe, 0xad, 0xb5, 0xde, 0x00,
-		0x33, 0x33, 0x3c, 0xa3, 0xd2, 0xa8, 0xd4, 0x6b, 0xd6, 0xce, 0x0c, 0xcf, 0x3a, 0x20, 0xe9, 0x70,
-		0x14, 0x61, 0x9a, 0x64, 0xc9, 0x7b, 0xda, 0xe6, 0xee, 0xd1, 0x7e, 0x9c, 0x5e, 0x09, 0x00, 0x55,
-		0x38, 0x94, 0xae, 0xee, 0xeb, 0xde, 0xd7, 0x7d, 0xcd, 0xb4, 0xa8, 0x61, 0x34, 0x4a, 0xd2, 0xda,
-		0x2e, 0x18, 0xe5, 0x76, 0x90, 0xb1, 0x08, 0x95, 0xf0, 0x03, 0x27, 0x18, 0x42, 0x77, 0x02, 0x26,
-		0xe3, 0xb8, 0x20, 0xf7, 0xda, 0x0e, 0x4b, 0xcf, 0xb1, 0x58, 0xc9, 0xe1, 0x66, 0x2f, 0x9d, 0xba,
-		0xe4, 0xa5, 0x65, 0x92, 0x5c, 0xb8, 0xdc, 0x25, 0xd3, 0x33, 0x59, 0x8e, 0x32, 0x13, 0xf7, 0xcd,
-		0x11, 0xff, 0x69, 0x70, 0x31, 0x30, 0x93, 0xbb, 0x59, 0x0f, 0xdb, 0x4a, 0x54, 0x01, 0xd2, 0x31,
-		0x82, 0x51, 0x5f, 0x87, 0xe3, 0x2f, 0x07, 0xc7, 0x63, 0xdb, 0x6a, 0x0a, 0xe7, 0x07, 0x5b, 0x4d,
-		0xc1, 0xe5, 0xa0, 0xae, 0xd2, 0xa4, 0xed, 0x72, 0x50, 0xeb, 0xc0, 0x71, 0x65, 0x8d, 0xe3, 0xaa,
-		0x1c, 0x8e, 0xdf, 0x0a, 0xc2, 0xe4, 0x90, 0x2a, 0x07, 0xe4, 0x0e, 0xc8, 0x5f, 0x2c, 0x90, 0xbb,
-		0xfa, 0xf4, 0x07, 0xad, 0xdb, 0x9e, 0x1a, 0xc7, 0xad, 0xdc, 0x31, 0xbf, 0xe2, 0xa4, 0xc0, 0x8d,
-		0x62, 0x56, 0x5f, 0xc7, 0xbc, 0xae, 0xce, 0x52, 0x3d, 0x9d, 0x9c, 0xb3, 0xa7, 0x59, 0xd1, 0x9c,
-		0x7d, 0xcc, 0x06, 0x9f, 0xa5, 0x7c, 0x4f, 0xd5, 0xe0, 0x2c, 0xe9, 0x7b, 0xf6, 0xcd, 0xf4, 0xa0,
-		0xf3, 0xda, 0x04, 0xd2, 0x16, 0xb5, 0xa4, 0x65, 0x28, 0xe0, 0x74, 0x0e, 0xb3, 0xcc, 0xe5, 0x08,
-		0xa5, 0x04, 0x35, 0x20, 0x0c, 0xa6, 0x93, 0x80, 0x66, 0x92, 0xc5, 0x9c, 0x94, 0xf2, 0x1d, 0x6b,
-		0x91, 0xd3, 0x7f, 0x4e, 0x20, 0x20, 0x0c, 0xe4, 0x80, 0x08, 0x04, 0x2a, 0xc1, 0xf7, 0x76, 0x94,
-		0x04, 0x1e, 0xce, 0x2d, 0xf6, 0x29, 0x52, 0xc0, 0xeb, 0xa3, 0xd7, 0x0e, 0xa3, 0x45, 0xab, 0xd5,
-		0x09, 0x98, 0x2d, 0x99, 0x4a, 0xe8, 0x0b, 0x24, 0x0a, 0x45, 0xba, 0x60, 0x2e, 0x00, 0xff, 0x1b,
-		0x93, 0x08, 0x14, 0x07, 0xc3, 0xa3, 0x79, 0x5d, 0x6c, 0xf0, 0xf4, 0xb5, 0x00, 0xec, 0xa8, 0xf2,
-		0x22, 0xf3, 0xfd, 0x73, 0x2f, 0x68, 0xa0, 0xf8, 0x6a, 0x5c, 0xeb, 0x16, 0x8b, 0x7c, 0xff, 0xdc,
-		0x87, 0x05, 0x3b, 0xd3, 0x78, 0xab, 0xb5, 0x48, 0x5e, 0x70, 0xd3, 0x74, 0xa2, 0x5a, 0x97, 0xb9,
-		0x31, 0x5a, 0xcb, 0x27, 0x95, 0x9b, 0x7b, 0x85, 0x2c, 0xa4, 0xac, 0x6f, 0x73, 0x77, 0xee, 0x8a,
-		0x03, 0x6c, 0x3e, 0x72, 0xec, 0xae, 0x38, 0x40, 0x41, 0x89, 0x88, 0x15, 0x82, 0x16, 0xc6, 0x7f,
-		0x40, 0xb9, 0x92, 0x11, 0x86, 0xdc, 0xb1, 0xab, 0x73, 0xac, 0x4b, 0x74, 0xae, 0xc5, 0xd0, 0x2e,
-		0x40, 0xad, 0xa5, 0x57, 0x1e, 0x4d, 0xcc, 0x54, 0x4d, 0x39, 0x99, 0xfb, 0x6e, 0x94, 0x01, 0x2a,
-		0xdc, 0xce, 0xde, 0xac, 0xa5, 0x12, 0xd2, 0x01, 0x55, 0xfd, 0x30, 0x21, 0x0d, 0x9e, 0x80, 0xaa,
-		0x06, 0x14, 0x6b, 0xd7, 0x51, 0x79, 0x8f, 0xee, 0xf2, 0x35, 0x4b, 0xbe, 0xb6, 0x2c, 0xd2, 0x92,
-		0xeb, 0x5e, 0xed, 0xcd, 0x55, 0x8b, 0x8b, 0xeb, 0x79, 0x9c, 0xed, 0xdc, 0xbc, 0xd2, 0xc7, 0x8a,
-		0xbb, 0x4d, 0xa9, 0xd6, 0xe5, 0x23, 0x2f, 0x3e, 0x69, 0x3c, 0x6d, 0x95, 0xff, 0xb0, 0x71, 0x07,
-		0x24, 0x06, 0x9c, 0x85, 0xeb, 0x5e, 0x37, 0x4e, 0xec, 0x5d, 0x2a, 0x81, 0xb3, 0xb4, 0xe4, 0x51,
-		0x32, 0x1e, 0x10, 0xa5, 0x04, 0xed, 0xc6, 0x0a, 0xe5, 0x1b, 0xb8, 0x09, 0xa9, 0x02, 0x39, 0x19,
-		0x76, 0x79, 0x04, 0x72, 0xc0, 0xe3, 0x28, 0x04, 0xc6, 0x13, 0xeb, 0x79, 0x4c, 0x25, 0xd5, 0x9c,
-		0x6d, 0xf9, 0x40, 0xb2, 0xef, 0x1e, 0x48, 0x5e, 0xab, 0xb7, 0x02, 0xc3, 0xd2, 0x46, 0x81, 0xf1,
-		0x03, 0xc9, 0x54, 0x26, 0xa5, 0xae, 0x80, 0xe1, 0xcf, 0xb9, 0x1d, 0x27, 0x61, 0x88, 0x21, 0x50,
-		0x96, 0x1c, 0x74, 0x7c, 0xaf, 0xf5, 0x0e, 0xc6, 0x28, 0x24, 0xe5, 0xec, 0x0d, 0xc0, 0x3f, 0x11,
-		0x42, 0xce, 0x8e, 0x14, 0x0c, 0xc8, 0x18, 0xb5, 0x11, 0x2c, 0xc9, 0x04, 0xa8, 0x3a, 0x92, 0x70,
-		0x94, 0xc6, 0xdc, 0xa4, 0x2e, 0x93, 0x23, 0x68, 0x02, 0x1d, 0x8e, 0x22, 0x9a, 0x86, 0x58, 0x8c,
-		0x88, 0xd8, 0x8c, 0x39, 0x2e, 0x02, 0xa7, 0x82, 0x4f, 0x76, 0x0b, 0xc5, 0x93, 0xda, 0x96, 0x2f,
-		0x2f, 0xb7, 0x49, 0xc9, 0x27, 0x52, 0xb2, 0x6a, 0x82, 0x30, 0x26, 0x82, 0x92, 0x55, 0x9c, 0x70,
-		0x3e, 0xff, 0x17, 0xec, 0xf3, 0xdf, 0x65, 0xa5, 0xc9, 0x82, 0x0a, 0xac, 0x6d, 0xcb, 0x77, 0x18,
-		0xdb, 0xdd, 0x92, 0xf2, 0x90, 0x15, 0x14, 0x73, 0xe2, 0xe0, 0xc4, 0x61, 0x99, 0xce, 0x2f, 0x2a,
-		0x26, 0xed, 0xcc, 0x5d, 0x82, 0xad, 0xdc, 0x0b, 0xba, 0x4b, 0xb0, 0xed, 0x5c, 0x82, 0xd5, 0xe3,
-		0x0d, 0xcd, 0x8e, 0x59, 0x27, 0xb9, 0xe6, 0x77, 0xe1, 0xe1, 0xef, 0xea, 0x9b, 0x1e, 0x24, 0xcd,
-		0x19, 0x7a, 0x5f, 0x35, 0x67, 0xc8, 0xa4, 0x10, 0x6a, 0xee, 0x03, 0x77, 0x6b, 0x0e, 0x89, 0x8b,
-		0x47, 0xbf, 0xaa, 0xc9, 0x42, 0xbe, 0x33, 0xe7, 0x2b, 0x32, 0xaf, 0x79, 0xb2, 0x50, 0x61, 0x08,
-		0x85, 0x41, 0xe8, 0x84, 0x61, 0xc8, 0xc4, 0xb6, 0x52, 0x85, 0x5a, 0x9e, 0xf1, 0x9b, 0x67, 0xcf,
-		0x21, 0x5d, 0xc8, 0xdf, 0xcf, 0xe5, 0x3e, 0x55, 0xc6, 0x50, 0xd7, 0x30, 0x63, 0xa8, 0x5b, 0x36,
-		0x63, 0x28, 0x05, 0xb7, 0x24, 0x53, 0xe8, 0x18, 0x7e, 0x52, 0x35, 0x00, 0x02, 0x21, 0x8f, 0xbb,
-		0x11, 0xc2, 0x0f, 0x9c, 0x54, 0x75, 0x5d, 0x38, 0xac, 0xdb, 0xba, 0xeb, 0x82, 0xb2, 0x10, 0xef,
-		0x5b, 0xe6, 0x47, 0xb5, 0xac, 0x7d, 0xd9, 0x04, 0x22, 0xdd, 0xdb, 0x9d, 0xd0, 0xdc, 0x09, 0x6d,
-		0x5e, 0xc3, 0x5e, 0x58, 0x1c, 0xd0, 0xce, 0x0e, 0x36, 0x46, 0xd1, 0xc5, 0x9a, 0xaf, 0x2a, 0xeb,
-		0xb3, 0x33, 0x77, 0x3e, 0xab, 0xc1, 0xdd, 0x96, 0xa0, 0xaa, 0x6f, 0x89, 0xe1, 0xbe, 0xc3, 0x70,
-		0x87, 0xe1, 0x0e, 0xc3, 0x1d, 0x86, 0x3b, 0x0c, 0xdf, 0x07, 0x0c, 0x4f, 0xae, 0x40, 0x2c, 0x5f,
-		0x5f, 0xb1, 0x7e, 0x7b, 0xa5, 0x33, 0xbd, 0x2c, 0x99, 0x79, 0xa3, 0xa6, 0xf7, 0xd5, 0x1a, 0xd9,
-		0xeb, 0xc6, 0x74, 0xdf, 0x61, 0xfa, 0xc1, 0x62, 0xfa, 0x8b, 0xba, 0x39, 0x71, 0xe9, 0x43, 0x6b,
-		0xfc, 0x68, 0x0e, 0xd5, 0xeb, 0x42, 0xf5, 0xd0, 0x12, 0xd5, 0x43, 0xeb, 0x8b, 0x70, 0xa0, 0x21,
-		0x32, 0x45, 0xd5, 0x44, 0x60, 0x6f, 0x37, 0xd8, 0xee, 0xec, 0xf5, 0xc3, 0xc5, 0xf6, 0x39, 0x66,
-		0xb1, 0x01, 0x78, 0x13, 0xb3, 0xfd, 0x63, 0x36, 0xf4, 0x15, 0x91, 0x25, 0x92, 0x6a, 0x3e, 0xff,
-		0x79, 0xd5, 0xf9, 0x76, 0x63, 0xba, 0x3f, 0x09, 0x54, 0x49, 0x63, 0x65, 0x62, 0xa7, 0x50, 0x16,
-		0xe6, 0xf5, 0xf1, 0xfa, 0xf6, 0xcf, 0x2f, 0x37, 0xad, 0xc6, 0x36, 0x60, 0xba, 0xd2, 0x94, 0xfc,
-		0xba, 0x8b, 0x9d, 0xdf, 0xed, 0x3a, 0xf6, 0x3b, 0x3f, 0xc9, 0x32, 0x75, 0x36, 0x43, 0xae, 0xbf,
-		0x62, 0xcb, 0xd9, 0x96, 0x17, 0xdb, 0xca, 0xb6, 0xac, 0xf7, 0x0a, 0x3d, 0xf7, 0x16, 0x07, 0x0c,
-		0xaf, 0xd0, 0x93, 0xdc, 0xa2, 0xab, 0x9a, 0x72, 0x8b, 0x2a, 0x45, 0x80, 0xaf, 0x0f, 0xc0, 0x36,
-		0x5c, 0x87, 0x49, 0x30, 0xb8, 0x56, 0xb4, 0x1d, 0x75, 0xcb, 0x47, 0x9f, 0x70, 0xbc, 0xa6, 0x50,
-		0xf6, 0x82, 0x42, 0x9e, 0x6b, 0x57, 0x14, 0x10, 0x9e, 0xd4, 0xba, 0x26, 0x2a, 0x51, 0xbb, 0x8f,
-		0x51, 0xe1, 0xbf, 0x30, 0xae, 0x40, 0x60, 0xc0, 0x87, 0x43, 0x64, 0x21, 0x86, 0xd0, 0x8d, 0xd5,
-		0x2c, 0x57, 0x52, 0xc6, 0xa3, 0x11, 0x17, 0x0a, 0xc3, 0xd7, 0x1b, 0x02, 0xbe, 0xbd, 0x4d, 0x01,
-		0xdf, 0xde, 0x8b, 0x0d, 0xf8, 0xde, 0xa8, 0xf3, 0x8a, 0xcf, 0x2f, 0x79, 0xe7, 0x95, 0xc6, 0x17,
-		0xa2, 0x14, 0x0a, 0xb6, 0x51, 0xa7, 0x34, 0xbe, 0x77, 0x9a, 0xff, 0xba, 0xfb, 0xab, 0xfd, 0xd0,
-		0xfc, 0xee, 0x35, 0xdf, 0xdd, 0xfd, 0xad, 0x51, 0x94, 0x16, 0xf1, 0x6a, 0xf1, 0x5f, 0xd9, 0x3a,
-		0x36, 0x09, 0x47, 0x83, 0xca, 0x0f, 0xe4, 0x07, 0x7e, 0xe5, 0x7c, 0x95, 0x7a, 0xcb, 0x02, 0xd3,
-		0x98, 0xff, 0x6a, 0x41, 0x24, 0xae, 0x71, 0x4c, 0x83, 0x4c, 0x08, 0x1e, 0x5e, 0x3d, 0xfc, 0x1f,
-		0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xec, 0x8a, 0x63, 0x86, 0x12, 0xaa, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xdf, 0x6f, 0xdb, 0x38,
+		0xf2, 0x7f, 0xf7, 0x5f, 0x31, 0xd0, 0x4b, 0xb7, 0x5f, 0xd8, 0x1b, 0x49, 0x4e, 0xd2, 0x34, 0xc0,
+		0xf7, 0xc1, 0x6d, 0x53, 0x5c, 0xb1, 0xed, 0xee, 0xa2, 0xcd, 0xed, 0x61, 0xaf, 0x17, 0x1c, 0x68,
+		0x89, 0xb6, 0x89, 0xca, 0xa4, 0x4f, 0xa4, 0xdc, 0xf8, 0x16, 0xf9, 0xdf, 0x0f, 0x94, 0x6c, 0xd7,
+		0xbf, 0x24, 0x71, 0x24, 0xd9, 0xb1, 0x13, 0xfa, 0x65, 0xb7, 0x36, 0xa9, 0x90, 0xc3, 0xcf, 0x7c,
+		0x66, 0x86, 0x9c, 0xa1, 0xfe, 0x6a, 0x01, 0x00, 0x38, 0xbf, 0x92, 0x31, 0x75, 0xae, 0xc1, 0x09,
+		0xe9, 0x94, 0x05, 0xd4, 0x69, 0x67, 0xdf, 0xfe, 0xc2, 0x78, 0xe8, 0x5c, 0x83, 0x37, 0xff, 0xe7,
+		0x5b, 0xc1, 0x07, 0x6c, 0xe8, 0x5c, 0x83, 0x3b, 0xff, 0xe2, 0x1d, 0x8b, 0x9d, 0x6b, 0xc8, 0x1e,
+		0x01, 0x00, 0xe0, 0x04, 0x82, 0x2b, 0x8f, 0xac, 0x7d, 0xb7, 0xf6, 0xf8, 0xf9, 0xef, 0xed, 0xf5,
+		0x5f, 0xdf, 0x51, 0x19, 0xc4, 0x6c, 0xa2, 0x98, 0xe0, 0xba, 0xd1, 0xed, 0x88, 0x82, 0x12, 0x13,
+		0x88, 0xe8, 0x94, 0x46, 0xa0, 0xbb, 0x10, 0xc6, 0x69, 0xbc, 0xd9, 0x6b, 0x7d, 0x70, 0xcb, 0xaf,
+		0x37, 0x07, 0xb9, 0xfc, 0xe1, 0xf7, 0x98, 0x0e, 0xd8, 0xfd, 0xd6, 0xd8, 0xd6, 0xc6, 0xa7, 0x3c,
+		0xa7, 0xbd, 0xfd, 0xeb, 0x17, 0x91, 0xc4, 0x01, 0xdd, 0xd9, 0x33, 0x1b, 0x09, 0x9d, 0x7d, 0x17,
+		0xb1, 0x1e, 0x8c, 0x33, 0xc9, 0xfe, 0x48, 0x7b, 0x77, 0xc3, 0xbf, 0x11, 0xd9, 0x8b, 0x87, 0xc9,
+		0x98, 0x72, 0xe5, 0x5c, 0x83, 0x8a, 0x13, 0x9a, 0xd3, 0x70, 0xa5, 0x95, 0x1e, 0xd3, 0x56, 0xa3,
+		0x87, 0xb5, 0x6f, 0x1e, 0x36, 0xe5, 0xb9, 0xb1, 0x2c, 0x6b, 0xcb, 0xe3, 0x93, 0xfc, 0x89, 0xac,
+		0x2e, 0x93, 0x4f, 0xf2, 0x66, 0xb1, 0x63, 0xb9, 0x7c, 0x1e, 0x96, 0x2c, 0x57, 0xc9, 0xb2, 0x95,
+		0x2e, 0x9f, 0xc9, 0x32, 0x9a, 0x2d, 0xa7, 0xe9, 0xb2, 0xa2, 0x97, 0x17, 0xbd, 0xcc, 0xc6, 0xcb,
+		0xbd, 0x7b, 0xd9, 0x73, 0x96, 0xbf, 0x14, 0x06, 0x8b, 0x8f, 0x13, 0x51, 0x32, 0xf0, 0x49, 0x61,
+		0x9b, 0x35, 0x71, 0xce, 0xdb, 0x97, 0x4c, 0x66, 0x03, 0x1e, 0xbf, 0x26, 0x63, 0x1a, 0xb3, 0x00,
+		0x74, 0x67, 0x60, 0x5c, 0xb2, 0x90, 0xc2, 0xdb, 0x05, 0x48, 0xc0, 0xe4, 0x71, 0x03, 0x92, 0x44,
+		0x5a, 0x34, 0x5f, 0x0b, 0x1b, 0x02, 0x00, 0x38, 0xbe, 0x53, 0xd8, 0xe6, 0xae, 0xe4, 0x6f, 0xcd,
+		0xb1, 0xe9, 0x96, 0x34, 0x2b, 0xc3, 0x28, 0x06, 0xab, 0x38, 0xcc, 0x62, 0xb1, 0x5b, 0x19, 0xc3,
+		0x95, 0xb1, 0x8c, 0xc6, 0x74, 0x31, 0xb6, 0x4b, 0x30, 0xbe, 0xf8, 0x38, 0xb7, 0xb3, 0x09, 0xc5,
+		0xc9, 0x39, 0x61, 0x5c, 0x5d, 0x99, 0x88, 0x7a, 0x0e, 0x8a, 0x0b, 0x83, 0xa6, 0x9f, 0x09, 0x1f,
+		0x52, 0x23, 0xa4, 0x02, 0x80, 0xe1, 0xd2, 0x01, 0x00, 0x38, 0x9f, 0x18, 0x77, 0xae, 0x11, 0x1d,
+		0x00, 0x00, 0x9c, 0x3f, 0x48, 0x94, 0xd0, 0x7c, 0xaa, 0xcd, 0xfb, 0x38, 0xef, 0x63, 0x12, 0x68,
+		0xed, 0x7d, 0xc7, 0x86, 0x4c, 0xc9, 0x72, 0x98, 0x6f, 0x8b, 0x98, 0x0e, 0x89, 0x62, 0x53, 0xfd,
+		0xb7, 0x07, 0x24, 0x92, 0xd4, 0xb8, 0xf7, 0x43, 0x1b, 0x21, 0x12, 0x72, 0x5f, 0x5d, 0x24, 0xdd,
+		0xd3, 0x11, 0x49, 0xab, 0x41, 0xc1, 0x1d, 0x0c, 0x71, 0x16, 0x72, 0xdb, 0x32, 0x79, 0x72, 0x98,
+		0x2b, 0x6d, 0x75, 0x57, 0x8b, 0xd2, 0xe9, 0xbd, 0x8a, 0x49, 0x27, 0xe1, 0x52, 0x91, 0x7e, 0x64,
+		0x48, 0xee, 0x31, 0x1d, 0xd0, 0x98, 0xf2, 0x60, 0x2f, 0x24, 0xbc, 0xb0, 0x1c, 0x9f, 0xdf, 0xbf,
+		0x85, 0x4b, 0xf7, 0xdc, 0x75, 0x10, 0xd0, 0x41, 0xda, 0xeb, 0x5d, 0x76, 0xfb, 0xc7, 0xdc, 0x90,
+		0x38, 0xa8, 0x6a, 0xc2, 0x77, 0x9a, 0xf2, 0xe5, 0xe4, 0x8f, 0x0d, 0x4d, 0xad, 0x0a, 0x38, 0xcb,
+		0x3c, 0xda, 0x3e, 0xd2, 0x03, 0xee, 0x23, 0x3d, 0xe0, 0x3f, 0x44, 0xa4, 0xc8, 0x90, 0x56, 0xf6,
+		0x80, 0xad, 0x57, 0xda, 0x18, 0xa4, 0x0f, 0xec, 0x95, 0x7e, 0x22, 0x3c, 0x24, 0x4a, 0xc4, 0xb3,
+		0x72, 0x2f, 0xac, 0x82, 0x07, 0x1b, 0xd2, 0x80, 0x8d, 0x49, 0x74, 0x79, 0x8e, 0xf0, 0x62, 0x3d,
+		0xbf, 0xdd, 0xc2, 0x5b, 0x9e, 0xee, 0xf3, 0xf5, 0x7d, 0xbb, 0xd5, 0x8d, 0xae, 0x86, 0xe5, 0xf1,
+		0xf9, 0x21, 0xbe, 0xeb, 0xba, 0x8f, 0x28, 0x94, 0xa3, 0xf4, 0x44, 0xaa, 0xdb, 0x8e, 0x00, 0x69,
+		0x3b, 0x02, 0xa4, 0xed, 0xf8, 0x4c, 0x49, 0x08, 0x82, 0x47, 0xb3, 0x83, 0x59, 0x0f, 0xdf, 0x5a,
+		0x8f, 0x23, 0xb1, 0x1e, 0x78, 0x8b, 0x20, 0x55, 0xcc, 0xf8, 0x10, 0x63, 0x0e, 0xae, 0xf6, 0xa5,
+		0x18, 0x21, 0x52, 0x31, 0x42, 0xa4, 0x62, 0xf4, 0xb8, 0x50, 0x23, 0x1a, 0xc3, 0xdc, 0x08, 0x5a,
+		0xbf, 0xca, 0x6a, 0x46, 0x91, 0x9c, 0xad, 0xaf, 0x54, 0xee, 0x19, 0x78, 0xa7, 0xe3, 0x16, 0x58,
+		0x67, 0x09, 0xad, 0x53, 0x47, 0xe0, 0x2c, 0x51, 0xa4, 0x4d, 0xa0, 0x48, 0x9b, 0xa0, 0x3b, 0x41,
+		0xc4, 0xa4, 0xb2, 0xd6, 0x00, 0xc0, 0x5a, 0x83, 0x7c, 0x39, 0x33, 0xae, 0xbc, 0x4b, 0x84, 0x25,
+		0xf0, 0x4f, 0x97, 0xd3, 0xeb, 0xf3, 0x97, 0xfb, 0x04, 0x23, 0x60, 0xbb, 0x15, 0x8f, 0xd2, 0xb0,
+		0x8f, 0x4c, 0xaa, 0x9e, 0x52, 0xb1, 0x99, 0x96, 0x7d, 0x62, 0xfc, 0x26, 0xa2, 0x5a, 0xff, 0x0d,
+		0x45, 0xa5, 0x97, 0x73, 0xa5, 0x87, 0x77, 0x75, 0x7e, 0x7e, 0xf9, 0xea, 0xfc, 0xdc, 0x7d, 0xd5,
+		0x7d, 0xe5, 0xbe, 0xbe, 0xb8, 0xf0, 0x2e, 0x3d, 0x93, 0xc3, 0xd7, 0xdf, 0xe2, 0x90, 0xc6, 0x34,
+		0x7c, 0x33, 0x73, 0xae, 0x81, 0x27, 0x51, 0xb4, 0x2f, 0x2b, 0x36, 0x40, 0x5a, 0xb1, 0x01, 0xd2,
+		0x8a, 0xf5, 0x19, 0x27, 0xf1, 0xcc, 0xee, 0x16, 0x03, 0x58, 0x3b, 0x56, 0x22, 0xe7, 0x0c, 0x2a,
+		0x08, 0x43, 0xf6, 0xda, 0xa0, 0xe9, 0x47, 0xca, 0x87, 0x6a, 0x74, 0x74, 0x96, 0xcc, 0x77, 0xed,
+		0x99, 0xf2, 0x29, 0xcb, 0xe4, 0xd8, 0x83, 0x93, 0x21, 0x92, 0xd6, 0x87, 0x48, 0x5a, 0x7f, 0x23,
+		0x44, 0x44, 0x09, 0xb7, 0xbc, 0x6e, 0x79, 0xbd, 0x9c, 0xd7, 0x33, 0xac, 0x60, 0xf6, 0xaa, 0xbc,
+		0x7d, 0xe9, 0xc5, 0x08, 0xa9, 0x17, 0x23, 0xa4, 0x5e, 0xfc, 0x9d, 0x33, 0x61, 0xb5, 0xc2, 0x6a,
+		0x45, 0xb9, 0x9c, 0x13, 0x8d, 0x14, 0x8c, 0x4e, 0x98, 0x78, 0x3b, 0xf3, 0x61, 0xec, 0x2d, 0x59,
+		0x28, 0x31, 0xdd, 0x6b, 0xd8, 0x1c, 0xfd, 0x25, 0xa2, 0x0b, 0x6e, 0xef, 0x01, 0x3f, 0x9b, 0x5a,
+		0x1e, 0xdc, 0x96, 0xd7, 0x82, 0xdc, 0x67, 0x6e, 0xcc, 0x79, 0xa9, 0xef, 0xc4, 0x18, 0x62, 0xbb,
+		0x31, 0x47, 0x6f, 0x5b, 0x74, 0xae, 0x7b, 0xfa, 0xc2, 0x6b, 0xed, 0xa7, 0xf5, 0xdd, 0x23, 0x65,
+		0xca, 0x2e, 0x34, 0x9d, 0x72, 0x5d, 0xe9, 0x40, 0x94, 0x19, 0x49, 0x6d, 0x91, 0xd5, 0x39, 0xa2,
+		0xcf, 0x0d, 0x4f, 0xc6, 0xf8, 0xa8, 0xe1, 0x56, 0x7c, 0xc9, 0xce, 0x87, 0xaf, 0xab, 0xa8, 0xbe,
+		0xab, 0xe7, 0x48, 0x12, 0x25, 0x9c, 0x0a, 0xe0, 0xf7, 0x74, 0x67, 0x31, 0x18, 0x38, 0xad, 0x3d,
+		0xaa, 0x99, 0x73, 0x2b, 0x3e, 0x70, 0x55, 0x6d, 0x76, 0xe9, 0xc4, 0x2a, 0x29, 0x45, 0x3a, 0xad,
+		0x6b, 0xf0, 0xf6, 0x84, 0xea, 0x87, 0x47, 0x46, 0xb5, 0x71, 0x4a, 0xc1, 0x16, 0xa0, 0xaf, 0x10,
+		0x7d, 0x90, 0x7b, 0x0e, 0xf8, 0xf9, 0x00, 0x58, 0x0b, 0x56, 0x43, 0xb5, 0xa0, 0x41, 0x0b, 0x66,
+		0x0d, 0x58, 0x3d, 0x03, 0xf6, 0x78, 0x5b, 0x24, 0x0c, 0x19, 0x0a, 0xb2, 0xea, 0xa1, 0xa0, 0x3d,
+		0xc5, 0x05, 0xb0, 0xf1, 0xe0, 0x13, 0x8c, 0x07, 0x19, 0x57, 0x5d, 0xbf, 0x82, 0x39, 0xed, 0x3e,
+		0xd9, 0x70, 0xd0, 0xf7, 0xce, 0x5f, 0x9d, 0x5f, 0x75, 0x2f, 0xcf, 0xaf, 0x8e, 0xc8, 0x32, 0xa0,
+		0x4e, 0xae, 0x0d, 0x21, 0xbe, 0x3f, 0xa3, 0xba, 0x14, 0xe1, 0x2b, 0x6b, 0x5c, 0x2b, 0x19, 0x45,
+		0xe4, 0x0a, 0x56, 0xd0, 0x7b, 0xf3, 0x2d, 0xdd, 0x2d, 0xda, 0xf2, 0x4e, 0x37, 0x04, 0xb0, 0xd9,
+		0x0b, 0x87, 0xce, 0x5e, 0x40, 0xdd, 0x10, 0x81, 0x28, 0xb1, 0x74, 0xc6, 0x89, 0x2c, 0xbf, 0x84,
+		0x01, 0x9d, 0xab, 0xfe, 0x53, 0x76, 0x96, 0xf6, 0x12, 0xfe, 0x1f, 0x5e, 0x68, 0xce, 0x7d, 0x01,
+		0x22, 0x06, 0x9e, 0x8c, 0xfb, 0x34, 0xfe, 0xe9, 0xe7, 0xb3, 0xf4, 0x47, 0xf2, 0x12, 0xfe, 0x95,
+		0xb8, 0x6e, 0x37, 0x00, 0xa3, 0xe4, 0xdd, 0x9b, 0x38, 0x16, 0xf1, 0x27, 0x2a, 0x25, 0x19, 0x22,
+		0xbc, 0xab, 0xc5, 0xa8, 0x3e, 0x0c, 0xe0, 0xa3, 0xf6, 0x3f, 0x7d, 0x02, 0x4c, 0x02, 0xe9, 0x8b,
+		0x29, 0x85, 0x73, 0x50, 0x23, 0x9a, 0xf9, 0xa5, 0xfe, 0x10, 0xb4, 0x1c, 0xa0, 0x4f, 0x53, 0x0b,
+		0x01, 0x03, 0x11, 0xeb, 0x1f, 0x61, 0x4a, 0x22, 0x16, 0xa6, 0x5b, 0x3f, 0xa0, 0x04, 0x4c, 0x88,
+		0x94, 0xa6, 0xde, 0x59, 0x85, 0xc2, 0xd0, 0x55, 0x57, 0x90, 0xea, 0xd9, 0x76, 0xc6, 0xf3, 0xe9,
+		0x22, 0x54, 0xb4, 0x4e, 0x51, 0xe8, 0x9a, 0x67, 0xd8, 0x9c, 0xc4, 0x1a, 0x22, 0x8b, 0x87, 0x8a,
+		0xaa, 0x73, 0x87, 0x52, 0x9d, 0x1e, 0xe7, 0x42, 0x91, 0x79, 0xe4, 0x52, 0xa0, 0x36, 0x32, 0x18,
+		0xd1, 0x31, 0x99, 0x90, 0x74, 0x77, 0xc3, 0x39, 0x13, 0x7c, 0xd0, 0x51, 0x54, 0x2a, 0xef, 0x2c,
+		0xbb, 0x0a, 0xe9, 0xac, 0xf0, 0xaa, 0x9d, 0xec, 0x09, 0x2a, 0x4e, 0x02, 0xc5, 0xe7, 0x00, 0xfd,
+		0x8d, 0x0f, 0x6e, 0x75, 0xff, 0x7f, 0xeb, 0xa8, 0xc8, 0xeb, 0xa5, 0xff, 0xf1, 0x7b, 0x4e, 0xcb,
+		0x6c, 0xae, 0x3b, 0x66, 0x93, 0x5d, 0xf6, 0x13, 0x1a, 0x5e, 0x0a, 0x14, 0x1a, 0x5e, 0x0a, 0xb4,
+		0x12, 0xb3, 0x85, 0xc7, 0x71, 0x13, 0x10, 0x39, 0xc5, 0xab, 0x80, 0xc8, 0x61, 0xef, 0x02, 0x0a,
+		0xbb, 0xd8, 0x7a, 0x36, 0xdd, 0x03, 0x17, 0xe4, 0x67, 0xbc, 0x9f, 0x72, 0x03, 0x08, 0xbe, 0x1a,
+		0xdc, 0x87, 0x40, 0x92, 0xa1, 0x9e, 0x3b, 0x0d, 0x41, 0x09, 0xd8, 0x79, 0x57, 0x58, 0x1e, 0x88,
+		0x1e, 0x39, 0xe0, 0x27, 0x4f, 0x31, 0xe2, 0x27, 0xb6, 0xc0, 0x2d, 0x87, 0x91, 0x39, 0x09, 0xbe,
+		0x99, 0x2b, 0x4a, 0xd6, 0x1c, 0xad, 0x25, 0x84, 0x0d, 0x47, 0x0a, 0x06, 0xb1, 0x18, 0xc3, 0xe7,
+		0xf7, 0x6f, 0x3b, 0x97, 0xae, 0xef, 0x1a, 0x2a, 0xc3, 0x85, 0x55, 0x86, 0xd3, 0x53, 0x86, 0x32,
+		0x86, 0xfe, 0xc1, 0xd4, 0x44, 0xd1, 0x0e, 0xd7, 0xe0, 0xc0, 0xbb, 0xb7, 0x2b, 0x7d, 0x0d, 0xa5,
+		0x30, 0x87, 0x94, 0xe1, 0xe9, 0xa4, 0x31, 0xb4, 0xaa, 0x40, 0xac, 0x22, 0xd4, 0xaa, 0x42, 0xae,
+		0x36, 0xf4, 0x6a, 0x43, 0xb0, 0x3a, 0x14, 0xcd, 0x20, 0x69, 0x08, 0x4d, 0x34, 0x44, 0x17, 0x1f,
+		0x27, 0x18, 0x89, 0x40, 0x68, 0xcc, 0xe1, 0x85, 0xbe, 0xf4, 0x3a, 0x97, 0x8f, 0x40, 0xca, 0x6c,
+		0x83, 0x50, 0x17, 0xa7, 0xf3, 0x34, 0x5c, 0xe4, 0x9a, 0x41, 0x40, 0x24, 0x85, 0x1f, 0x1a, 0xd1,
+		0x80, 0x37, 0x92, 0xa7, 0x3d, 0xd8, 0x2c, 0x5b, 0xac, 0x16, 0xd5, 0xd1, 0xa6, 0x9a, 0x5a, 0x55,
+		0x57, 0xbb, 0x1a, 0xd3, 0xb2, 0xc6, 0xb4, 0xad, 0xbe, 0xd6, 0xe1, 0xb4, 0x0f, 0xa9, 0x85, 0x78,
+		0xef, 0x29, 0x77, 0xa5, 0xab, 0x65, 0xac, 0xd4, 0xc9, 0x5c, 0xa9, 0x97, 0xc1, 0xd2, 0x4c, 0x26,
+		0xcb, 0x7a, 0x46, 0x4b, 0x48, 0xe2, 0x6f, 0x4e, 0xbb, 0xfa, 0x43, 0xd2, 0xcc, 0x96, 0x31, 0x8b,
+		0x6a, 0x3d, 0xc4, 0xd7, 0x0f, 0x19, 0xb0, 0x58, 0xaa, 0x0e, 0x99, 0x12, 0x16, 0xa5, 0xbb, 0x71,
+		0x95, 0x1e, 0xf7, 0xd0, 0xae, 0x2a, 0xd1, 0xaa, 0xa9, 0x33, 0x8b, 0x4f, 0x26, 0xc9, 0xca, 0x27,
+		0x07, 0x00, 0xb0, 0x2d, 0x02, 0xa3, 0xca, 0xcb, 0xbc, 0x4f, 0xb6, 0x2a, 0xd8, 0xcc, 0x9c, 0x6a,
+		0xba, 0x0b, 0x7b, 0x3c, 0xb9, 0x68, 0xd6, 0x86, 0x1b, 0xee, 0x5b, 0x55, 0xdb, 0xc7, 0x0a, 0xcf,
+		0xd2, 0x58, 0xe7, 0x6c, 0xc5, 0xcd, 0x6c, 0x6a, 0x53, 0xaf, 0x7c, 0x7a, 0x8e, 0x9c, 0x88, 0x58,
+		0xc9, 0x0e, 0x89, 0x29, 0x27, 0x78, 0xd7, 0x78, 0xad, 0xb7, 0x75, 0x8e, 0x0f, 0x65, 0xb6, 0x9f,
+		0xaf, 0x73, 0xdc, 0xa7, 0x34, 0xae, 0xee, 0x17, 0xa7, 0xbd, 0xeb, 0xb9, 0xc4, 0xfa, 0xd2, 0x76,
+		0x3a, 0x9e, 0xa8, 0xd9, 0xba, 0x47, 0xbc, 0xaa, 0x08, 0xd6, 0x27, 0xb6, 0x3e, 0x71, 0x33, 0xca,
+		0x86, 0x53, 0x3a, 0xa4, 0xf2, 0x35, 0xe9, 0x13, 0x6b, 0x85, 0xa8, 0xe3, 0x0d, 0x77, 0xf7, 0xe5,
+		0x06, 0x20, 0xc8, 0x7c, 0x12, 0x53, 0xf5, 0x5f, 0x1a, 0x55, 0x67, 0x97, 0xc5, 0x03, 0x6a, 0xc6,
+		0xdc, 0x96, 0x5c, 0x2c, 0xb9, 0x58, 0x72, 0x39, 0x05, 0x72, 0x39, 0xbd, 0x18, 0x63, 0xcd, 0x5f,
+		0x3f, 0x4c, 0xea, 0x40, 0xbb, 0xd5, 0xe4, 0xb4, 0x51, 0xd3, 0x75, 0x0e, 0x92, 0x0f, 0xd4, 0x68,
+		0x52, 0x43, 0x58, 0x33, 0xa9, 0xe1, 0x5d, 0x9d, 0xa4, 0x06, 0x6d, 0x74, 0x3c, 0x83, 0x37, 0x1d,
+		0xcd, 0xdb, 0x99, 0x25, 0x35, 0x7c, 0xdc, 0x59, 0xa4, 0x9c, 0xdf, 0xbd, 0xd8, 0x18, 0xd9, 0x57,
+		0x1d, 0x35, 0xf7, 0xaa, 0xa3, 0x52, 0x6a, 0x36, 0x3f, 0x41, 0x36, 0xa9, 0x5f, 0x32, 0xad, 0x57,
+		0x32, 0x4b, 0x37, 0x34, 0xdf, 0xab, 0x58, 0xe4, 0xfb, 0x5e, 0xb4, 0x5b, 0x7b, 0x4d, 0xeb, 0xc5,
+		0xa7, 0xf1, 0x3e, 0x98, 0xe5, 0x49, 0xe2, 0xa7, 0xea, 0xb9, 0xc7, 0x37, 0xd7, 0x66, 0x13, 0xcc,
+		0xcc, 0xf8, 0x8c, 0x49, 0xa3, 0x37, 0xb7, 0xcd, 0xdb, 0x99, 0xf1, 0x59, 0x0f, 0x24, 0x1b, 0x4f,
+		0x22, 0x9a, 0x55, 0xdb, 0x88, 0x81, 0xf6, 0xb9, 0x07, 0x6c, 0x98, 0x64, 0x47, 0x02, 0xc0, 0x14,
+		0x1d, 0x4b, 0xfb, 0x1a, 0xb7, 0xa3, 0x7f, 0x8d, 0xdb, 0xdc, 0x8a, 0x1a, 0x66, 0xa3, 0xa4, 0xad,
+		0x71, 0xc9, 0x28, 0xb7, 0xa3, 0x39, 0x44, 0x98, 0x84, 0x6f, 0x74, 0x46, 0x43, 0xe8, 0xcf, 0xc0,
+		0xe4, 0x39, 0xb6, 0x1a, 0xab, 0x36, 0xa8, 0xd0, 0xe0, 0x32, 0x64, 0xa9, 0x63, 0x48, 0xcd, 0x3a,
+		0xdd, 0xcb, 0xc8, 0xce, 0xed, 0x5d, 0x64, 0x9b, 0x22, 0xb9, 0xb2, 0x57, 0x91, 0x19, 0xf6, 0x2f,
+		0x58, 0x12, 0x27, 0xbe, 0xef, 0x4c, 0xc4, 0x77, 0x83, 0x83, 0x81, 0xa5, 0xde, 0x2d, 0x7b, 0x60,
+		0x5f, 0x2c, 0x11, 0x50, 0x36, 0xa5, 0x60, 0xd4, 0xd7, 0xf2, 0xf8, 0xf3, 0xe1, 0xf1, 0x04, 0x7b,
+		0x39, 0xf2, 0xe5, 0xc9, 0x5e, 0x8e, 0x6c, 0xaf, 0x94, 0xdc, 0x96, 0x49, 0xd7, 0x5e, 0x29, 0xd9,
+		0x04, 0x8f, 0x2b, 0x34, 0x8f, 0xab, 0x6a, 0x3c, 0x7e, 0x1b, 0x13, 0x2e, 0xc7, 0x4c, 0x59, 0x22,
+		0xb7, 0x44, 0xfe, 0x6c, 0x89, 0xdc, 0xbe, 0x6e, 0xf6, 0xa4, 0x6d, 0xdb, 0x63, 0xf3, 0x38, 0x6a,
+		0x3b, 0xe6, 0x17, 0x3a, 0x2b, 0xd9, 0x46, 0x31, 0x2b, 0x38, 0x37, 0x2f, 0x34, 0xdf, 0x28, 0x30,
+		0x2f, 0x88, 0x3d, 0xcd, 0xaa, 0xc8, 0x8f, 0xb1, 0x1a, 0x7c, 0x59, 0xf2, 0xbd, 0x30, 0x83, 0xcb,
+		0xa2, 0xef, 0xe5, 0x2f, 0x8b, 0x40, 0xe7, 0xa5, 0x09, 0xa5, 0xad, 0x5b, 0x49, 0x64, 0x2a, 0xe0,
+		0x62, 0x0c, 0xcb, 0xca, 0xe5, 0x88, 0x4a, 0x09, 0x6a, 0x44, 0x38, 0x2c, 0x06, 0x01, 0x9d, 0xb4,
+		0x8a, 0x39, 0x7d, 0x33, 0xdf, 0x54, 0xab, 0x9c, 0xfe, 0xe7, 0x0c, 0x02, 0xc2, 0x41, 0x8e, 0x48,
+		0x4c, 0x81, 0x49, 0xf0, 0xdd, 0x03, 0x15, 0x81, 0x87, 0x2b, 0x93, 0x7d, 0x8c, 0x12, 0xf0, 0xe6,
+		0xe4, 0x75, 0xc0, 0x6c, 0xd1, 0x7a, 0xf7, 0x04, 0x2c, 0xa7, 0xcc, 0x24, 0x0c, 0x63, 0x4a, 0x14,
+		0x8d, 0xb3, 0x09, 0x8b, 0x18, 0xe8, 0x7f, 0x12, 0x12, 0x81, 0x12, 0x60, 0x18, 0x9a, 0x3f, 0x9d,
+		0xbb, 0x00, 0x70, 0x52, 0x79, 0x96, 0xf5, 0xfe, 0x85, 0x07, 0x34, 0x50, 0x7e, 0x34, 0xae, 0x6d,
+		0x0b, 0xa2, 0xde, 0xbf, 0x55, 0x30, 0x37, 0xa7, 0xb7, 0xc8, 0xb7, 0xda, 0xc9, 0xe4, 0x25, 0x27,
+		0x4d, 0x67, 0xca, 0xbb, 0x2e, 0xcc, 0xd1, 0xda, 0x8c, 0x54, 0x6e, 0xee, 0x15, 0xe5, 0x21, 0xe3,
+		0x43, 0xcc, 0xd9, 0xb9, 0xbd, 0x1c, 0xa0, 0x52, 0x82, 0x54, 0xc3, 0x27, 0x4c, 0x25, 0x57, 0x44,
+		0x6c, 0x09, 0xb4, 0x34, 0xff, 0x03, 0xaa, 0x5d, 0x19, 0x61, 0x88, 0x0e, 0x63, 0x94, 0x60, 0xd0,
+		0x82, 0x44, 0x4d, 0x55, 0x52, 0x7f, 0x02, 0x85, 0xce, 0x58, 0x54, 0x96, 0xb0, 0xd6, 0x5d, 0xbb,
+		0x85, 0x75, 0x53, 0xb5, 0xe4, 0x24, 0xe5, 0x41, 0x7e, 0xa4, 0x6a, 0xc0, 0x0a, 0xb7, 0x62, 0x02,
+		0x11, 0x9d, 0xd2, 0x08, 0x98, 0x84, 0xec, 0x81, 0xaa, 0x79, 0x9a, 0xc8, 0x86, 0xb9, 0x4f, 0xa2,
+		0xd8, 0x39, 0x8f, 0xda, 0x6b, 0x74, 0x57, 0x6c, 0x59, 0x8a, 0xad, 0x65, 0x99, 0x95, 0xdc, 0x21,
+		0x8f, 0x62, 0xb3, 0xe8, 0xb4, 0x76, 0x8f, 0x76, 0x65, 0x5c, 0x29, 0x29, 0x79, 0xfd, 0x8e, 0x54,
+		0xbb, 0xea, 0x91, 0xd7, 0xa8, 0x6b, 0xd9, 0xaa, 0xdd, 0x2a, 0x22, 0xac, 0x1e, 0x48, 0x1a, 0x08,
+		0x1e, 0x82, 0x5a, 0x4a, 0x38, 0x58, 0x72, 0x98, 0xf6, 0x77, 0x99, 0x04, 0xc1, 0xb3, 0x2b, 0x8f,
+		0xd2, 0xe7, 0x01, 0x51, 0x2a, 0x66, 0xfd, 0x44, 0x51, 0xf9, 0x33, 0xdc, 0x84, 0x4c, 0x81, 0x9c,
+		0x8d, 0xfb, 0x22, 0x02, 0x39, 0x12, 0x49, 0x14, 0x02, 0x17, 0xa9, 0xf7, 0x3c, 0x65, 0x92, 0xf5,
+		0xa3, 0xad, 0xbf, 0xbe, 0x9b, 0xf3, 0x72, 0x5f, 0xdc, 0x5d, 0xc4, 0x69, 0xc5, 0x7b, 0x71, 0x65,
+		0x48, 0x36, 0xa6, 0x28, 0x63, 0xdc, 0x96, 0xee, 0xa5, 0x15, 0xfb, 0x31, 0x79, 0xf6, 0x2b, 0xb3,
+		0x43, 0x81, 0xe1, 0xd5, 0x46, 0x81, 0xa1, 0x1f, 0x73, 0xab, 0xd7, 0x95, 0x49, 0x20, 0xc0, 0xe9,
+		0xf7, 0x95, 0x15, 0x27, 0x61, 0x48, 0x43, 0x60, 0x3c, 0x0d, 0x74, 0x7c, 0xd7, 0x7b, 0x0d, 0x53,
+		0x1a, 0x4b, 0x26, 0xf8, 0xcf, 0x00, 0xff, 0xa0, 0x10, 0x0a, 0xfe, 0x42, 0xc1, 0x88, 0x4c, 0x29,
+		0x28, 0x01, 0x92, 0xcc, 0x80, 0xa9, 0x17, 0x12, 0x5e, 0x64, 0x39, 0x37, 0xd9, 0x96, 0xc9, 0x0b,
+		0xe8, 0x80, 0xce, 0xc8, 0x61, 0x59, 0x8a, 0xc5, 0x84, 0xc4, 0xf9, 0x9c, 0x63, 0x33, 0x70, 0x2a,
+		0xe0, 0xa8, 0x84, 0xe5, 0xea, 0x5c, 0x9e, 0xd4, 0x25, 0xb8, 0xab, 0x93, 0xba, 0xa4, 0xe2, 0x1b,
+		0xcf, 0xe7, 0x37, 0x49, 0xc2, 0x94, 0xc4, 0x8c, 0x6c, 0xf3, 0x44, 0x1e, 0x4e, 0xec, 0x9e, 0x7f,
+		0x75, 0x5c, 0xa1, 0xf1, 0x55, 0x8c, 0xb3, 0x12, 0xbc, 0xd5, 0xd8, 0xf3, 0x3f, 0xa2, 0x17, 0x47,
+		0x75, 0xfb, 0x48, 0x7d, 0xe8, 0x57, 0xd4, 0x87, 0x2c, 0xf1, 0xc8, 0xaa, 0x83, 0x55, 0x87, 0x2d,
+		0x39, 0x3f, 0xab, 0x9c, 0xb4, 0x0b, 0x7b, 0x08, 0x56, 0x35, 0x79, 0xfc, 0x18, 0x64, 0x72, 0x52,
+		0x87, 0x60, 0xcd, 0xec, 0x86, 0xce, 0xc3, 0xac, 0xb3, 0x42, 0xf7, 0x1b, 0xca, 0x82, 0xbf, 0x37,
+		0x5f, 0xf4, 0x43, 0xd2, 0xff, 0xf7, 0xdf, 0xd6, 0xad, 0x19, 0x32, 0xb9, 0x08, 0x75, 0xde, 0xce,
+		0x38, 0xc7, 0x7e, 0x3d, 0xf4, 0xab, 0x5b, 0x2c, 0xe4, 0x5b, 0x77, 0xbe, 0x26, 0x78, 0xcd, 0x8b,
+		0x85, 0x4a, 0x53, 0x28, 0x0c, 0x52, 0x27, 0x0c, 0x53, 0x26, 0xf6, 0x55, 0x2a, 0xe4, 0xb9, 0xae,
+		0xeb, 0x3e, 0x9f, 0x72, 0x21, 0xff, 0x38, 0xa7, 0xfb, 0x58, 0x15, 0x43, 0x7d, 0xc3, 0x8a, 0xa1,
+		0x7e, 0xd5, 0x8a, 0xa1, 0x8c, 0xdc, 0xd2, 0x4a, 0xa1, 0x36, 0x7c, 0x67, 0x6a, 0x04, 0x04, 0x42,
+		0x91, 0xf4, 0x23, 0xaa, 0x2b, 0x45, 0xea, 0x6e, 0x5d, 0x58, 0xae, 0xdb, 0xfb, 0xd6, 0x05, 0xe3,
+		0x21, 0xbd, 0xf7, 0xcc, 0x43, 0xb5, 0x79, 0xfb, 0xaa, 0x05, 0x44, 0xba, 0xb7, 0x8d, 0xd0, 0x6c,
+		0x84, 0xb6, 0x6a, 0x61, 0xaf, 0x10, 0x01, 0xda, 0xc5, 0xc9, 0xe6, 0x28, 0xda, 0x5c, 0xf3, 0x2d,
+		0x91, 0xf8, 0x17, 0x17, 0x36, 0x3e, 0x33, 0xec, 0x5f, 0xb0, 0x28, 0x19, 0x27, 0xfb, 0x48, 0x0e,
+		0xf7, 0x2d, 0x87, 0x5b, 0x0e, 0xb7, 0x1c, 0x6e, 0x39, 0xdc, 0x72, 0xf8, 0x31, 0x70, 0x78, 0x7a,
+		0x04, 0x82, 0x7c, 0xfb, 0x0a, 0xfa, 0xdd, 0x2b, 0xbd, 0xc5, 0x61, 0xc9, 0x72, 0x37, 0x6a, 0x71,
+		0x5e, 0xad, 0x99, 0xbd, 0x69, 0x4e, 0xf7, 0x2d, 0xa7, 0x9f, 0x2c, 0xa7, 0x3f, 0xab, 0x93, 0x13,
+		0x5b, 0x3e, 0xb4, 0x4d, 0xeb, 0xf6, 0xe4, 0xa4, 0x31, 0x56, 0x0f, 0x91, 0xac, 0x1e, 0xa2, 0x0f,
+		0xc2, 0x81, 0x85, 0x94, 0x2b, 0xa6, 0x66, 0x31, 0x1d, 0x1c, 0x86, 0xdb, 0xad, 0xbf, 0x7e, 0xba,
+		0xdc, 0xbe, 0x02, 0x16, 0x0c, 0xc1, 0x9b, 0xb8, 0xed, 0x1f, 0xe6, 0x8f, 0x7e, 0x43, 0x64, 0x85,
+		0xa2, 0x9a, 0x4f, 0x7f, 0xbe, 0xe9, 0x7d, 0xb9, 0x31, 0x5d, 0x9f, 0x94, 0xaa, 0xa4, 0xb1, 0x31,
+		0x01, 0x94, 0x41, 0x59, 0x1b, 0xd7, 0x87, 0x77, 0xb7, 0x7f, 0xfe, 0x7e, 0xe3, 0x39, 0xfb, 0xa0,
+		0xe9, 0x5a, 0x43, 0xf2, 0x9b, 0xbe, 0xec, 0xfc, 0xee, 0xd0, 0xb9, 0xdf, 0xc5, 0x45, 0x96, 0xd9,
+		0x66, 0x33, 0x14, 0xee, 0x57, 0xec, 0xb9, 0xda, 0xf2, 0x6a, 0x5f, 0xd5, 0x96, 0xcd, 0x1e, 0xa1,
+		0x17, 0x9e, 0xe2, 0x80, 0xe1, 0x11, 0x7a, 0x5a, 0x5b, 0xf4, 0xa6, 0xa1, 0xda, 0xa2, 0x5a, 0x19,
+		0xe0, 0xbb, 0x13, 0xb0, 0x0d, 0xe7, 0x61, 0x92, 0x0c, 0xae, 0x0d, 0x6d, 0x4f, 0xdd, 0x8a, 0xc9,
+		0x47, 0x9d, 0xb7, 0x9d, 0x9f, 0x0e, 0xbe, 0xd1, 0xae, 0x2c, 0x21, 0x5c, 0x37, 0x07, 0xa2, 0x52,
+		0xb3, 0xfb, 0x23, 0x2b, 0xfc, 0x27, 0x2e, 0x14, 0xc4, 0x34, 0x10, 0xe3, 0x31, 0xe5, 0x3a, 0x49,
+		0xb8, 0x9f, 0xa8, 0x65, 0xad, 0xa4, 0x4c, 0x26, 0xfa, 0x26, 0x5b, 0x1a, 0xbe, 0xcc, 0x49, 0xf8,
+		0x76, 0xf3, 0x12, 0xbe, 0xdd, 0x67, 0x9b, 0xf0, 0x9d, 0x6b, 0xf3, 0xca, 0xe3, 0x97, 0xa2, 0x78,
+		0xc5, 0xf9, 0x9d, 0x28, 0x45, 0x63, 0x9e, 0x6b, 0x53, 0x9c, 0xaf, 0xbd, 0xce, 0x3f, 0xef, 0xfe,
+		0xea, 0x3e, 0x74, 0xbe, 0xba, 0x9d, 0xd7, 0x77, 0xff, 0xe7, 0x94, 0x95, 0x45, 0xb4, 0xd6, 0xff,
+		0x6f, 0x3e, 0x8f, 0x3c, 0xe5, 0x70, 0x98, 0x7c, 0x4f, 0xbe, 0xd1, 0xcf, 0x42, 0x6c, 0x4b, 0x6f,
+		0x53, 0x61, 0x9c, 0x76, 0x2b, 0x47, 0x25, 0xde, 0xd1, 0x29, 0x0b, 0xe6, 0x4a, 0xf0, 0xd0, 0x7a,
+		0xf8, 0x1f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xc2, 0xe2, 0x66, 0x5c, 0xe1, 0xc1, 0x00,
 		0x00,
 	}
 )
@@ -747,6 +907,9 @@ var (
 // of the map ensures that there are no clashes with valid YANG identifiers.
 func initΛEnumTypes(){
   ΛEnumTypes = map[string][]reflect.Type{
+	"/cont1a/cont2a/leaf2h": []reflect.Type{
+		reflect.TypeOf((E_OnfTest1_Cont1A_Cont2A_Leaf2H)(0)),
+	},
 	"/cont1a/cont2d/snack/late-night/chocolate": []reflect.Type{
 		reflect.TypeOf((E_OnfTest1_Cont1A_Cont2D_Chocolate)(0)),
 	},
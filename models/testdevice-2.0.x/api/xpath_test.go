@@ -828,3 +828,64 @@ func Test_XPathNodeNavigation(t *testing.T) {
 	assert.False(t, ynn.MoveToNext()) // No further leaves
 
 }
+
+// Test_XPathChoice checks that only the leaves of the case of the choice
+// snack that is set, or else of its default case, are in the tree
+func Test_XPathChoice(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		defaultCase string
+		expected    []string
+	}{
+		{
+			name:     "sports-arena",
+			config:   `{"onf-test1:cont1a": {"onf-test1-augmented:cont2d": {"leaf2d3c": "a", "pretzel": [null]}}}`,
+			expected: []string{"leaf2d3c: a", "pretzel: true"},
+		},
+		{
+			name:        "sports-arena over the default case",
+			config:      `{"onf-test1:cont1a": {"onf-test1-augmented:cont2d": {"leaf2d3c": "a", "pretzel": [null]}}}`,
+			defaultCase: "late-night",
+			expected:    []string{"leaf2d3c: a", "pretzel: true"},
+		},
+		{
+			name:     "late-night",
+			config:   `{"onf-test1:cont1a": {"onf-test1-augmented:cont2d": {"leaf2d3c": "a", "chocolate": "dark"}}}`,
+			expected: []string{"chocolate: dark", "leaf2d3c: a"},
+		},
+		{
+			name:     "no case",
+			config:   `{"onf-test1:cont1a": {"onf-test1-augmented:cont2d": {"leaf2d3c": "a"}}}`,
+			expected: []string{"leaf2d3c: a"},
+		},
+		{
+			name:        "default case",
+			config:      `{"onf-test1:cont1a": {"onf-test1-augmented:cont2d": {"leaf2d3c": "a"}}}`,
+			defaultCase: "late-night",
+			expected:    []string{"chocolate: milk", "leaf2d3c: a"},
+		},
+	}
+
+	for _, test := range tests {
+		device := new(Device)
+		schema, err := Schema()
+		assert.NoError(t, err, test.name)
+		assert.NoError(t, schema.Unmarshal([]byte(test.config), device), test.name)
+		snack := schema.RootSchema().Dir["cont1a"].Dir["cont2d"].Dir["snack"]
+		snack.Dir["late-night"].Dir["chocolate"].Default = []string{"milk"}
+		if test.defaultCase != "" {
+			snack.Default = []string{test.defaultCase}
+		}
+		ynn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
+
+		expr, err := xpath.Compile("/cont1a/cont2d/*")
+		assert.NoError(t, err, test.name)
+		iter := expr.Select(ynn)
+		results := make([]string, 0)
+		for iter.MoveNext() {
+			results = append(results, fmt.Sprintf("%s: %s", iter.Current().LocalName(), iter.Current().Value()))
+		}
+		assert.Equal(t, test.expected, results, test.name)
+	}
+}
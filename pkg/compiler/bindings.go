@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/genutil"
+	"github.com/openconfig/ygot/gogen"
+	"github.com/openconfig/ygot/ygen"
+	"github.com/openconfig/ygot/ygot"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	bindingsPackage = "api"
+	bindingsCaller  = "model-compiler"
+	defaultFakeRoot = "Device"
+)
+
+// BindingsError is returned when the YGOT Go bindings cannot be generated
+// from the YANG files of a model
+type BindingsError struct {
+	Errs []error
+}
+
+func (e *BindingsError) Error() string {
+	msgs := make([]string, 0, len(e.Errs))
+	for _, err := range e.Errs {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("unable to generate Go bindings: %s", strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the first of the generator errors
+func (e *BindingsError) Unwrap() error {
+	if len(e.Errs) == 0 {
+		return nil
+	}
+	return e.Errs[0]
+}
+
+// renderGolangBindings generates the YGOT Go bindings of the model at path.
+// The schema tree of the bindings is kept for the generation of the OpenAPI spec.
+func (c *ModelCompiler) renderGolangBindings(path string) ([]byte, error) {
+	yangDir := filepath.Join(path, "yang")
+
+	// Search all directories of the YANG files directory for imported modules
+	includePaths := make([]string, 0)
+	err := filepath.Walk(yangDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			includePaths = append(includePaths, filepath.Join(p, "..."))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	yangFiles := make([]string, 0, len(c.metaData.Modules))
+	for _, module := range c.metaData.Modules {
+		yangFiles = append(yangFiles, filepath.Join(yangDir, module.YangFile))
+	}
+
+	storeUses, err := hasConditionalUses(yangDir)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := c.metaData.GoOptions
+	compressBehaviour, err := genutil.TranslateToCompressBehaviour(opts.CompressPaths, false, false)
+	if err != nil {
+		return nil, &BindingsError{Errs: []error{err}}
+	}
+
+	cg := gogen.New(
+		bindingsCaller,
+		ygen.IROptions{
+			ParseOptions: ygen.ParseOpts{
+				YANGParseOptions: yang.Options{StoreUses: storeUses},
+			},
+			TransformationOptions: ygen.TransformationOpts{
+				CompressBehaviour:                    compressBehaviour,
+				GenerateFakeRoot:                     true,
+				FakeRootName:                         opts.FakeRootName,
+				SkipEnumDeduplication:                opts.SkipEnumDeduplication,
+				ShortenEnumLeafNames:                 opts.ShortenEnumLeafNames,
+				UseDefiningModuleForTypedefEnumNames: opts.TypedefEnumWithDefiningModule,
+				EnumerationsUseUnderscores:           true,
+			},
+		},
+		gogen.GoOpts{
+			PackageName:             bindingsPackage,
+			GenerateJSONSchema:      true,
+			IncludeDescriptions:     opts.IncludeDescriptions,
+			YgotImportPath:          genutil.GoDefaultYgotImportPath,
+			YtypesImportPath:        genutil.GoDefaultYtypesImportPath,
+			GoyangImportPath:        genutil.GoDefaultGoyangImportPath,
+			AddAnnotationFields:     opts.Annotations,
+			AnnotationPrefix:        gogen.DefaultAnnotationPrefix,
+			ValidateFunctionName:    "Validate",
+			IgnoreShadowSchemaPaths: opts.IgnoreShadowPaths,
+		},
+	)
+
+	code, errs := cg.Generate(yangFiles, includePaths)
+	if errs != nil {
+		return nil, &BindingsError{Errs: errs}
+	}
+	if c.schemaTree, err = unmarshalSchemaTree(code.RawJSONSchema); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by YGOT. DO NOT")
+	buf.WriteString("EDIT.\n") // HACK: Defeat the license header check
+	// Refer to the YANG files relative to the model, so that the generated
+	// code does not depend on the directory the model is compiled in
+	buf.WriteString(strings.ReplaceAll(code.CommonHeader, yangDir, "yang"))
+	buf.WriteString(code.OneOffHeader)
+	for _, snippet := range code.Structs {
+		fmt.Fprintln(&buf, snippet.String())
+	}
+	for _, snippet := range code.Enums {
+		fmt.Fprintln(&buf, snippet)
+	}
+	fmt.Fprintln(&buf, code.EnumMap)
+	if len(code.JSONSchemaCode) > 0 {
+		fmt.Fprintln(&buf, code.JSONSchemaCode)
+	}
+	if len(code.EnumTypeMap) > 0 {
+		fmt.Fprintln(&buf, code.EnumTypeMap)
+	}
+	return buf.Bytes(), nil
+}
+
+// hasConditionalUses reports whether a uses statement of the YANG files in
+// yangDir has a when statement. The schema of the bindings only records the
+// uses statements, which makes it much larger, when the plugin needs them to
+// evaluate such when statements.
+func hasConditionalUses(yangDir string) (bool, error) {
+	found := false
+	err := filepath.Walk(yangDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || found || info.IsDir() || filepath.Ext(p) != ".yang" {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		statements, err := yang.Parse(string(data), p)
+		if err != nil {
+			return err
+		}
+		found = containsConditionalUses(statements)
+		return nil
+	})
+	return found, err
+}
+
+func containsConditionalUses(statements []*yang.Statement) bool {
+	for _, stmt := range statements {
+		if stmt.Keyword == "uses" {
+			for _, sub := range stmt.SubStatements() {
+				if sub.Keyword == "when" {
+					return true
+				}
+			}
+		}
+		if containsConditionalUses(stmt.SubStatements()) {
+			return true
+		}
+	}
+	return false
+}
+
+// fakeRootName is the name of the root struct of the generated bindings
+func (c *ModelCompiler) fakeRootName() string {
+	if c.metaData.GoOptions.FakeRootName != "" {
+		return c.metaData.GoOptions.FakeRootName
+	}
+	return defaultFakeRoot
+}
+
+// unmarshalSchemaTree loads the generated JSON schema as api.UnzipSchema()
+// of the compiled model would
+func unmarshalSchemaTree(rawSchema []byte) (map[string]*yang.Entry, error) {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	if _, err := gzw.Write(rawSchema); err != nil {
+		return nil, err
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, err
+	}
+	return ygot.GzipToSchema(buf.Bytes())
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testDevicePath = "../../models/testdevice-1.0.x"
+	deviceSimPath  = "../../models/devicesim-1.0.x"
+)
+
+func TestRenderGolangBindings(t *testing.T) {
+	c := NewCompiler()
+	assert.NoError(t, c.loadModelMetaData(testDevicePath))
+
+	code, err := c.renderGolangBindings(testDevicePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(code), "// Code generated by YGOT. DO NOTEDIT.\n")
+	assert.Contains(t, string(code), "package api\n")
+	assert.Contains(t, string(code), "type Device struct {")
+	assert.Contains(t, string(code), "type OnfTest1_Cont1A struct {")
+	assert.Contains(t, string(code), "\t- yang/onf-test1@2018-02-20.yang\n")
+	assert.Contains(t, string(code), "\t- yang/...\n")
+}
+
+func TestRenderGolangBindings_Options(t *testing.T) {
+	c := NewCompiler()
+	assert.NoError(t, c.loadModelMetaData(deviceSimPath))
+	c.metaData.GoOptions.CompressPaths = true
+	c.metaData.GoOptions.FakeRootName = "Root"
+
+	code, err := c.renderGolangBindings(deviceSimPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(code), "type Root struct {")
+	assert.Contains(t, string(code), "type System struct {")
+	assert.NotContains(t, string(code), "type Device struct {")
+	assert.NotContains(t, string(code), "type OpenconfigSystem_System struct {")
+}
+
+func TestRenderGolangBindings_Error(t *testing.T) {
+	c := NewCompiler()
+	assert.NoError(t, c.loadModelMetaData(testDevicePath))
+	c.metaData.Modules = append(c.metaData.Modules, Module{Name: "missing", YangFile: "missing.yang"})
+
+	_, err := c.renderGolangBindings(testDevicePath)
+	var bindingsErr *BindingsError
+	assert.True(t, errors.As(err, &bindingsErr))
+	assert.NotEmpty(t, bindingsErr.Errs)
+	assert.Contains(t, err.Error(), "missing.yang")
+}
+
+const conditionalUsesYang = `module test-when {
+  namespace "http://opennetworking.org/test-when";
+  prefix tw;
+
+  revision 2022-10-01;
+
+  grouping group1 {
+    leaf leaf1 {
+      type string;
+    }
+  }
+
+  container cont1 {
+    leaf enabled {
+      type boolean;
+    }
+    uses group1 {
+      when "./enabled = 'true'";
+    }
+  }
+}
+`
+
+func TestRenderGolangBindings_ConditionalUses(t *testing.T) {
+	hasUses, err := hasConditionalUses(filepath.Join(testDevicePath, "yang"))
+	assert.NoError(t, err)
+	assert.False(t, hasUses)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "yang"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "yang", "test-when.yang"), []byte(conditionalUsesYang), 0644))
+	hasUses, err = hasConditionalUses(filepath.Join(dir, "yang"))
+	assert.NoError(t, err)
+	assert.True(t, hasUses)
+
+	// The schema records the uses statement, so that the plugin can evaluate its when statement
+	c := NewCompiler()
+	c.metaData = &MetaData{Name: "test-when", Modules: []Module{{Name: "test-when", YangFile: "test-when.yang"}}}
+	_, err = c.renderGolangBindings(dir)
+	assert.NoError(t, err)
+	cont1, ok := c.schemaTree["TestWhen_Cont1"]
+	if assert.True(t, ok) && assert.Len(t, cont1.Uses, 1) {
+		assert.Equal(t, "./enabled = 'true'", cont1.Uses[0].Uses.When.Name)
+		assert.Contains(t, cont1.Uses[0].Grouping.Dir, "leaf1")
+	}
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+)
+
+const lockFile = ".model-compiler.lock"
+
+// Compilation stages that are skipped when their inputs have not changed
+const (
+	stageBindings = "bindings"
+	stageTree     = "tree"
+	stagePlugin   = "plugin"
+	stageOpenAPI  = "openapi"
+)
+
+// stages are all the compilation stages, in the order they run
+var stages = []string{stageBindings, stageTree, stagePlugin, stageOpenAPI}
+
+// lock records the fingerprint of the inputs of each compilation stage and
+// the files it generated from them
+type lock struct {
+	Stages map[string]stageLock `json:"stages"`
+}
+
+type stageLock struct {
+	Fingerprint string   `json:"fingerprint"`
+	Files       []string `json:"files"`
+}
+
+// loadLock reads the lock file of the model at path; a missing or unreadable
+// lock file means that nothing has been generated yet
+func loadLock(path string) *lock {
+	l := &lock{Stages: make(map[string]stageLock)}
+	data, err := ioutil.ReadFile(filepath.Join(path, lockFile))
+	if err != nil {
+		return l
+	}
+	if err := json.Unmarshal(data, l); err != nil || l.Stages == nil {
+		log.Warnf("Ignoring invalid lock file in '%s': %v", path, err)
+		return &lock{Stages: make(map[string]stageLock)}
+	}
+	return l
+}
+
+func (l *lock) save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(path, lockFile), append(data, '\n'), 0644)
+}
+
+// upToDate checks that a stage was generated from the same inputs and that
+// the files it generated are still there
+func (l *lock) upToDate(path string, stage string, fingerprint string) bool {
+	s, ok := l.Stages[stage]
+	if !ok || s.Fingerprint != fingerprint {
+		return false
+	}
+	for _, file := range s.Files {
+		if _, err := os.Stat(filepath.Join(path, file)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// stageTemplates are the templates that each stage renders
+var stageTemplates = map[string][]string{
+	stagePlugin:  {mainTemplate, modelTemplate, gomodTemplate, makefileTemplate, dockerfileTemplate},
+	stageOpenAPI: {openapiGenTemplate},
+}
+
+// runStage runs the generation of a stage unless its inputs are unchanged
+// since it last ran, recording the files that it generates in the lock
+func (c *ModelCompiler) runStage(path string, stage string, generate func(path string) error) error {
+	if c.lock == nil {
+		return generate(path)
+	}
+	// The plugin artifacts only depend on the meta-data and templates
+	fingerprint, err := c.fingerprint(path, stage != stagePlugin, stageTemplates[stage]...)
+	if err != nil {
+		return err
+	}
+	if !c.force && c.lock.upToDate(path, stage, fingerprint) {
+		log.Infof("Skipping %s generation, inputs are unchanged", stage)
+		c.skipped = append(c.skipped, stage)
+		return nil
+	}
+
+	c.stageFiles = nil
+	if err := generate(path); err != nil {
+		delete(c.lock.Stages, stage)
+		return err
+	}
+	files := make([]string, 0, len(c.stageFiles))
+	for _, file := range c.stageFiles {
+		if rel, err := filepath.Rel(path, file); err == nil {
+			files = append(files, rel)
+		}
+	}
+	sort.Strings(files)
+	c.lock.Stages[stage] = stageLock{Fingerprint: fingerprint, Files: files}
+	return nil
+}
+
+// fingerprint hashes the inputs of a stage: the model meta-data, the plugin
+// version, the config-models directory, the build of the compiler and, as
+// requested, the YANG files and the contents of the templates
+func (c *ModelCompiler) fingerprint(path string, withYang bool, templates ...string) (string, error) {
+	h := sha256.New()
+	metaData, err := json.Marshal(c.metaData)
+	if err != nil {
+		return "", err
+	}
+	h.Write(metaData)
+	fmt.Fprintf(h, "\x00%s\x00%s\x00", c.pluginVersion, c.configModelsDir)
+	if info, ok := debug.ReadBuildInfo(); ok {
+		fmt.Fprintf(h, "%s@%s\x00", info.Main.Path, info.Main.Version)
+		for _, dep := range info.Deps {
+			fmt.Fprintf(h, "%s@%s\x00", dep.Path, dep.Version)
+		}
+	}
+
+	if withYang {
+		yangDir := filepath.Join(path, "yang")
+		err := filepath.Walk(yangDir, func(p string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			data, err := ioutil.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			rel, _ := filepath.Rel(yangDir, p)
+			fmt.Fprintf(h, "%s\x00%d\x00", rel, len(data))
+			h.Write(data)
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, name := range templates {
+		text, err := c.readTemplate(path, name)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00", name, len(text))
+		h.Write(text)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
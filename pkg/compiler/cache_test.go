@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompile_Cache(t *testing.T) {
+	path := copyModel(t, testDevicePath)
+
+	c := NewCompiler(WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Empty(t, c.skipped)
+	assert.FileExists(t, filepath.Join(path, lockFile))
+	l := loadLock(path)
+	assert.Len(t, l.Stages, len(stages))
+	assert.Equal(t, []string{"api/generated.go"}, l.Stages[stageBindings].Files)
+
+	// Nothing changed
+	c = NewCompiler(WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Equal(t, stages, c.skipped)
+
+	// A missing output is generated again
+	assert.NoError(t, os.Remove(filepath.Join(path, "testdevice.tree")))
+	c = NewCompiler(WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Equal(t, []string{stageBindings, stagePlugin, stageOpenAPI}, c.skipped)
+	assert.FileExists(t, filepath.Join(path, "testdevice.tree"))
+
+	// A change to a model template only affects the plugin artifacts
+	assert.NoError(t, os.Mkdir(filepath.Join(path, templatesDir), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(path, templatesDir, dockerfileTemplate), []byte("FROM scratch\n"), 0644))
+	c = NewCompiler(WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Equal(t, []string{stageBindings, stageTree, stageOpenAPI}, c.skipped)
+	dockerfile, err := ioutil.ReadFile(filepath.Join(path, "Dockerfile"))
+	assert.NoError(t, err)
+	assert.Equal(t, "FROM scratch\n", string(dockerfile))
+
+	// A change to a YANG file affects all but the plugin artifacts
+	yangFile := filepath.Join(path, "yang", "onf-test1@2018-02-20.yang")
+	data, err := ioutil.ReadFile(yangFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(yangFile, append(data, []byte("\n// changed\n")...), 0644))
+	c = NewCompiler(WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Equal(t, []string{stagePlugin}, c.skipped)
+
+	// Forcing regenerates everything
+	c = NewCompiler(WithForce(true), WithConfigModelsDir("../.."))
+	assert.NoError(t, c.Compile(path))
+	assert.Empty(t, c.skipped)
+}
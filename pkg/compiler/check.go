@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// artifact is a generated file kept in memory by a dry-run compilation
+type artifact struct {
+	path    string
+	content []byte
+}
+
+// DriftError is returned by Check when generated files differ from the ones on disk
+type DriftError struct {
+	Files []string
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("%d generated file(s) are out of date: %s", len(e.Files), strings.Join(e.Files, ", "))
+}
+
+// Check compiles the config model in memory and compares every generated
+// artifact with the file on disk. The unified diff of each out of date file is
+// written to w and a DriftError is returned if any file differs. The go.mod
+// file is tidied after it is generated, so only its module, go, replace and
+// config-models directives are compared.
+func (c *ModelCompiler) Check(path string, w io.Writer) error {
+	log.Infof("Checking config model at '%s'", path)
+	c.dryRun = true
+	c.artifacts = nil
+	if err := c.compile(path); err != nil {
+		return err
+	}
+
+	drifted := make([]string, 0)
+	for _, a := range c.artifacts {
+		current, err := ioutil.ReadFile(a.path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		currentText, generatedText := string(current), string(a.content)
+		if filepath.Base(a.path) == "go.mod" {
+			currentText, generatedText = generatedDirectives(currentText), generatedDirectives(generatedText)
+		}
+		if currentText == generatedText {
+			continue
+		}
+		drifted = append(drifted, a.path)
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(currentText),
+			B:        difflib.SplitLines(generatedText),
+			FromFile: a.path,
+			ToFile:   a.path + " (generated)",
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, diff); err != nil {
+			return err
+		}
+	}
+	if len(drifted) > 0 {
+		return &DriftError{Files: drifted}
+	}
+	return nil
+}
+
+// generatedDirectives keeps the directives of a go.mod file that go mod tidy
+// leaves as they are generated: the module, the go version, the replacements
+// and the requirement of config-models
+func generatedDirectives(goMod string) string {
+	var directives strings.Builder
+	for _, line := range strings.Split(goMod, "\n") {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "require ")
+		for _, prefix := range []string{"module ", "go ", "replace ", "github.com/onosproject/config-models "} {
+			if strings.HasPrefix(line, prefix) {
+				directives.WriteString(line + "\n")
+				break
+			}
+		}
+	}
+	return directives.String()
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// copyModel copies the sources of a config model in to a temporary directory
+func copyModel(t *testing.T, path string) string {
+	dir := t.TempDir()
+	for _, file := range []string{"metadata.yaml", "VERSION"} {
+		data, err := ioutil.ReadFile(filepath.Join(path, file))
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, file), data, 0644))
+	}
+	files, err := ioutil.ReadDir(filepath.Join(path, "yang"))
+	assert.NoError(t, err)
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "yang"), 0755))
+	for _, f := range files {
+		data, err := ioutil.ReadFile(filepath.Join(path, "yang", f.Name()))
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "yang", f.Name()), data, 0644))
+	}
+	return dir
+}
+
+func TestCheck(t *testing.T) {
+	path := copyModel(t, testDevicePath)
+
+	var out bytes.Buffer
+	err := NewCompiler(WithConfigModelsDir("../..")).Check(path, &out)
+	var driftErr *DriftError
+	assert.True(t, errors.As(err, &driftErr))
+	assert.Contains(t, driftErr.Files, filepath.Join(path, "plugin", "main.go"))
+	assert.Contains(t, out.String(), "+++ "+filepath.Join(path, "go.mod")+" (generated)")
+
+	assert.NoError(t, NewCompiler(WithConfigModelsDir("../..")).Compile(path))
+	out.Reset()
+	assert.NoError(t, NewCompiler(WithConfigModelsDir("../..")).Check(path, &out))
+	assert.Empty(t, out.String())
+
+	// The requirements that go mod tidy adds are not drift
+	goModFile := filepath.Join(path, "go.mod")
+	goMod, err := ioutil.ReadFile(goModFile)
+	assert.NoError(t, err)
+	goMod = bytes.Replace(goMod, []byte("require (\n"), []byte("require (\n\tgithub.com/ghodss/yaml v1.0.0 // indirect\n"), 1)
+	assert.NoError(t, ioutil.WriteFile(goModFile, goMod, 0644))
+	assert.NoError(t, NewCompiler(WithConfigModelsDir("../..")).Check(path, &out))
+	assert.Empty(t, out.String())
+
+	treeFile := filepath.Join(path, "testdevice.tree")
+	assert.NoError(t, ioutil.WriteFile(treeFile, []byte("module: stale\n"), 0644))
+	err = NewCompiler(WithConfigModelsDir("../..")).Check(path, &out)
+	assert.True(t, errors.As(err, &driftErr))
+	assert.Equal(t, []string{treeFile}, driftErr.Files)
+	assert.Contains(t, out.String(), "-module: stale\n")
+	assert.Contains(t, out.String(), "+module: onf-test1\n")
+}
+
+func TestCheck_CommittedModel(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, NewCompiler(WithConfigModelsDir("../..")).Check(testDevicePath, &out))
+	assert.Empty(t, out.String())
+}
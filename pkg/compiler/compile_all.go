@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const metaDataFile = "metadata.yaml"
+
+// ModelResult is the outcome of the compilation of one config model
+type ModelResult struct {
+	Path     string
+	Name     string
+	Duration time.Duration
+	// Paths is the number of leaf paths in the generated schema
+	Paths int
+	// UpToDate is set when no stage had to be regenerated
+	UpToDate bool
+	Err      error
+}
+
+// CompileAllError lists the config models that failed to compile
+type CompileAllError struct {
+	Failures []ModelResult
+}
+
+func (e *CompileAllError) Error() string {
+	lines := []string{fmt.Sprintf("%d config model(s) failed to compile:", len(e.Failures))}
+	for _, r := range e.Failures {
+		lines = append(lines, fmt.Sprintf("  %s: %v", r.Path, r.Err))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FindModels returns the directories under root that contain a model meta-data file
+func FindModels(root string) ([]string, error) {
+	paths := make([]string, 0)
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if _, err := os.Stat(filepath.Join(p, metaDataFile)); err == nil {
+			paths = append(paths, p)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// CompileAll compiles every config model found under root, running at most
+// workers compilations concurrently. The results are in the order the models
+// were found; a CompileAllError lists the failures, if any.
+func CompileAll(root string, workers int, opts ...Option) ([]ModelResult, error) {
+	paths, err := FindModels(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no config models found in '%s'", root)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]ModelResult, len(paths))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = compileModel(paths[i], opts...)
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failures := make([]ModelResult, 0)
+	for _, r := range results {
+		if r.Err != nil {
+			failures = append(failures, r)
+		}
+	}
+	if len(failures) > 0 {
+		return results, &CompileAllError{Failures: failures}
+	}
+	return results, nil
+}
+
+func compileModel(path string, opts ...Option) ModelResult {
+	c := NewCompiler(opts...)
+	start := time.Now()
+	err := c.Compile(path)
+	result := ModelResult{
+		Path:     path,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if c.metaData != nil {
+		result.Name = c.metaData.Name
+	}
+	result.UpToDate = err == nil && len(c.skipped) == len(stages)
+	if root, ok := c.schemaTree[c.fakeRootName()]; ok {
+		result.Paths = countLeaves(root)
+	}
+	return result
+}
+
+// countLeaves counts the leaves and leaf-lists under a schema entry
+func countLeaves(e *yang.Entry) int {
+	if !e.IsDir() {
+		return 1
+	}
+	n := 0
+	for _, child := range e.Dir {
+		n += countLeaves(child)
+	}
+	return n
+}
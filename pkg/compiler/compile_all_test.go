@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompileAll(t *testing.T) {
+	root := t.TempDir()
+	for _, model := range []string{testDevicePath, deviceSimPath} {
+		assert.NoError(t, os.Rename(copyModel(t, model), filepath.Join(root, filepath.Base(model))))
+	}
+	broken := filepath.Join(root, "nested", "broken")
+	assert.NoError(t, os.MkdirAll(filepath.Join(broken, "yang"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(broken, metaDataFile), []byte(`name: broken
+version: 1.0.0
+artifactName: broken
+goPackage: github.com/onosproject/config-models/models/broken
+contactName: Open Networking Foundation
+licenseName: Apache-2.0
+modules:
+  - name: missing
+    revision: 2022-01-01
+    file: missing.yang
+`), 0644))
+
+	paths, err := FindModels(root)
+	assert.NoError(t, err)
+	assert.Len(t, paths, 3)
+
+	results, err := CompileAll(root, 3, WithConfigModelsDir("../.."))
+	var compileErr *CompileAllError
+	assert.True(t, errors.As(err, &compileErr))
+	assert.Len(t, compileErr.Failures, 1)
+	assert.Equal(t, broken, compileErr.Failures[0].Path)
+	assert.Contains(t, err.Error(), broken)
+
+	assert.Len(t, results, 3)
+	for _, r := range results {
+		if r.Path == broken {
+			assert.Error(t, r.Err)
+			continue
+		}
+		assert.NoError(t, r.Err)
+		assert.NotZero(t, r.Paths)
+		assert.FileExists(t, filepath.Join(r.Path, "api", "generated.go"))
+	}
+
+	_, err = CompileAll(t.TempDir(), 1)
+	assert.Error(t, err)
+}
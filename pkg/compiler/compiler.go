@@ -5,20 +5,20 @@
 package compiler
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/onosproject/config-models/pkg/lint"
+	"github.com/onosproject/config-models/pkg/tree"
 	api "github.com/onosproject/onos-api/go/onos/config/admin"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	_ "github.com/openconfig/gnmi/proto/gnmi" // gnmi
-	_ "github.com/openconfig/goyang/pkg/yang" // yang
-	_ "github.com/openconfig/ygot/genutil"    // genutil
-	_ "github.com/openconfig/ygot/ygen"       // ygen
-	_ "github.com/openconfig/ygot/ygot"       // ygot
-	_ "github.com/openconfig/ygot/ytypes"     // ytypes
-	_ "google.golang.org/protobuf/proto"      // proto
+	"github.com/openconfig/goyang/pkg/yang"
+	_ "github.com/openconfig/ygot/ygot"   // ygot
+	_ "github.com/openconfig/ygot/ytypes" // ytypes
+	_ "google.golang.org/protobuf/proto"  // proto
+	"io"
 	"io/ioutil"
-	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 )
@@ -33,44 +33,98 @@ const (
 	makefileTemplate   = "Makefile.tpl"
 	dockerfileTemplate = "Dockerfile.tpl"
 	openapiGenTemplate = "openapi-gen.go.tpl"
+	templatesDir       = "templates"
+	// configModelsVersion is the release of the config-models module that the
+	// generated plugins require, and configModelsReleased whether it has been
+	// published. The plugins import its pkg/plugin, which no earlier release
+	// has, so until then they can only be built with a local config-models
+	configModelsVersion  = "v0.10.42"
+	configModelsReleased = false
 	//gnmiGenTemplate    = "gnmi-gen.go.tpl"
 )
 
+// Option is a config model compiler option
+type Option func(c *ModelCompiler)
+
+// WithTemplatesDir makes the compiler use the templates in dir instead of its
+// embedded templates. Templates missing from dir fall back to the embedded ones.
+func WithTemplatesDir(dir string) Option {
+	return func(c *ModelCompiler) {
+		c.templatesDir = dir
+	}
+}
+
+// WithForce makes the compiler regenerate every artifact, ignoring the lock file
+func WithForce(force bool) Option {
+	return func(c *ModelCompiler) {
+		c.force = force
+	}
+}
+
+// WithConfigModelsDir makes the generated plugins use the config-models module
+// in dir, e.g. ../.. for the models of this repository, instead of its release
+func WithConfigModelsDir(dir string) Option {
+	return func(c *ModelCompiler) {
+		c.configModelsDir = dir
+	}
+}
+
 // NewCompiler creates a new config model compiler
-func NewCompiler() *ModelCompiler {
-	return &ModelCompiler{}
+func NewCompiler(opts ...Option) *ModelCompiler {
+	c := &ModelCompiler{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 type Dictionary struct {
-	Name               string
-	Version            string
-	PluginVersion      string
-	ArtifactName       string
-	GoPackage          string
-	ModelData          []*gnmi.ModelData
-	Module             string
-	GetStateMode       uint32
-	ReadOnlyPath       []*api.ReadOnlyPath
-	ReadWritePath      []*api.ReadWritePath
-	OpenAPITargetAlias string
-	ContactName        string
-	ContactUrl         string
-	ContactEmail       string
-	LicenseName        string
-	LicenseUrl         string
+	Name                string
+	Version             string
+	PluginVersion       string
+	ArtifactName        string
+	GoPackage           string
+	ModelData           []*gnmi.ModelData
+	Module              string
+	GetStateMode        uint32
+	ReadOnlyPath        []*api.ReadOnlyPath
+	ReadWritePath       []*api.ReadWritePath
+	OpenAPITargetAlias  string
+	ContactName         string
+	ContactUrl          string
+	ContactEmail        string
+	LicenseName         string
+	LicenseUrl          string
+	ConfigModelsDir     string
+	ConfigModelsVersion string
 }
 
 // ModelCompiler is a model plugin compiler
 type ModelCompiler struct {
-	pluginVersion string
-	metaData      *MetaData
-	modelInfo     *api.ModelInfo
-	dictionary    Dictionary
+	templatesDir    string
+	configModelsDir string
+	pluginVersion   string
+	metaData        *MetaData
+	modelInfo       *api.ModelInfo
+	dictionary      Dictionary
+	schemaTree      map[string]*yang.Entry
+	// dryRun keeps the generated artifacts in memory instead of writing them
+	dryRun    bool
+	artifacts []artifact
+	// force regenerates all stages, even if their inputs are unchanged
+	force      bool
+	lock       *lock
+	stageFiles []string
+	skipped    []string
 }
 
 // Compile compiles the config model
 func (c *ModelCompiler) Compile(path string) error {
 	log.Infof("Compiling config model at '%s'", path)
+	return c.compile(path)
+}
+
+func (c *ModelCompiler) compile(path string) error {
 	var err error
 
 	// Make sure inputs are present: meta-data file and YANG files directory
@@ -81,6 +135,12 @@ func (c *ModelCompiler) Compile(path string) error {
 		return err
 	}
 
+	if !configModelsReleased && c.configModelsDir == "" {
+		err = fmt.Errorf("config-models %s is not released: the directory of a local config-models module is required to build the plugin", configModelsVersion)
+		log.Error(err)
+		return err
+	}
+
 	err = c.loadPluginVersion(path)
 	if err != nil {
 		log.Errorf("Unable to load model plugin version; defaulting to %s: %+v", c.pluginVersion, err)
@@ -96,48 +156,36 @@ func (c *ModelCompiler) Compile(path string) error {
 	}
 
 	// Create dictionary from metadata and model info
-	c.dictionary = Dictionary{
-		Name:               c.modelInfo.Name,
-		Version:            c.modelInfo.Version,
-		PluginVersion:      c.pluginVersion,
-		ArtifactName:       c.metaData.ArtifactName,
-		GoPackage:          c.metaData.GoPackage,
-		ModelData:          c.modelInfo.ModelData,
-		Module:             c.modelInfo.Module,
-		GetStateMode:       c.modelInfo.GetStateMode,
-		ReadOnlyPath:       c.modelInfo.ReadOnlyPath,
-		ReadWritePath:      c.modelInfo.ReadWritePath,
-		OpenAPITargetAlias: c.metaData.OpenAPITargetAlias,
-		ContactName:        c.metaData.ContactName,
-		ContactUrl:         c.metaData.ContactUrl,
-		ContactEmail:       c.metaData.ContactEmail,
-		LicenseName:        c.metaData.LicenseName,
-		LicenseUrl:         c.metaData.LicenseUrl,
+	c.createDictionary()
+
+	// Stages whose inputs have not changed since the last compilation are skipped
+	if !c.dryRun {
+		c.lock = loadLock(path)
 	}
 
 	// Generate Golang bindings for the YANG files
-	err = c.generateGolangBindings(path)
+	err = c.runStage(path, stageBindings, c.generateGolangBindings)
 	if err != nil {
 		log.Errorf("Unable to generate Golang bindings: %+v", err)
 		return err
 	}
 
 	// Generate YANG model tree
-	err = c.generateModelTree(path)
+	err = c.runStage(path, stageTree, c.generateModelTree)
 	if err != nil {
 		log.Errorf("Unable to generate YANG model tree: %+v", err)
 		return err
 	}
 
 	// Generate model plugin artifacts from generic templates
-	err = c.generatePluginArtifacts(path)
+	err = c.runStage(path, stagePlugin, c.generatePluginArtifacts)
 	if err != nil {
 		log.Errorf("Unable to generate model plugin artifacts: %+v", err)
 		return err
 	}
 
 	// Generate OpenAPI
-	err = c.generateOpenApi(path)
+	err = c.runStage(path, stageOpenAPI, c.generateOpenApi)
 	if err != nil {
 		log.Errorf("Unable to generate OpenApi specs: %+v", err)
 		return err
@@ -159,15 +207,42 @@ func (c *ModelCompiler) Compile(path string) error {
 	//	return err
 	//}
 
+	if c.lock != nil {
+		return c.lock.save(path)
+	}
 	return nil
 }
 
+// createDictionary creates the template dictionary from the metadata and model info
+func (c *ModelCompiler) createDictionary() {
+	c.dictionary = Dictionary{
+		Name:                c.modelInfo.Name,
+		Version:             c.modelInfo.Version,
+		PluginVersion:       c.pluginVersion,
+		ArtifactName:        c.metaData.ArtifactName,
+		GoPackage:           c.metaData.GoPackage,
+		ModelData:           c.modelInfo.ModelData,
+		Module:              c.modelInfo.Module,
+		GetStateMode:        c.modelInfo.GetStateMode,
+		ReadOnlyPath:        c.modelInfo.ReadOnlyPath,
+		ReadWritePath:       c.modelInfo.ReadWritePath,
+		OpenAPITargetAlias:  c.metaData.OpenAPITargetAlias,
+		ContactName:         c.metaData.ContactName,
+		ContactUrl:          c.metaData.ContactUrl,
+		ContactEmail:        c.metaData.ContactEmail,
+		LicenseName:         c.metaData.LicenseName,
+		LicenseUrl:          c.metaData.LicenseUrl,
+		ConfigModelsDir:     c.configModelsDir,
+		ConfigModelsVersion: configModelsVersion,
+	}
+}
+
 func (c *ModelCompiler) loadModelMetaData(path string) error {
 	c.metaData = &MetaData{}
 	if err := LoadMetaData(path, "metadata", c.metaData); err != nil {
 		return err
 	}
-	if err := ValidateMetaData(c.metaData); err != nil {
+	if err := ValidateMetaData(path, c.metaData); err != nil {
 		return err
 	}
 	modelData := make([]*gnmi.ModelData, 0, len(c.metaData.Modules))
@@ -197,102 +272,108 @@ func (c *ModelCompiler) loadPluginVersion(path string) error {
 	return err
 }
 
+// Lint lints the YANG files of the config model, whether its meta-data requests it or not
+func (c *ModelCompiler) Lint(path string) error {
+	if err := c.loadModelMetaData(path); err != nil {
+		return err
+	}
+	return c.lintModel(path)
+}
+
 func (c *ModelCompiler) lintModel(path string) error {
 	log.Infof("Linting YANG files")
 
-	args := []string{"--lint", "--lint-ensure-hyphenated-names", "-W", "error", "--ignore-error=XPATH_FUNCTION"}
-
-	// Append the root YANG files to the command-line arguments
 	yangDir := filepath.Join(path, "yang")
+	searchPath, err := yang.PathsWithModules(yangDir)
+	if err != nil {
+		return err
+	}
+
+	// Lint the root YANG files; imports are resolved through the search path
+	files := make([]string, 0, len(c.metaData.Modules))
 	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
+		files = append(files, filepath.Join(yangDir, module.YangFile))
 	}
 
-	log.Infof("Executing %s", path, strings.Join(args, " "))
-	cmd := exec.Command("pyang", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	diags := lint.Lint(searchPath, files)
+	for _, d := range diags {
+		log.Errorf("%s", d)
+	}
+	if len(diags) > 0 {
+		return fmt.Errorf("lint found %d issue(s) in YANG files", len(diags))
+	}
+	return nil
 }
 
 func (c *ModelCompiler) generateGolangBindings(path string) error {
-	apiDir := filepath.Join(path, "api")
-	c.createDir(apiDir)
-
-	apiFile := filepath.Join(apiDir, "generated.go")
+	apiFile := filepath.Join(path, "api", "generated.go")
 	log.Infof("Generating YANG bindings '%s'", apiFile)
 
-	args := []string{
-		fmt.Sprintf("-path=%s/yang", path),
-		fmt.Sprintf("-output_file=%s", apiFile),
-		"-package_name=api",
-		"-generate_fakeroot",
-		"--include_descriptions",
-	}
-
-	// Append all YANG files to the command-line arguments
-	pathDirs := make([]string, 0)
-	err := filepath.Walk(filepath.Join(path, "yang"), func(p string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() {
-			pathDirs = append(pathDirs, p)
-		}
-		return nil
-	})
+	code, err := c.renderGolangBindings(path)
 	if err != nil {
 		return err
 	}
-	args = append(args, fmt.Sprintf("-path=\"%s\"", strings.Join(pathDirs, ",")))
-	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(path, "yang", module.YangFile))
-	}
+	return c.writeFile(apiFile, code, 0640)
+}
 
-	log.Infof("Executing: generator %s", path, strings.Join(args, " "))
-	cmd := exec.Command("generator", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	err = cmd.Run()
-	if err != nil {
+func (c *ModelCompiler) generateModelTree(path string) error {
+	treeFile := filepath.Join(path, c.modelInfo.Name+".tree")
+	log.Infof("Generating YANG tree '%s'", treeFile)
+
+	var buf bytes.Buffer
+	if err := c.writeModelTree(path, &buf); err != nil {
 		return err
 	}
+	return c.writeFile(treeFile, buf.Bytes(), 0644)
+}
 
-	return insertHeaderPrefix(apiFile)
+// Tree writes the YANG tree diagram of the config model at path
+func (c *ModelCompiler) Tree(path string, w io.Writer) error {
+	if err := c.loadModelMetaData(path); err != nil {
+		return err
+	}
+	return c.writeModelTree(path, w)
 }
 
-func insertHeaderPrefix(file string) error {
-	content, err := ioutil.ReadFile(file)
+func (c *ModelCompiler) writeModelTree(path string, w io.Writer) error {
+	modules, err := c.loadModules(path)
 	if err != nil {
 		return err
 	}
-
-	newContent := []byte("// Code generated by YGOT. DO NOT")
-	newContent = append(newContent, []byte("EDIT.\n")...) // HACK: Defeat the license header check
-	newContent = append(newContent, content...)
-	return ioutil.WriteFile(file, newContent, 0640)
+	return tree.WriteModules(w, modules)
 }
 
-func (c *ModelCompiler) generateModelTree(path string) error {
-	treeFile := filepath.Join(path, c.modelInfo.Name+".tree")
-	log.Infof("Generating YANG tree '%s'", treeFile)
-
+// loadModules parses the YANG files of the model with goyang and returns
+// the entries of the modules listed in the meta-data, in the listed order
+func (c *ModelCompiler) loadModules(path string) ([]*yang.Entry, error) {
 	yangDir := filepath.Join(path, "yang")
-	args := []string{"-f", "tree", "--ignore-error=XPATH_FUNCTION", "-p", yangDir, "-o", treeFile}
-
-	// Append the root YANG files to the command-line arguments
+	searchPath, err := yang.PathsWithModules(yangDir)
+	if err != nil {
+		return nil, err
+	}
+	ms := yang.NewModules()
+	ms.AddPath(searchPath...)
 	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
+		if err := ms.Read(filepath.Join(yangDir, module.YangFile)); err != nil {
+			return nil, err
+		}
+	}
+	if errs := ms.Process(); len(errs) > 0 {
+		return nil, fmt.Errorf("unable to process YANG files: %v", errs)
 	}
 
-	log.Infof("Executing %s", path, strings.Join(args, " "))
-	cmd := exec.Command("pyang", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	entries := make([]*yang.Entry, 0, len(c.metaData.Modules))
+	for _, module := range c.metaData.Modules {
+		m, ok := ms.Modules[module.Name]
+		if !ok {
+			m, ok = ms.SubModules[module.Name]
+		}
+		if !ok {
+			return nil, fmt.Errorf("module %s not found in %s", module.Name, module.YangFile)
+		}
+		entries = append(entries, yang.ToEntry(m))
+	}
+	return entries, nil
 }
 
 func (c *ModelCompiler) generatePluginArtifacts(path string) error {
@@ -322,50 +403,61 @@ func (c *ModelCompiler) generatePluginArtifacts(path string) error {
 }
 
 func (c *ModelCompiler) generateMain(path string) error {
-	mainDir := filepath.Join(path, "plugin")
-	mainFile := filepath.Join(mainDir, "main.go")
+	mainFile := filepath.Join(path, "plugin", "main.go")
 	log.Infof("Generating plugin main '%s'", mainFile)
-	c.createDir(mainDir)
-	return c.applyTemplate(mainTemplate, c.getTemplatePath(mainTemplate), mainFile)
+	return c.applyTemplate(path, mainTemplate, mainFile)
 }
 
 func (c *ModelCompiler) generateModel(path string) error {
-	modelDir := filepath.Join(path, "api")
-	modelFile := filepath.Join(modelDir, "model.go")
+	modelFile := filepath.Join(path, "api", "model.go")
 	log.Infof("Generating plugin model '%s'", modelFile)
-	c.createDir(modelDir)
-	return c.applyTemplate(modelTemplate, c.getTemplatePath(modelTemplate), modelFile)
+	return c.applyTemplate(path, modelTemplate, modelFile)
 }
 
 func (c *ModelCompiler) generateGoModule(path string) error {
 	gomodFile := filepath.Join(path, "go.mod")
 	log.Infof("Generating plugin Go module '%s'", gomodFile)
-	return c.applyTemplate(gomodTemplate, c.getTemplatePath(gomodTemplate), gomodFile)
+	return c.applyTemplate(path, gomodTemplate, gomodFile)
 }
 
 func (c *ModelCompiler) generateMakefile(path string) error {
 	makefileFile := filepath.Join(path, "Makefile")
 	log.Infof("Generating plugin Makefile '%s'", makefileFile)
-	return c.applyTemplate(makefileTemplate, c.getTemplatePath(makefileTemplate), makefileFile)
+	return c.applyTemplate(path, makefileTemplate, makefileFile)
 }
 
 func (c *ModelCompiler) generateDockerfile(path string) error {
 	dockerfileFile := filepath.Join(path, "Dockerfile")
 	log.Infof("Generating plugin Dockerfile '%s'", dockerfileFile)
-	return c.applyTemplate(dockerfileTemplate, c.getTemplatePath(dockerfileTemplate), dockerfileFile)
+	return c.applyTemplate(path, dockerfileTemplate, dockerfileFile)
 }
 
-// TODO we should be able to run this generated code right after we generate it,
-// so that we can remove a step from `make models-images`
 func (c *ModelCompiler) generateOpenApi(path string) error {
-	// the Schema we need to import is generated at runtime, so we need to generate the tool
-	// to import such schema and generate the OpenApi specs
-	dir := filepath.Join(path, "openapi")
-	openapiGenFile := filepath.Join(dir, "openapi-gen.go")
-	c.createDir(dir)
+	// the generated tool imports the compiled schema to generate the OpenApi specs,
+	// so that they can be regenerated with `make openapi`
+	openapiGenFile := filepath.Join(path, "openapi", "openapi-gen.go")
 
 	log.Infof("Generating plugin OpenApi Gen file '%s'", openapiGenFile)
-	return c.applyTemplate(openapiGenTemplate, c.getTemplatePath(openapiGenTemplate), openapiGenFile)
+	if err := c.applyTemplate(path, openapiGenTemplate, openapiGenFile); err != nil {
+		return err
+	}
+	if !c.metaData.GenOpenAPI {
+		return nil
+	}
+	if c.schemaTree == nil {
+		// The bindings were up to date, generate their schema again
+		if _, err := c.renderGolangBindings(path); err != nil {
+			return err
+		}
+	}
+
+	openapiFile := filepath.Join(path, "openapi.yaml")
+	log.Infof("Generating OpenApi specs '%s'", openapiFile)
+	spec, err := c.renderOpenApi()
+	if err != nil {
+		return err
+	}
+	return c.writeFile(openapiFile, spec, 0644)
 }
 
 //func (c *ModelCompiler) generateGnmiClientGenerator(path string) error {
@@ -376,7 +468,7 @@ func (c *ModelCompiler) generateOpenApi(path string) error {
 //	c.createDir(dir)
 //
 //	log.Infof("Generating plugin GnmiGen file '%s'", gnmiGen)
-//	return c.applyTemplate(gnmiGenTemplate, c.getTemplatePath(gnmiGenTemplate), gnmiGen)
+//	return c.applyTemplate(path, gnmiGenTemplate, gnmiGen)
 //}
 
 //func (c *ModelCompiler) generateGnmiClient(path string) error {
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompile_PluginBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a generated plugin")
+	}
+	configModelsDir, err := filepath.Abs("../..")
+	assert.NoError(t, err)
+	path := copyModel(t, testDevicePath)
+	assert.NoError(t, NewCompiler(WithConfigModelsDir(configModelsDir)).Compile(path))
+
+	goMod, err := ioutil.ReadFile(filepath.Join(path, "go.mod"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(goMod), "replace github.com/onosproject/config-models => "+configModelsDir+"\n")
+
+	build := exec.Command("go", "build", "-mod=mod", "-o", filepath.Join(t.TempDir(), "plugin"), "./plugin")
+	build.Dir = path
+	out, err := build.CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
+
+func TestCompile_UnreleasedConfigModels(t *testing.T) {
+	if configModelsReleased {
+		t.Skip("config-models " + configModelsVersion + " is released")
+	}
+	path := copyModel(t, testDevicePath)
+	err := NewCompiler().Compile(path)
+	assert.EqualError(t, err, "config-models "+configModelsVersion+" is not released: the directory of a local config-models module is required to build the plugin")
+	assert.NoFileExists(t, filepath.Join(path, "go.mod"))
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/template"
+	"time"
+)
+
+const defaultGoPackagePrefix = "github.com/onosproject/config-models/models/"
+
+// InitOptions are the attributes of a new config model that cannot be
+// derived from its YANG files
+type InitOptions struct {
+	Name         string
+	Version      string
+	ArtifactName string
+	GoPackage    string
+	ContactName  string
+	ContactUrl   string
+	ContactEmail string
+	LicenseName  string
+	LicenseUrl   string
+}
+
+// modelDirRegex splits model directory names such as devicesim-1.0.x into
+// the name and version of the model
+var modelDirRegex = regexp.MustCompile(`^(.+)-(v?\d+(\.(\d+|x)){1,2})$`)
+
+// DefaultInitOptions returns the default attributes of a config model created
+// in dir; the name and version are derived from the directory name
+func DefaultInitOptions(dir string) InitOptions {
+	base := filepath.Base(dir)
+	opts := InitOptions{
+		Name:         base,
+		Version:      "1.0.0",
+		ArtifactName: base,
+		GoPackage:    defaultGoPackagePrefix + base,
+		ContactName:  "Open Networking Foundation",
+		ContactUrl:   "https://opennetworking.org",
+		ContactEmail: "info@opennetworking.org",
+		LicenseName:  "Apache-2.0",
+		LicenseUrl:   "https://www.apache.org/licenses/LICENSE-2.0",
+	}
+	if m := modelDirRegex.FindStringSubmatch(base); m != nil {
+		opts.Name, opts.Version = m[1], m[2]
+	}
+	return opts
+}
+
+// yangFileInfo is what the scaffolding needs to know about a YANG file
+type yangFileInfo struct {
+	file         string
+	keyword      string
+	name         string
+	organization string
+	revision     string
+	imports      []string
+}
+
+// Init scaffolds a new config model in dir from the given YANG files. The
+// files are copied to the yang directory of the model, and the modules of
+// the meta-data are the top-level modules, that is those that no other
+// module imports. The meta-data and VERSION files are only written if the
+// meta-data is valid.
+func Init(dir string, yangFiles []string, opts InitOptions) (*MetaData, error) {
+	if len(yangFiles) == 0 {
+		return nil, fmt.Errorf("no YANG files given")
+	}
+	if _, err := os.Stat(filepath.Join(dir, metaDataFile)); err == nil {
+		return nil, fmt.Errorf("'%s' already contains a config model", dir)
+	}
+
+	infos := make([]*yangFileInfo, 0, len(yangFiles))
+	imported := make(map[string]bool)
+	for _, file := range yangFiles {
+		info, err := parseYangFile(file)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+		for _, name := range info.imports {
+			imported[name] = true
+		}
+	}
+
+	metaData := &MetaData{
+		Name:         opts.Name,
+		Version:      opts.Version,
+		ArtifactName: opts.ArtifactName,
+		GoPackage:    opts.GoPackage,
+		ContactName:  opts.ContactName,
+		ContactUrl:   opts.ContactUrl,
+		ContactEmail: opts.ContactEmail,
+		LicenseName:  opts.LicenseName,
+		LicenseUrl:   opts.LicenseUrl,
+		GenOpenAPI:   true,
+	}
+	for _, info := range infos {
+		if info.keyword != "module" || imported[info.name] {
+			continue
+		}
+		metaData.Modules = append(metaData.Modules, Module{
+			Name:         info.name,
+			Revision:     info.revision,
+			Organization: info.organization,
+			YangFile:     filepath.Base(info.file),
+		})
+	}
+	sort.Slice(metaData.Modules, func(i, j int) bool {
+		return metaData.Modules[i].Name < metaData.Modules[j].Name
+	})
+
+	yangDir := filepath.Join(dir, "yang")
+	if err := os.MkdirAll(yangDir, 0755); err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if err := copyYangFile(info.file, yangDir); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ValidateMetaData(dir, metaData); err != nil {
+		return metaData, err
+	}
+
+	var buf bytes.Buffer
+	if err := metaDataTemplate.Execute(&buf, struct {
+		*MetaData
+		Year int
+	}{metaData, time.Now().Year()}); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, metaDataFile), buf.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, versionFile), []byte("0.0.1-dev\n"), 0644); err != nil {
+		return nil, err
+	}
+	log.Infof("Created config model '%s' in '%s' with %d module(s)", metaData.Name, dir, len(metaData.Modules))
+	return metaData, nil
+}
+
+// parseYangFile reads the name, organization, latest revision and imports of
+// the module or submodule defined in a YANG file
+func parseYangFile(file string) (*yangFileInfo, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := yang.Parse(string(data), file)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) != 1 || (statements[0].Keyword != "module" && statements[0].Keyword != "submodule") {
+		return nil, fmt.Errorf("'%s' does not define a YANG module", file)
+	}
+	stmt := statements[0]
+	info := &yangFileInfo{file: file, keyword: stmt.Keyword, name: stmt.Argument}
+	for _, sub := range stmt.SubStatements() {
+		switch sub.Keyword {
+		case "organization":
+			info.organization = sub.Argument
+		case "revision":
+			if sub.Argument > info.revision {
+				info.revision = sub.Argument
+			}
+		case "import":
+			info.imports = append(info.imports, sub.Argument)
+		}
+	}
+	return info, nil
+}
+
+func copyYangFile(file string, yangDir string) error {
+	target := filepath.Join(yangDir, filepath.Base(file))
+	if src, err := filepath.Abs(file); err == nil {
+		if dst, err := filepath.Abs(target); err == nil && src == dst {
+			return nil
+		}
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(target, data, 0644)
+}
+
+var metaDataTemplate = template.Must(template.New(metaDataFile).Parse(`# SPDX-FileCopyrightText: {{ .Year }}-present {{ .ContactName }}
+#
+# SPDX-License-Identifier: {{ .LicenseName }}
+
+name: {{ .Name }}
+version: {{ .Version }}
+artifactName: {{ .ArtifactName }}
+goPackage: {{ .GoPackage }}
+genOpenAPI: {{ .GenOpenAPI }}
+contactName: {{ printf "%q" .ContactName }}
+{{- with .ContactUrl }}
+contactUrl: {{ . }}
+{{- end }}
+{{- with .ContactEmail }}
+contactEmail: {{ . }}
+{{- end }}
+licenseName: {{ .LicenseName }}
+{{- with .LicenseUrl }}
+licenseUrl: {{ . }}
+{{- end }}
+modules:
+{{- range .Modules }}
+  - name: {{ .Name }}
+{{- with .Organization }}
+    organization: {{ printf "%q" . }}
+{{- end }}
+    revision: {{ .Revision }}
+    file: {{ .YangFile }}
+{{- end }}
+`))
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultInitOptions(t *testing.T) {
+	opts := DefaultInitOptions("models/devicesim-1.0.x")
+	assert.Equal(t, "devicesim", opts.Name)
+	assert.Equal(t, "1.0.x", opts.Version)
+	assert.Equal(t, "devicesim-1.0.x", opts.ArtifactName)
+	assert.Equal(t, "github.com/onosproject/config-models/models/devicesim-1.0.x", opts.GoPackage)
+
+	opts = DefaultInitOptions("mydevice")
+	assert.Equal(t, "mydevice", opts.Name)
+	assert.Equal(t, "1.0.0", opts.Version)
+}
+
+func TestInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "testdevice-2.0.x")
+	yangDir := filepath.Join(testDevicePath, "..", "testdevice-2.0.x", "yang")
+	files := []string{
+		filepath.Join(yangDir, "onf-test1@2019-06-10.yang"),
+		filepath.Join(yangDir, "onf-test1-augmented@2020-02-29.yang"),
+	}
+
+	md, err := Init(dir, files, DefaultInitOptions(dir))
+	assert.NoError(t, err)
+	// onf-test1 is imported by onf-test1-augmented
+	assert.Equal(t, []Module{{
+		Name:         "onf-test1-augmented",
+		Revision:     "2020-02-29",
+		Organization: "Open Networking Foundation.",
+		YangFile:     "onf-test1-augmented@2020-02-29.yang",
+	}}, md.Modules)
+	for _, file := range files {
+		assert.FileExists(t, filepath.Join(dir, "yang", filepath.Base(file)))
+	}
+
+	// The written meta-data loads back as is and is valid
+	loaded := &MetaData{}
+	assert.NoError(t, LoadMetaData(dir, "metadata", loaded))
+	assert.Equal(t, md.Name, loaded.Name)
+	assert.Equal(t, md.ContactName, loaded.ContactName)
+	assert.Equal(t, md.Modules, loaded.Modules)
+	assert.NoError(t, ValidateMetaData(dir, loaded))
+	version, err := ioutil.ReadFile(filepath.Join(dir, versionFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0.1-dev\n", string(version))
+
+	_, err = Init(dir, files, DefaultInitOptions(dir))
+	assert.EqualError(t, err, "'"+dir+"' already contains a config model")
+}
+
+func TestInitInvalid(t *testing.T) {
+	dir := t.TempDir()
+	opts := DefaultInitOptions(dir)
+	opts.GoPackage = "devicesim"
+	_, err := Init(dir, []string{filepath.Join(testDevicePath, "yang", "onf-test1@2018-02-20.yang")}, opts)
+	assert.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, metaDataFile))
+
+	_, err = Init(dir, nil, opts)
+	assert.Error(t, err)
+}
@@ -5,26 +5,58 @@
 package compiler
 
 import (
+	_ "embed" // for the meta-data JSON schema
 	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/spf13/viper"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
+// MetaDataSchema is the JSON schema of metadata.yaml, for use by editors
+//
+//go:embed metadata.schema.json
+var MetaDataSchema []byte
+
 // MetaData plugin meta-data
 type MetaData struct {
-	Name               string   `mapstructure:"name" yaml:"name"`
-	Version            string   `mapstructure:"version" yaml:"version"`
-	Modules            []Module `mapstructure:"modules" yaml:"modules"`
-	GetStateMode       uint32   `mapstructure:"getStateMode" yaml:"getStateMode"`
-	LintModel          bool     `mapstructure:"lintModel" yaml:"lintModel"`
-	GenOpenAPI         bool     `mapstructure:"genOpenAPI" yaml:"genOpenAPI"`
-	OpenAPITargetAlias string   `mapstructure:"openAPITargetAlias" yaml:"openAPITargetAlias"`
-	GoPackage          string   `mapstructure:"goPackage" yaml:"goPackage"`
-	ArtifactName       string   `mapstructure:"artifactName" yaml:"artifactName"`
-	ContactName        string   `mapstructure:"contactName" yaml:"contactName"`
-	ContactUrl         string   `mapstructure:"contactUrl" yaml:"contactUrl"`
-	ContactEmail       string   `mapstructure:"contactEmail" yaml:"contactEmail"`
-	LicenseName        string   `mapstructure:"licenseName" yaml:"licenseName"`
-	LicenseUrl         string   `mapstructure:"licenseUrl" yaml:"licenseUrl"`
+	Name               string    `mapstructure:"name" yaml:"name"`
+	Version            string    `mapstructure:"version" yaml:"version"`
+	Modules            []Module  `mapstructure:"modules" yaml:"modules"`
+	GetStateMode       uint32    `mapstructure:"getStateMode" yaml:"getStateMode"`
+	LintModel          bool      `mapstructure:"lintModel" yaml:"lintModel"`
+	GenOpenAPI         bool      `mapstructure:"genOpenAPI" yaml:"genOpenAPI"`
+	OpenAPITargetAlias string    `mapstructure:"openAPITargetAlias" yaml:"openAPITargetAlias"`
+	GoPackage          string    `mapstructure:"goPackage" yaml:"goPackage"`
+	ArtifactName       string    `mapstructure:"artifactName" yaml:"artifactName"`
+	ContactName        string    `mapstructure:"contactName" yaml:"contactName"`
+	ContactUrl         string    `mapstructure:"contactUrl" yaml:"contactUrl"`
+	ContactEmail       string    `mapstructure:"contactEmail" yaml:"contactEmail"`
+	LicenseName        string    `mapstructure:"licenseName" yaml:"licenseName"`
+	LicenseUrl         string    `mapstructure:"licenseUrl" yaml:"licenseUrl"`
+	GoOptions          GoOptions `mapstructure:"goOptions" yaml:"goOptions"`
+}
+
+// GoOptions options for the generation of the YGOT Go bindings
+type GoOptions struct {
+	// CompressPaths compresses the schema paths according to the OpenConfig conventions
+	CompressPaths bool `mapstructure:"compressPaths" yaml:"compressPaths"`
+	// IgnoreShadowPaths ignores the shadowed config or state paths of compressed schemas when unmarshalling
+	IgnoreShadowPaths bool `mapstructure:"ignoreShadowPaths" yaml:"ignoreShadowPaths"`
+	// ShortenEnumLeafNames drops the module name from the names of enumeration leaves of compressed schemas
+	ShortenEnumLeafNames bool `mapstructure:"shortenEnumLeafNames" yaml:"shortenEnumLeafNames"`
+	// TypedefEnumWithDefiningModule names typedef enumerations and identities after their defining module
+	TypedefEnumWithDefiningModule bool `mapstructure:"typedefEnumWithDefiningModule" yaml:"typedefEnumWithDefiningModule"`
+	// SkipEnumDeduplication generates a separate enumeration for every enumeration leaf
+	SkipEnumDeduplication bool `mapstructure:"skipEnumDeduplication" yaml:"skipEnumDeduplication"`
+	// Annotations adds metadata annotation fields to the generated structs
+	Annotations bool `mapstructure:"annotations" yaml:"annotations"`
+	// IncludeDescriptions includes the YANG descriptions in the generated schema; defaults to true
+	IncludeDescriptions bool `mapstructure:"includeDescriptions" yaml:"includeDescriptions"`
+	// FakeRootName is the name of the generated root struct; defaults to Device
+	FakeRootName string `mapstructure:"fakeRootName" yaml:"fakeRootName"`
 }
 
 type Module struct {
@@ -36,38 +68,154 @@ type Module struct {
 
 // LoadMetaData loads the metadata.yaml file
 func LoadMetaData(path string, configFile string, metaData *MetaData) error {
-	viper.SetConfigType("yaml")
-	viper.SetConfigName(configFile)
-	viper.AddConfigPath(path)
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetConfigName(configFile)
+	v.AddConfigPath(path)
+	v.SetDefault("goOptions.includeDescriptions", true)
 
-	if err := viper.ReadInConfig(); err != nil {
+	if err := v.ReadInConfig(); err != nil {
 		return err
 	}
-	return viper.Unmarshal(metaData)
+	return v.Unmarshal(metaData)
+}
+
+// ValidationError is a problem with a field of the meta-data
+type ValidationError struct {
+	// Field is the path of the field in metadata.yaml, e.g. modules[0].revision
+	Field   string
+	Message string
+	// Hint suggests how to fix the problem
+	Hint string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Hint == "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.Field, e.Message, e.Hint)
+}
+
+// ValidationErrors lists all the problems found in the meta-data
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("invalid %s:\n  %s", metaDataFile, strings.Join(msgs, "\n  "))
+}
+
+var (
+	// versionRegex matches semver-ish versions: 1.0.0, 1.0.x, 1.x, v1.2.3-rc1
+	versionRegex = regexp.MustCompile(`^v?\d+(\.(\d+|x)){1,2}(-[0-9A-Za-z.-]+)?$`)
+	// revisionRegex matches YANG revision dates
+	revisionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	// importPathElemRegex matches an element of a Go import path
+	importPathElemRegex = regexp.MustCompile(`^[A-Za-z0-9_.~+-]+$`)
+)
+
+// ValidateMetaData checks the meta-data of the model at path: the required
+// attributes, their format and that the listed modules match the YANG files.
+// All the problems are reported at once as ValidationErrors.
+func ValidateMetaData(path string, metaData *MetaData) error {
+	var errs ValidationErrors
+	add := func(field string, message string, hint string) {
+		errs = append(errs, &ValidationError{Field: field, Message: message, Hint: hint})
+	}
+	required := func(field string, value string, hint string) bool {
+		if value == "" {
+			add(field, "is mandatory", hint)
+			return false
+		}
+		return true
+	}
+
+	required("name", metaData.Name, "the name of the config model, e.g. devicesim")
+	if required("version", metaData.Version, "e.g. 1.0.0") && !versionRegex.MatchString(metaData.Version) {
+		add("version", fmt.Sprintf("'%s' is not a valid version", metaData.Version), "use <major>.<minor>.<patch>, where minor and patch may be x, e.g. 1.0.x")
+	}
+	required("artifactName", metaData.ArtifactName, "the name of the plugin artifact, e.g. devicesim")
+	if required("goPackage", metaData.GoPackage, "the Go import path of the model, e.g. github.com/onosproject/config-models/models/devicesim-1.0.x") {
+		if err := checkImportPath(metaData.GoPackage); err != nil {
+			add("goPackage", fmt.Sprintf("'%s' is not a valid import path: %v", metaData.GoPackage, err), "use a module path such as github.com/org/repo/models/name")
+		}
+	}
+	required("contactName", metaData.ContactName, "the maintainer of the config model")
+	required("licenseName", metaData.LicenseName, "e.g. Apache-2.0")
+
+	if len(metaData.Modules) == 0 {
+		add("modules", "no modules are listed", "list the YANG modules of the model with their name, revision and file")
+	}
+	for i, module := range metaData.Modules {
+		field := fmt.Sprintf("modules[%d]", i)
+		required(field+".name", module.Name, "the name of the YANG module")
+		if required(field+".revision", module.Revision, "the latest revision of the YANG module, e.g. 2020-05-01") && !revisionRegex.MatchString(module.Revision) {
+			add(field+".revision", fmt.Sprintf("'%s' is not a valid revision", module.Revision), "use a YYYY-MM-DD date")
+		}
+		if required(field+".file", module.YangFile, "the YANG file of the module, relative to the yang directory") {
+			validateModuleFile(filepath.Join(path, "yang"), field, module, add)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// ValidateMetaData checks that required attributes are set
-func ValidateMetaData(metaData *MetaData) error {
-	if metaData.Name == "" {
-		return fmt.Errorf("name is mandatory")
+// validateModuleFile checks that the YANG file of a module exists and that
+// the module it defines has the listed name and revision
+func validateModuleFile(yangDir string, field string, module Module, add func(field string, message string, hint string)) {
+	file := filepath.Join(yangDir, module.YangFile)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		add(field+".file", fmt.Sprintf("unable to read '%s'", file), "the file must be in the yang directory of the model")
+		return
 	}
-	if metaData.Version == "" {
-		return fmt.Errorf("version is mandatory")
+	statements, err := yang.Parse(string(data), file)
+	if err != nil {
+		add(field+".file", fmt.Sprintf("unable to parse '%s': %v", file, err), "")
+		return
 	}
-	if metaData.ArtifactName == "" {
-		return fmt.Errorf("artifactName is mandatory")
+	if len(statements) != 1 || (statements[0].Keyword != "module" && statements[0].Keyword != "submodule") {
+		add(field+".file", fmt.Sprintf("'%s' does not define a YANG module", file), "")
+		return
 	}
-	if metaData.GoPackage == "" {
-		return fmt.Errorf("goPackage is mandatory")
+	stmt := statements[0]
+	if module.Name != "" && stmt.Argument != module.Name {
+		add(field+".name", fmt.Sprintf("'%s' does not match module '%s' defined in %s", module.Name, stmt.Argument, module.YangFile), fmt.Sprintf("use %s", stmt.Argument))
 	}
-	if metaData.Modules == nil || len(metaData.Modules) == 0 {
-		return fmt.Errorf("no modules are listed")
+
+	// Revisions are usually listed newest first, but do not rely on it
+	latest := ""
+	for _, sub := range stmt.SubStatements() {
+		if sub.Keyword == "revision" && sub.Argument > latest {
+			latest = sub.Argument
+		}
+	}
+	if module.Revision != "" && latest != "" && module.Revision != latest {
+		add(field+".revision", fmt.Sprintf("'%s' does not match the latest revision '%s' of %s", module.Revision, latest, module.YangFile), fmt.Sprintf("use %s", latest))
 	}
-	if metaData.ContactName == "" {
-		return fmt.Errorf("ContactName is mandatory")
+}
+
+// checkImportPath checks that path is a fully qualified Go import path
+func checkImportPath(path string) error {
+	elems := strings.Split(path, "/")
+	if !strings.Contains(elems[0], ".") {
+		return fmt.Errorf("the first element must be a domain name")
 	}
-	if metaData.LicenseName == "" {
-		return fmt.Errorf("licenseName is mandatory")
+	for _, elem := range elems {
+		if elem == "" {
+			return fmt.Errorf("empty path element")
+		}
+		if elem == "." || elem == ".." || strings.HasPrefix(elem, ".") || strings.HasSuffix(elem, ".") {
+			return fmt.Errorf("invalid path element '%s'", elem)
+		}
+		if !importPathElemRegex.MatchString(elem) {
+			return fmt.Errorf("invalid characters in path element '%s'", elem)
+		}
 	}
 	return nil
 }
@@ -5,7 +5,13 @@
 package compiler
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -16,14 +22,125 @@ func TestLoadMetaData(t *testing.T) {
 	if err := LoadMetaData(path, "valid", md); err != nil {
 		t.Fatal(err)
 	}
+	assert.Equal(t, "test", md.Name)
+	assert.True(t, md.GoOptions.IncludeDescriptions)
+	assert.False(t, md.GoOptions.CompressPaths)
 
 	err := LoadMetaData(path, "not-existing", md)
 	assert.Error(t, err)
 }
 
+func validationFields(t *testing.T, err error) []string {
+	errs, ok := err.(ValidationErrors)
+	if !assert.True(t, ok, "unexpected error %v", err) {
+		return nil
+	}
+	fields := make([]string, 0, len(errs))
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	return fields
+}
+
 func TestValidateMetaData(t *testing.T) {
 	missingName := &MetaData{Name: ""}
-	err := ValidateMetaData(missingName)
+	err := ValidateMetaData(t.TempDir(), missingName)
 	assert.Error(t, err)
-	assert.Equal(t, "name is mandatory", err.Error())
+	// All the missing fields are reported at once
+	assert.Equal(t, []string{"name", "version", "artifactName", "goPackage", "contactName", "licenseName", "modules"},
+		validationFields(t, err))
+	assert.Contains(t, err.Error(), "name: is mandatory")
+}
+
+func TestValidateMetaDataModels(t *testing.T) {
+	paths, err := FindModels("../../models")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, paths)
+	for _, path := range paths {
+		md := &MetaData{}
+		assert.NoError(t, LoadMetaData(path, "metadata", md))
+		assert.NoError(t, ValidateMetaData(path, md), path)
+	}
+}
+
+func TestValidateMetaDataModules(t *testing.T) {
+	path := t.TempDir()
+	yangDir := filepath.Join(path, "yang")
+	assert.NoError(t, os.Mkdir(yangDir, 0755))
+	yangFile := `module test-module {
+  namespace "http://example.com/test";
+  prefix t;
+  revision 2021-02-01;
+  revision 2022-03-04;
+}
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(yangDir, "test.yang"), []byte(yangFile), 0644))
+
+	md := &MetaData{
+		Name:         "test",
+		Version:      "1.0.x",
+		ArtifactName: "test",
+		GoPackage:    "github.com/onosproject/config-models/models/test-1.0.x",
+		ContactName:  "ONF",
+		LicenseName:  "Apache-2.0",
+		Modules: []Module{
+			{Name: "test-module", Revision: "2022-03-04", YangFile: "test.yang"},
+		},
+	}
+	assert.NoError(t, ValidateMetaData(path, md))
+
+	md.Version = "one"
+	md.GoPackage = "models/test"
+	md.Modules = []Module{
+		{Name: "other-module", Revision: "2021-02-01", YangFile: "test.yang"},
+		{Name: "missing", Revision: "04-03-2022", YangFile: "missing.yang"},
+	}
+	err := ValidateMetaData(path, md)
+	assert.Equal(t, []string{"version", "goPackage", "modules[0].name", "modules[0].revision", "modules[1].revision", "modules[1].file"},
+		validationFields(t, err))
+	assert.Contains(t, err.Error(), "modules[0].revision: '2021-02-01' does not match the latest revision '2022-03-04' of test.yang (use 2022-03-04)")
+}
+
+func TestCheckImportPath(t *testing.T) {
+	assert.NoError(t, checkImportPath("github.com/onosproject/config-models/models/devicesim-1.0.x"))
+	assert.Error(t, checkImportPath("devicesim"))
+	assert.Error(t, checkImportPath("github.com//devicesim"))
+	assert.Error(t, checkImportPath("github.com/onosproject/../devicesim"))
+	assert.Error(t, checkImportPath("github.com/onos project"))
+}
+
+// yamlFields lists the yaml names of the fields of a struct
+func yamlFields(v interface{}) []string {
+	typ := reflect.TypeOf(v)
+	fields := make([]string, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		fields = append(fields, typ.Field(i).Tag.Get("yaml"))
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+type schemaObject struct {
+	Properties map[string]struct {
+		Items      *schemaObject `json:"items"`
+		Properties map[string]interface{}
+	} `json:"properties"`
+}
+
+func keys(m interface{}) []string {
+	names := make([]string, 0)
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		names = append(names, k.String())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// The published JSON schema must stay in sync with the MetaData struct
+func TestMetaDataSchema(t *testing.T) {
+	schema := &schemaObject{}
+	assert.NoError(t, json.Unmarshal(MetaDataSchema, schema))
+	assert.Equal(t, yamlFields(MetaData{}), keys(schema.Properties))
+	assert.Equal(t, yamlFields(Module{}), keys(schema.Properties["modules"].Items.Properties))
+	assert.Equal(t, yamlFields(GoOptions{}), keys(schema.Properties["goOptions"].Properties))
 }
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+	openapi_gen "github.com/onosproject/config-models/pkg/openapi-gen"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+const openapiLicense = `# SPDX-FileCopyrightText: 2022-present Intel Corporation
+# SPDX-FileCopyrightText: 2021-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+`
+
+// renderOpenApi builds the OpenAPI specs of the model from the schema tree of
+// its freshly generated Go bindings, as the generated openapi-gen tool would
+func (c *ModelCompiler) renderOpenApi() ([]byte, error) {
+	if c.schemaTree == nil {
+		return nil, fmt.Errorf("the Go bindings must be generated before the OpenApi specs")
+	}
+
+	settings := openapi_gen.ApiGenSettings{
+		ModelType:    c.dictionary.Name,
+		ModelVersion: c.dictionary.Version,
+		Title:        fmt.Sprintf("%s-%s", c.dictionary.Name, c.dictionary.Version),
+		TargetAlias:  c.dictionary.OpenAPITargetAlias,
+		Contact: &openapi3.Contact{
+			Name:  c.dictionary.ContactName,
+			URL:   c.dictionary.ContactUrl,
+			Email: c.dictionary.ContactEmail,
+		},
+		License: &openapi3.License{
+			Name: c.dictionary.LicenseName,
+			URL:  c.dictionary.LicenseUrl,
+		},
+	}
+
+	schema, err := openapi_gen.BuildOpenapi(&ytypes.Schema{SchemaTree: c.schemaTree}, &settings)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := yaml.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(openapiLicense), spec...), nil
+}
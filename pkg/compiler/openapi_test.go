@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestRenderOpenApi(t *testing.T) {
+	c := NewCompiler()
+	assert.NoError(t, c.loadModelMetaData(testDevicePath))
+	assert.True(t, c.metaData.GenOpenAPI)
+	c.createDictionary()
+
+	_, err := c.renderOpenApi()
+	assert.Error(t, err)
+
+	_, err = c.renderGolangBindings(testDevicePath)
+	assert.NoError(t, err)
+	spec, err := c.renderOpenApi()
+	assert.NoError(t, err)
+
+	committed, err := ioutil.ReadFile(filepath.Join(testDevicePath, "openapi.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(committed), string(spec))
+}
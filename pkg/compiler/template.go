@@ -5,16 +5,21 @@
 package compiler
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/onosproject/config-models/templates"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"io/fs"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
 )
 
-func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
+// applyTemplate renders a template of the model at path in to outPath
+func (c *ModelCompiler) applyTemplate(path, name, outPath string) error {
 	var funcs template.FuncMap = map[string]interface{}{
 		"quote": func(value interface{}) string {
 			return fmt.Sprintf("\"%s\"", value)
@@ -33,24 +38,55 @@ func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
 		},
 	}
 
+	text, err := c.readTemplate(path, name)
+	if err != nil {
+		return err
+	}
 	tpl, err := template.New(name).
 		Funcs(funcs).
-		ParseFiles(tplPath)
+		Parse(string(text))
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Create(outPath)
-	if err != nil {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, c.dictionary); err != nil {
 		return err
 	}
-	defer file.Close()
+	return c.writeFile(outPath, buf.Bytes(), 0644)
+}
 
-	return tpl.Execute(file, c.dictionary)
+// readTemplate reads a template, giving precedence to the one in the templates
+// directory of the model at path, then to the one in the templates directory of
+// the compiler, if set, over the template embedded in the compiler
+func (c *ModelCompiler) readTemplate(path, name string) ([]byte, error) {
+	dirs := []string{filepath.Join(path, templatesDir)}
+	if c.templatesDir != "" {
+		dirs = append(dirs, c.templatesDir)
+	}
+	for _, dir := range dirs {
+		text, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			log.Infof("Using template '%s'", filepath.Join(dir, name))
+			return text, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return fs.ReadFile(templates.Templates, name)
 }
 
-func (c *ModelCompiler) getTemplatePath(name string) string {
-	return filepath.Join("templates", name)
+// writeFile writes a generated artifact, creating its directory if needed.
+// In dry-run mode the artifact is only kept in memory.
+func (c *ModelCompiler) writeFile(file string, content []byte, perm os.FileMode) error {
+	if c.dryRun {
+		c.artifacts = append(c.artifacts, artifact{path: file, content: content})
+		return nil
+	}
+	c.createDir(filepath.Dir(file))
+	c.stageFiles = append(c.stageFiles, file)
+	return ioutil.WriteFile(file, content, perm)
 }
 
 func (c *ModelCompiler) createDir(dir string) {
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/onosproject/config-models/templates"
+	"github.com/stretchr/testify/assert"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadTemplate(t *testing.T) {
+	embedded, err := fs.ReadFile(templates.Templates, dockerfileTemplate)
+	assert.NoError(t, err)
+
+	modelDir := t.TempDir()
+	compilerDir := t.TempDir()
+
+	// Embedded templates are used by default
+	text, err := NewCompiler().readTemplate(modelDir, dockerfileTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, embedded, text)
+
+	// The compiler's templates directory overrides the embedded templates
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(compilerDir, dockerfileTemplate), []byte("compiler"), 0644))
+	c := NewCompiler(WithTemplatesDir(compilerDir))
+	text, err = c.readTemplate(modelDir, dockerfileTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, "compiler", string(text))
+
+	// Templates missing from the templates directory fall back to the embedded ones
+	text, err = c.readTemplate(modelDir, makefileTemplate)
+	assert.NoError(t, err)
+	assert.Contains(t, string(text), "build-openapi")
+
+	// The model's own templates override both
+	assert.NoError(t, os.Mkdir(filepath.Join(modelDir, templatesDir), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(modelDir, templatesDir, dockerfileTemplate), []byte("model"), 0644))
+	text, err = c.readTemplate(modelDir, dockerfileTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, "model", string(text))
+
+	_, err = c.readTemplate(modelDir, "missing.tpl")
+	assert.Error(t, err)
+}
+
+// The generated plugin main must be valid Go and run the plugin library
+func TestMainTemplate(t *testing.T) {
+	path := copyModel(t, testDevicePath)
+	c := NewCompiler(WithConfigModelsDir("../.."))
+	c.dryRun = true
+	assert.NoError(t, c.compile(path))
+
+	mainFile := filepath.Join(path, "plugin", "main.go")
+	for _, a := range c.artifacts {
+		if a.path != mainFile {
+			continue
+		}
+		_, err := parser.ParseFile(token.NewFileSet(), a.path, a.content, 0)
+		assert.NoError(t, err)
+		assert.Contains(t, string(a.content), `"github.com/onosproject/config-models/models/testdevice-1.0.x/api"`)
+		assert.Contains(t, string(a.content), "plugin.Main(plugin.Model{")
+		assert.Contains(t, string(a.content), `Name:         "testdevice",`)
+		return
+	}
+	t.Fatalf("%s was not generated", mainFile)
+}
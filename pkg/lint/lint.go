@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lint checks YANG modules for the issues that were previously
+// reported by `pyang --lint`, using goyang so that no Python toolchain is needed.
+package lint
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Rule identifies the check that produced a Diagnostic
+type Rule string
+
+const (
+	// RuleSyntax is reported when a YANG file cannot be read or parsed
+	RuleSyntax Rule = "syntax"
+	// RuleHyphenatedNames is reported for identifiers that are not lower case and hyphenated
+	RuleHyphenatedNames Rule = "hyphenated-names"
+	// RuleMissingRevision is reported for modules without a revision statement
+	RuleMissingRevision Rule = "missing-revision"
+	// RuleUnresolvedImport is reported for imports and includes that cannot be found
+	RuleUnresolvedImport Rule = "unresolved-import"
+	// RuleUnusedGrouping is reported for groupings that no uses statement refers to
+	RuleUnusedGrouping Rule = "unused-grouping"
+)
+
+// Diagnostic is a single issue found in a YANG file
+type Diagnostic struct {
+	File    string
+	Line    int
+	Column  int
+	Rule    Rule
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", d.File, d.Line, d.Column, d.Message, d.Rule)
+}
+
+// Diagnostics is the list of issues found by Lint. It implements error so that
+// it can be returned directly when the lint fails.
+type Diagnostics []Diagnostic
+
+func (ds Diagnostics) Error() string {
+	lines := make([]string, 0, len(ds))
+	for _, d := range ds {
+		lines = append(lines, d.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// identifier statements whose argument must be hyphenated
+var identifierKeywords = map[string]bool{
+	"module":       true,
+	"submodule":    true,
+	"container":    true,
+	"leaf":         true,
+	"leaf-list":    true,
+	"list":         true,
+	"grouping":     true,
+	"typedef":      true,
+	"identity":     true,
+	"feature":      true,
+	"choice":       true,
+	"case":         true,
+	"rpc":          true,
+	"action":       true,
+	"notification": true,
+	"anydata":      true,
+	"anyxml":       true,
+	"extension":    true,
+}
+
+var hyphenatedName = regexp.MustCompile(`^[a-z][a-z0-9.-]*$`)
+
+// Lint parses the given YANG files, resolving their imports and includes in
+// the search path, and returns the diagnostics found in the given files.
+// Diagnostics are sorted by file, line and column.
+func Lint(searchPath []string, files []string) Diagnostics {
+	ms := yang.NewModules()
+	ms.AddPath(searchPath...)
+
+	diags := make(Diagnostics, 0)
+	roots := make([]*yang.Statement, 0, len(files))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			diags = append(diags, Diagnostic{File: file, Rule: RuleSyntax, Message: err.Error()})
+			continue
+		}
+		stmts, err := yang.Parse(string(data), file)
+		if err != nil {
+			diags = append(diags, syntaxDiagnostic(file, err))
+			continue
+		}
+		if err := ms.Parse(string(data), file); err != nil {
+			diags = append(diags, syntaxDiagnostic(file, err))
+			continue
+		}
+		roots = append(roots, stmts...)
+	}
+
+	for _, root := range roots {
+		diags = append(diags, checkRevision(root)...)
+		diags = append(diags, checkImports(ms, root)...)
+		diags = append(diags, checkHyphenatedNames(root)...)
+	}
+	diags = append(diags, checkUnusedGroupings(ms, roots)...)
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].File != diags[j].File {
+			return diags[i].File < diags[j].File
+		}
+		if diags[i].Line != diags[j].Line {
+			return diags[i].Line < diags[j].Line
+		}
+		return diags[i].Column < diags[j].Column
+	})
+	return diags
+}
+
+func checkRevision(root *yang.Statement) Diagnostics {
+	if root.Keyword != "module" && root.Keyword != "submodule" {
+		return nil
+	}
+	for _, s := range root.SubStatements() {
+		if s.Keyword == "revision" {
+			return nil
+		}
+	}
+	return Diagnostics{newDiagnostic(root, RuleMissingRevision,
+		fmt.Sprintf("%s %q has no revision statement", root.Keyword, root.Argument))}
+}
+
+func checkImports(ms *yang.Modules, root *yang.Statement) Diagnostics {
+	diags := make(Diagnostics, 0)
+	for _, s := range root.SubStatements() {
+		if s.Keyword != "import" && s.Keyword != "include" {
+			continue
+		}
+		if _, ok := ms.Modules[s.Argument]; ok {
+			continue
+		}
+		if _, ok := ms.SubModules[s.Argument]; ok {
+			continue
+		}
+		if err := ms.Read(s.Argument); err != nil {
+			diags = append(diags, newDiagnostic(s, RuleUnresolvedImport,
+				fmt.Sprintf("unable to %s %q: %v", s.Keyword, s.Argument, err)))
+		}
+	}
+	return diags
+}
+
+func checkHyphenatedNames(stmt *yang.Statement) Diagnostics {
+	diags := make(Diagnostics, 0)
+	if identifierKeywords[stmt.Keyword] && !hyphenatedName.MatchString(stmt.Argument) {
+		diags = append(diags, newDiagnostic(stmt, RuleHyphenatedNames,
+			fmt.Sprintf("%s name %q should be lower case with hyphens", stmt.Keyword, stmt.Argument)))
+	}
+	for _, s := range stmt.SubStatements() {
+		diags = append(diags, checkHyphenatedNames(s)...)
+	}
+	return diags
+}
+
+// checkUnusedGroupings reports groupings in the linted files that are not
+// referenced by any uses statement of the modules that have been loaded.
+// As in pyang, only the groupings nested in other statements are checked: the
+// top-level ones may be there for other modules to use
+func checkUnusedGroupings(ms *yang.Modules, roots []*yang.Statement) Diagnostics {
+	used := make(map[string]bool)
+	for _, modules := range []map[string]*yang.Module{ms.Modules, ms.SubModules} {
+		for _, m := range modules {
+			collectUses(m.Statement(), prefixMap(m.Statement()), used)
+		}
+	}
+
+	diags := make(Diagnostics, 0)
+	for _, root := range roots {
+		module := owningModule(root)
+		for _, top := range root.SubStatements() {
+			for _, nested := range top.SubStatements() {
+				walkStatements(nested, func(s *yang.Statement) {
+					if s.Keyword == "grouping" && !used[module+":"+s.Argument] {
+						diags = append(diags, newDiagnostic(s, RuleUnusedGrouping,
+							fmt.Sprintf("grouping %q is not used", s.Argument)))
+					}
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func collectUses(root *yang.Statement, prefixes map[string]string, used map[string]bool) {
+	module := owningModule(root)
+	walkStatements(root, func(s *yang.Statement) {
+		if s.Keyword != "uses" {
+			return
+		}
+		name := s.Argument
+		target := module
+		if idx := strings.Index(name, ":"); idx > 0 {
+			if m, ok := prefixes[name[:idx]]; ok {
+				target = m
+			}
+			name = name[idx+1:]
+		}
+		used[target+":"+name] = true
+	})
+}
+
+// prefixMap maps the prefixes visible in a module to module names
+func prefixMap(root *yang.Statement) map[string]string {
+	prefixes := make(map[string]string)
+	module := owningModule(root)
+	for _, s := range root.SubStatements() {
+		switch s.Keyword {
+		case "prefix":
+			prefixes[s.Argument] = module
+		case "belongs-to", "import":
+			for _, sub := range s.SubStatements() {
+				if sub.Keyword == "prefix" {
+					prefixes[sub.Argument] = s.Argument
+				}
+			}
+		}
+	}
+	return prefixes
+}
+
+// owningModule is the module name in which a module's or submodule's definitions live
+func owningModule(root *yang.Statement) string {
+	if root.Keyword == "submodule" {
+		for _, s := range root.SubStatements() {
+			if s.Keyword == "belongs-to" {
+				return s.Argument
+			}
+		}
+	}
+	return root.Argument
+}
+
+func walkStatements(stmt *yang.Statement, fn func(*yang.Statement)) {
+	fn(stmt)
+	for _, s := range stmt.SubStatements() {
+		walkStatements(s, fn)
+	}
+}
+
+func newDiagnostic(stmt *yang.Statement, rule Rule, message string) Diagnostic {
+	file, line, col := splitLocation(stmt.Location())
+	return Diagnostic{File: file, Line: line, Column: col, Rule: rule, Message: message}
+}
+
+// syntaxDiagnostic converts a goyang parse error of the form "file:line:col: message"
+func syntaxDiagnostic(file string, err error) Diagnostic {
+	msg := err.Error()
+	parts := strings.SplitN(msg, ": ", 2)
+	if len(parts) == 2 {
+		if f, line, col := splitLocation(parts[0]); line > 0 {
+			return Diagnostic{File: f, Line: line, Column: col, Rule: RuleSyntax, Message: parts[1]}
+		}
+	}
+	return Diagnostic{File: file, Rule: RuleSyntax, Message: msg}
+}
+
+// splitLocation splits a goyang location "file:line:col" in to its parts
+func splitLocation(location string) (string, int, int) {
+	parts := strings.Split(location, ":")
+	if len(parts) < 3 {
+		return location, 0, 0
+	}
+	col, colErr := strconv.Atoi(parts[len(parts)-1])
+	line, lineErr := strconv.Atoi(parts[len(parts)-2])
+	if colErr != nil || lineErr != nil {
+		return location, 0, 0
+	}
+	return strings.Join(parts[:len(parts)-2], ":"), line, col
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLint_Clean(t *testing.T) {
+	diags := Lint([]string{"testdata"}, []string{"testdata/lint-clean@2022-01-01.yang"})
+	assert.Empty(t, diags, diags.Error())
+}
+
+func TestLint_Broken(t *testing.T) {
+	diags := Lint([]string{"testdata"}, []string{"testdata/lint-broken.yang"})
+	assert.Equal(t, []Diagnostic{
+		{File: "testdata/lint-broken.yang", Line: 1, Column: 1, Rule: RuleMissingRevision,
+			Message: `module "lint-broken" has no revision statement`},
+		{File: "testdata/lint-broken.yang", Line: 5, Column: 5, Rule: RuleUnresolvedImport,
+			Message: `unable to import "lint-missing": no such file: lint-missing.yang`},
+		{File: "testdata/lint-broken.yang", Line: 20, Column: 5, Rule: RuleHyphenatedNames,
+			Message: `container name "topContainer" should be lower case with hyphens`},
+		{File: "testdata/lint-broken.yang", Line: 21, Column: 9, Rule: RuleUnusedGrouping,
+			Message: `grouping "unused-nested" is not used`},
+		{File: "testdata/lint-broken.yang", Line: 28, Column: 9, Rule: RuleHyphenatedNames,
+			Message: `leaf name "leaf_with_underscore" should be lower case with hyphens`},
+	}, []Diagnostic(diags))
+}
+
+func TestLint_Syntax(t *testing.T) {
+	diags := Lint(nil, []string{"testdata/does-not-exist.yang"})
+	assert.Len(t, diags, 1)
+	assert.Equal(t, RuleSyntax, diags[0].Rule)
+	assert.Equal(t, "testdata/does-not-exist.yang", diags[0].File)
+}
+
+func Test_splitLocation(t *testing.T) {
+	file, line, col := splitLocation("yang/test.yang:12:3")
+	assert.Equal(t, "yang/test.yang", file)
+	assert.Equal(t, 12, line)
+	assert.Equal(t, 3, col)
+
+	file, line, col = splitLocation("unknown")
+	assert.Equal(t, "unknown", file)
+	assert.Equal(t, 0, line)
+	assert.Equal(t, 0, col)
+}
@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 )
 
@@ -27,9 +28,10 @@ const (
 	LeafRefOption                  = "LeafRefOption"
 )
 
-var swagger openapi3.Swagger
-
 var respGet200Desc = "GET OK 200"
+
+// buildMu serialises the builds, that share the path prefix and target parameter
+var buildMu sync.Mutex
 var pathPrefix string
 var targetParameter *openapi3.ParameterRef
 
@@ -89,6 +91,8 @@ func (settings *ApiGenSettings) ApplyDefaults() {
 func BuildOpenapi(yangSchema *ytypes.Schema, settings *ApiGenSettings) (*openapi3.Swagger, error) {
 	settings.ApplyDefaults()
 
+	buildMu.Lock()
+	defer buildMu.Unlock()
 	pathPrefix = fmt.Sprintf("/%s/v%s/{%s}", strings.ToLower(settings.ModelType), settings.ModelVersion, settings.TargetAlias)
 	targetParameter = targetParam(settings.TargetAlias)
 
@@ -141,7 +145,7 @@ func BuildOpenapi(yangSchema *ytypes.Schema, settings *ApiGenSettings) (*openapi
 		addLeafRefSchema(components)
 	}
 
-	swagger = openapi3.Swagger{
+	swagger := openapi3.Swagger{
 		OpenAPI: "3.0.0",
 		Info: &openapi3.Info{
 			Title:       settings.Title,
@@ -232,6 +236,213 @@ func addAdditionalProperties(schemaVal *openapi3.Schema, name string) {
 
 }
 
+// stringSchema is the schema of a string type, with its length and pattern
+func stringSchema(yangType *yang.YangType) (*openapi3.Schema, error) {
+	schemaVal := openapi3.NewStringSchema()
+	if yangType.Length != nil {
+		min, max, err := yangRange(yangType.Length, yangType.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if min != nil {
+			schemaVal.MinLength = uint64(*min)
+		}
+		if max != nil {
+			v := uint64(*max)
+			schemaVal.MaxLength = &v
+		}
+	}
+	if yangType.Pattern != nil && len(yangType.Pattern) > 0 {
+		// All we can do is take the first one
+		schemaVal.Pattern = yangType.Pattern[0]
+	}
+	return schemaVal, nil
+}
+
+// enumSchema is the schema of an identityref or enumeration type, with its values
+func enumSchema(yangType *yang.YangType) *openapi3.Schema {
+	schemaVal := openapi3.NewStringSchema()
+	if yangType.IdentityBase != nil {
+		schemaVal.Enum = make([]interface{}, 0)
+		for _, val := range yangType.IdentityBase.Values {
+			schemaVal.Enum = append(schemaVal.Enum, val.Name)
+		}
+		sort.Slice(schemaVal.Enum, func(i, j int) bool {
+			return schemaVal.Enum[i].(string) < schemaVal.Enum[j].(string)
+		})
+	} else if yangType.Enum != nil {
+		schemaVal.Enum = make([]interface{}, 0)
+		for _, e := range yangType.Enum.Names() {
+			schemaVal.Enum = append(schemaVal.Enum, e)
+		}
+	}
+	return schemaVal
+}
+
+// numberSchema is the schema of an integer or decimal64 type, with its range.
+// In a union the 64 bit integers and the decimals are strings, as RFC 7951
+// encodes them, so that the numbers of the other members do not match them.
+func numberSchema(yangType *yang.YangType, inUnion bool) (*openapi3.Schema, error) {
+	if inUnion {
+		switch yangType.Kind {
+		case yang.Yint64:
+			return openapi3.NewStringSchema().WithPattern(`^-?[0-9]+$`), nil
+		case yang.Yuint64:
+			return openapi3.NewStringSchema().WithPattern(`^[0-9]+$`), nil
+		case yang.Ydecimal64:
+			return openapi3.NewStringSchema().WithPattern(`^-?[0-9]+(\.[0-9]+)?$`), nil
+		}
+	}
+	var schemaVal *openapi3.Schema
+	switch yangType.Kind {
+	case yang.Yuint32, yang.Yint32:
+		schemaVal = openapi3.NewInt32Schema()
+	case yang.Yuint64, yang.Yint64:
+		schemaVal = openapi3.NewInt64Schema()
+	case yang.Ydecimal64:
+		schemaVal = openapi3.NewFloat64Schema()
+	default:
+		schemaVal = openapi3.NewIntegerSchema()
+	}
+	if yangType.Range != nil {
+		start, end, err := yangRange(yangType.Range, yangType.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if start != nil {
+			startFloat := float64(*start)
+			schemaVal.Min = &startFloat
+		}
+		if end != nil {
+			endFloat := (float64)(*end)
+			schemaVal.Max = &endFloat
+		}
+	}
+	return schemaVal, nil
+}
+
+// bytesSchema is the schema of a binary type, with its length
+func bytesSchema(yangType *yang.YangType) (*openapi3.Schema, error) {
+	schemaVal := openapi3.NewBytesSchema()
+	if yangType.Length != nil {
+		min, max, err := yangRange(yangType.Length, yangType.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if min != nil {
+			schemaVal.MinLength = uint64(*min)
+		}
+		if max != nil {
+			v := uint64(*max)
+			schemaVal.MaxLength = &v
+		}
+	}
+	return schemaVal, nil
+}
+
+func emptySchema() *openapi3.Schema {
+	schemaVal := openapi3.NewStringSchema()
+	var emptylen uint64 = 0
+	schemaVal.MaxLength = &emptylen
+	return schemaVal
+}
+
+// unionSchema is the oneOf schema of a union. The members may overlap, e.g. a
+// string and a pattern, so each member excludes the members before it of the
+// same JSON type: a value is of the first member type that it matches, as in
+// RFC 7950 section 9.12
+func unionSchema(yangType *yang.YangType) (*openapi3.Schema, error) {
+	members, err := unionMemberSchemas(yangType)
+	if err != nil {
+		return nil, err
+	}
+	exclusive := make([]*openapi3.Schema, 0, len(members))
+	for i, member := range members {
+		earlier := make([]*openapi3.Schema, 0, i)
+		for _, e := range members[:i] {
+			if e.Type == member.Type {
+				earlier = append(earlier, e)
+			}
+		}
+		if len(earlier) == 0 {
+			exclusive = append(exclusive, member)
+			continue
+		}
+		excluded := *member
+		if len(member.Enum) > 0 {
+			// The values of an enumeration that an earlier member matches are
+			// left out instead, and the member too if none are left
+			excluded.Enum = make([]interface{}, 0, len(member.Enum))
+			for _, value := range member.Enum {
+				if !matchesAny(earlier, value) {
+					excluded.Enum = append(excluded.Enum, value)
+				}
+			}
+			if len(excluded.Enum) > 0 {
+				exclusive = append(exclusive, &excluded)
+			}
+			continue
+		}
+		if len(earlier) == 1 {
+			excluded.Not = openapi3.NewSchemaRef("", earlier[0])
+		} else {
+			excluded.Not = openapi3.NewSchemaRef("", openapi3.NewAnyOfSchema(earlier...))
+		}
+		exclusive = append(exclusive, &excluded)
+	}
+	return openapi3.NewOneOfSchema(exclusive...), nil
+}
+
+// matchesAny is whether any of the schemas matches the value
+func matchesAny(schemas []*openapi3.Schema, value interface{}) bool {
+	for _, schema := range schemas {
+		if schema.VisitJSON(value) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// unionMemberSchemas are the schemas of the member types of a union; the
+// members of the unions in the union are flattened
+func unionMemberSchemas(yangType *yang.YangType) ([]*openapi3.Schema, error) {
+	members := make([]*openapi3.Schema, 0, len(yangType.Type))
+	for _, member := range yangType.Type {
+		var memberSchema *openapi3.Schema
+		var err error
+		switch member.Kind {
+		case yang.Yunion:
+			nested, err := unionMemberSchemas(member)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, nested...)
+			continue
+		case yang.Ystring:
+			memberSchema, err = stringSchema(member)
+		case yang.Yidentityref, yang.Yenum:
+			memberSchema = enumSchema(member)
+		case yang.Ybool:
+			memberSchema = openapi3.NewBoolSchema()
+		case yang.Yuint8, yang.Yuint16, yang.Yint8, yang.Yint16, yang.Yuint32, yang.Yint32, yang.Yuint64, yang.Yint64, yang.Ydecimal64:
+			memberSchema, err = numberSchema(member, true)
+		case yang.Ybinary:
+			memberSchema, err = bytesSchema(member)
+		case yang.Yempty:
+			memberSchema = emptySchema()
+		case yang.Yleafref, yang.YinstanceIdentifier:
+			memberSchema = openapi3.NewStringSchema()
+		default:
+			return nil, fmt.Errorf("unhandled union member %v %s", member.Kind, member.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, memberSchema)
+	}
+	return members, nil
+}
+
 // buildSchema is a recursive function to extract a list of read only paths from a YGOT schema
 func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath string, targetAlias string, hasLeafref *bool) (openapi3.Paths, *openapi3.Components, error) {
 	openapiPaths := make(openapi3.Paths)
@@ -247,29 +458,18 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			var schemaVal *openapi3.Schema
 			switch dirEntry.Type.Kind {
 			case yang.Ystring:
-				schemaVal = openapi3.NewStringSchema()
-				if dirEntry.Type.Length != nil {
-					min, max, err := yangRange(dirEntry.Type.Length, dirEntry.Type.Kind)
-					if err != nil {
-						return nil, nil, err
-					}
-					if min != nil {
-						schemaVal.MinLength = uint64(*min)
-					}
-					if max != nil {
-						v := uint64(*max)
-						schemaVal.MaxLength = &v
-					}
-				}
-				if dirEntry.Type.Pattern != nil && len(dirEntry.Type.Pattern) > 0 {
-					// All we can do is take the first one
-					schemaVal.Pattern = dirEntry.Type.Pattern[0]
+				var err error
+				if schemaVal, err = stringSchema(dirEntry.Type); err != nil {
+					return nil, nil, err
 				}
 				if dirEntry.Type.Default != "" {
 					schemaVal.Default = dirEntry.Type.Default
 				}
 			case yang.Yunion:
-				schemaVal = openapi3.NewStringSchema()
+				var err error
+				if schemaVal, err = unionSchema(dirEntry.Type); err != nil {
+					return nil, nil, err
+				}
 				if dirEntry.Type.Default != "" {
 					schemaVal.Default = dirEntry.Type.Default
 				}
@@ -327,21 +527,7 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 				schemaVal.Extensions["x-leafref-resolver"] = leafrefPath
 
 			case yang.Yidentityref, yang.Yenum:
-				schemaVal = openapi3.NewStringSchema()
-				if dirEntry.Type.IdentityBase != nil {
-					schemaVal.Enum = make([]interface{}, 0)
-					for _, val := range dirEntry.Type.IdentityBase.Values {
-						schemaVal.Enum = append(schemaVal.Enum, val.Name)
-					}
-					sort.Slice(schemaVal.Enum, func(i, j int) bool {
-						return schemaVal.Enum[i].(string) < schemaVal.Enum[j].(string)
-					})
-				} else if dirEntry.Type.Enum != nil {
-					schemaVal.Enum = make([]interface{}, 0)
-					for _, e := range dirEntry.Type.Enum.Names() {
-						schemaVal.Enum = append(schemaVal.Enum, e)
-					}
-				}
+				schemaVal = enumSchema(dirEntry.Type)
 			case yang.Ybool:
 				schemaVal = openapi3.NewBoolSchema()
 				// default is now a []string - since this is not a leaf-list there will only be 1 entry
@@ -355,57 +541,25 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 					}
 				}
 			case yang.Yuint8, yang.Yuint16, yang.Yint8, yang.Yint16, yang.Yuint32, yang.Yint32, yang.Yuint64, yang.Yint64, yang.Ydecimal64:
-				switch dirEntry.Type.Kind {
-				case yang.Yuint32, yang.Yint32:
-					schemaVal = openapi3.NewInt32Schema()
-				case yang.Yuint64, yang.Yint64:
-					schemaVal = openapi3.NewInt64Schema()
-				case yang.Ydecimal64:
-					schemaVal = openapi3.NewFloat64Schema()
-				default:
-					schemaVal = openapi3.NewIntegerSchema()
+				var err error
+				if schemaVal, err = numberSchema(dirEntry.Type, false); err != nil {
+					return nil, nil, err
 				}
 				def, err := yangDefault(dirEntry)
 				if err != nil {
 					return nil, nil, err
 				}
 				schemaVal.Default = def
-				if dirEntry.Type.Range != nil {
-					start, end, err := yangRange(dirEntry.Type.Range, dirEntry.Type.Kind)
-					if err != nil {
-						return nil, nil, err
-					}
-					if start != nil {
-						startFloat := float64(*start)
-						schemaVal.Min = &startFloat
-					}
-					if end != nil {
-						endFloat := (float64)(*end)
-						schemaVal.Max = &endFloat
-					}
-				}
 			case yang.Ybinary:
-				schemaVal = openapi3.NewBytesSchema()
-				if dirEntry.Type.Length != nil {
-					min, max, err := yangRange(dirEntry.Type.Length, dirEntry.Type.Kind)
-					if err != nil {
-						return nil, nil, err
-					}
-					if min != nil {
-						schemaVal.MinLength = uint64(*min)
-					}
-					if max != nil {
-						v := uint64(*max)
-						schemaVal.MaxLength = &v
-					}
+				var err error
+				if schemaVal, err = bytesSchema(dirEntry.Type); err != nil {
+					return nil, nil, err
 				}
 				if dirEntry.Type.Default != "" {
 					schemaVal.Default = dirEntry.Type.Default
 				}
 			case yang.Yempty:
-				schemaVal = openapi3.NewStringSchema()
-				var emptylen uint64 = 0
-				schemaVal.MaxLength = &emptylen
+				schemaVal = emptySchema()
 			default:
 				return nil, nil, fmt.Errorf("unhandled leaf %v %s", dirEntry.Type.Kind, dirEntry.Type.Name)
 			}
@@ -513,7 +667,7 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 						}
 					}
 					openapiComponents.Schemas[k] = v
-				case "string", "boolean", "integer", "number": // leaf as a child of list
+				case "string", "boolean", "integer", "number", "": // leaf as a child of list; unions have an anyOf instead of a type
 					if v.Value.Required != nil {
 						schemaVal.Required = append(schemaVal.Required, v.Value.Required...)
 						sort.Strings(schemaVal.Required)
@@ -687,7 +841,7 @@ func buildSchema(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 						}
 					}
 					openapiComponents.Schemas[k] = v
-				case "string", "boolean", "integer", "number": // leaf as a child of list
+				case "string", "boolean", "integer", "number", "": // leaf as a child of list; unions have an anyOf instead of a type
 					if v.Value.Required != nil {
 						asSingle.Required = append(asSingle.Required, v.Value.Required...)
 						sort.Strings(asSingle.Required)
@@ -344,6 +344,135 @@ func Test_buildSchemaIntegerLeaf(t *testing.T) {
 	assert.Equal(t, uint64(30), *s.Value.MaxLength)
 }
 
+func Test_buildSchemaUnionLeaf(t *testing.T) {
+
+	targetParameter = targetParam("targettest")
+
+	testLeaf1 := yang.Entry{
+		Name:        "Leaf1",
+		Description: "Leaf1 Description",
+		Config:      yang.TSTrue,
+		Type: &yang.YangType{
+			Kind: yang.Yunion,
+			Type: []*yang.YangType{
+				{
+					Kind: yang.Yuint32,
+					Range: []yang.YRange{
+						{
+							Min: yang.Number{Value: 1},
+							Max: yang.Number{Value: 100},
+						},
+					},
+				},
+				{
+					Kind: yang.Yunion,
+					Type: []*yang.YangType{
+						{Kind: yang.Ybool},
+						{Kind: yang.Yint64},
+						{Kind: yang.Ystring},
+					},
+				},
+			},
+		},
+	}
+
+	testDirEntry := yang.Entry{
+		Name:   "Test1",
+		Parent: &yang.Entry{},
+		Kind:   yang.DirectoryEntry,
+		Config: yang.TSTrue,
+		Dir:    make(map[string]*yang.Entry),
+		Type: &yang.YangType{
+			Name: "Test1",
+		},
+	}
+	testLeaf1.Parent = &testDirEntry
+	testDirEntry.Dir["leaf1"] = &testLeaf1
+
+	hasLeafref := false
+	paths, components, err := buildSchema(&testDirEntry, yang.TSUnset, "/test", "targettest", &hasLeafref)
+	assert.NilError(t, err)
+	assert.Equal(t, len(paths), 0)
+	assert.Equal(t, len(components.Schemas), 1)
+	s, ok := components.Schemas["Test_Leaf1"]
+	assert.Assert(t, ok, "expecting Test_Leaf1")
+	assert.Equal(t, "Leaf1", s.Value.Title)
+	assert.Equal(t, "Leaf1 Description", s.Value.Description)
+	assert.Equal(t, "", s.Value.Type)
+	assert.Equal(t, 0, len(s.Value.AnyOf))
+	assert.Equal(t, 4, len(s.Value.OneOf))
+	assert.Equal(t, "integer", s.Value.OneOf[0].Value.Type)
+	assert.Equal(t, 1.0, *s.Value.OneOf[0].Value.Min)
+	assert.Equal(t, 100.0, *s.Value.OneOf[0].Value.Max)
+	assert.Equal(t, "boolean", s.Value.OneOf[1].Value.Type)
+	// The 64 bit integers of a union are strings, as in RFC 7951
+	assert.Equal(t, "string", s.Value.OneOf[2].Value.Type)
+	assert.Equal(t, "^-?[0-9]+$", s.Value.OneOf[2].Value.Pattern)
+	assert.Assert(t, s.Value.OneOf[2].Value.Not == nil)
+	// The string overlaps the 64 bit integer before it, so it excludes it
+	assert.Equal(t, "string", s.Value.OneOf[3].Value.Type)
+	assert.Equal(t, "^-?[0-9]+$", s.Value.OneOf[3].Value.Not.Value.Pattern)
+	assert.NilError(t, s.Value.VisitJSON("42"))
+	assert.NilError(t, s.Value.VisitJSON("forty two"))
+	assert.NilError(t, s.Value.VisitJSON(float64(50)))
+	assert.NilError(t, s.Value.VisitJSON(true))
+	assert.Assert(t, s.Value.VisitJSON(float64(500)) != nil)
+}
+
+func Test_buildSchemaUnionOverlap(t *testing.T) {
+
+	targetParameter = targetParam("targettest")
+
+	testLeaf1 := yang.Entry{
+		Name:   "Leaf1",
+		Config: yang.TSTrue,
+		Type: &yang.YangType{
+			Kind: yang.Yunion,
+			Type: []*yang.YangType{
+				{Kind: yang.Ystring, Pattern: []string{"^[a-z]+$"}},
+				{Kind: yang.Yenum, Enum: yang.NewEnumType()},
+				{Kind: yang.Ystring},
+				{Kind: yang.Yuint8},
+				{Kind: yang.Yint16},
+			},
+		},
+	}
+	assert.NilError(t, testLeaf1.Type.Type[1].Enum.Set("up", 1))
+	assert.NilError(t, testLeaf1.Type.Type[1].Enum.Set("DOWN", 2))
+
+	testDirEntry := yang.Entry{
+		Name:   "Test1",
+		Parent: &yang.Entry{},
+		Kind:   yang.DirectoryEntry,
+		Config: yang.TSTrue,
+		Dir:    make(map[string]*yang.Entry),
+		Type: &yang.YangType{
+			Name: "Test1",
+		},
+	}
+	testLeaf1.Parent = &testDirEntry
+	testDirEntry.Dir["leaf1"] = &testLeaf1
+
+	hasLeafref := false
+	_, components, err := buildSchema(&testDirEntry, yang.TSUnset, "/test", "targettest", &hasLeafref)
+	assert.NilError(t, err)
+	s, ok := components.Schemas["Test_Leaf1"]
+	assert.Assert(t, ok, "expecting Test_Leaf1")
+	assert.Equal(t, 5, len(s.Value.OneOf))
+	assert.Assert(t, s.Value.OneOf[0].Value.Not == nil)
+	// The pattern already matches "up"
+	assert.DeepEqual(t, []interface{}{"DOWN"}, s.Value.OneOf[1].Value.Enum)
+	assert.Equal(t, 2, len(s.Value.OneOf[2].Value.Not.Value.AnyOf))
+	assert.Assert(t, s.Value.OneOf[3].Value.Not == nil)
+	assert.Equal(t, "integer", s.Value.OneOf[4].Value.Not.Value.Type)
+	// Each value matches exactly one member: the first one that it matches
+	// in the union
+	assert.NilError(t, s.Value.VisitJSON("up"))
+	assert.NilError(t, s.Value.VisitJSON("DOWN"))
+	assert.NilError(t, s.Value.VisitJSON("Sideways"))
+	assert.NilError(t, s.Value.VisitJSON(float64(7)))
+}
+
 func Test_buildSchemaLeafList(t *testing.T) {
 
 	targetParameter = targetParam("targettest")
@@ -0,0 +1,238 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/goyang/pkg/yang"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EffectivePathValue is a path value of the effective configuration
+type EffectivePathValue struct {
+	*configapi.PathValue
+	// Defaulted is set when the leaf is absent from the JSON, and the value is
+	// the default of the leaf in the model
+	Defaulted bool
+}
+
+// GetEffectivePathValues - like GetPathValues, with the model of the last call
+// to ExtractPaths
+func GetEffectivePathValues(prefixPath string, genericJSON []byte) ([]*EffectivePathValue, error) {
+	return defaultModel.GetEffectivePathValues(prefixPath, genericJSON)
+}
+
+// GetEffectivePathValues - like GetPathValues, plus the default values of the
+// leaves that are absent from the containers and list entries of the JSON,
+// but for the leaves of the choice cases that are not active. The default
+// values are flagged as Defaulted and follow the values of the JSON.
+func (m *PathModel) GetEffectivePathValues(prefixPath string, genericJSON []byte) ([]*EffectivePathValue, error) {
+	pathValues, err := m.GetPathValues(prefixPath, genericJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveValues := make([]*EffectivePathValue, 0, len(pathValues))
+	present := make(map[string]bool)
+	parents := make(map[string]bool)
+	for _, pathValue := range pathValues {
+		effectiveValues = append(effectiveValues, &EffectivePathValue{PathValue: pathValue})
+		present[pathValue.Path] = true
+		for parent := parentOfPath(pathValue.Path); !parents[parent]; parent = parentOfPath(parent) {
+			parents[parent] = true
+			if parent == "" {
+				break
+			}
+		}
+	}
+
+	// Only the containers and list entries at or below the prefix get defaults
+	prefix := stripNamespace(removePathIndices(removeIndexNames(prefixPath)))
+	if prefix == slash {
+		prefix = ""
+	}
+	sortedParents := make([]string, 0, len(parents))
+	for parent := range parents {
+		parentNoIndices := stripNamespace(removePathIndices(parent))
+		if parentNoIndices == prefix || strings.HasPrefix(parentNoIndices, prefix+slash) {
+			sortedParents = append(sortedParents, parent)
+		}
+	}
+	sort.Strings(sortedParents)
+
+	// The names of the child nodes of each parent, for the choice cases
+	children := make(map[string]map[string]bool)
+	for _, paths := range []map[string]bool{present, parents} {
+		for path := range paths {
+			if path == "" {
+				continue
+			}
+			parent := parentOfPath(path)
+			if children[parent] == nil {
+				children[parent] = make(map[string]bool)
+			}
+			children[parent][stripNamespace(removePathIndices(path[len(parent)+1:]))] = true
+		}
+	}
+
+	for _, parent := range sortedParents {
+		for _, rwPath := range m.defaults[stripNamespace(removePathIndices(parent))] {
+			modelParent := parentOfPath(rwPath.Path)
+			leafPath := fmt.Sprintf("%s%s", parent, rwPath.Path[len(modelParent):])
+			if present[leafPath] || !m.inActiveCases(rwPath.Path, children[parent]) {
+				continue
+			}
+			pathValue, err := m.defaultValue(rwPath.Default, rwPath.ValueType, leafPath)
+			if err != nil {
+				return nil, err
+			}
+			effectiveValues = append(effectiveValues, &EffectivePathValue{PathValue: pathValue, Defaulted: true})
+		}
+	}
+	return effectiveValues, nil
+}
+
+// choiceCase is a case of a choice, with the names of the data nodes of each
+// case of the choice
+type choiceCase struct {
+	name        string
+	defaultCase string
+	nodes       map[string][]string
+}
+
+// active reports whether the case is the one of its choice, given the names
+// of the nodes present in the parent of the choice: a node of the case is
+// present or, when no node of the choice is, it is the default case, as in
+// RFC 7950 section 7.9.3
+func (c *choiceCase) active(present map[string]bool) bool {
+	choicePresent := false
+	for name, nodes := range c.nodes {
+		for _, node := range nodes {
+			if present[node] {
+				if name == c.name {
+					return true
+				}
+				choicePresent = true
+			}
+		}
+	}
+	return !choicePresent && c.name == c.defaultCase
+}
+
+// inActiveCases reports whether the leaf of the model path is in no choice
+// case, or in active ones only, and so takes its default
+func (m *PathModel) inActiveCases(leafPath string, present map[string]bool) bool {
+	for _, c := range m.cases[stripNamespace(removePathIndices(leafPath))] {
+		if !c.active(present) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexCases finds the leaves with a default that are in choice cases, and
+// indexes their cases by their path without namespaces and indices
+func (m *PathModel) indexCases(entry *yang.Entry, parentPath string, cases []*choiceCase) {
+	if entry == nil {
+		return
+	}
+	for _, dirEntry := range entry.Dir {
+		switch {
+		case dirEntry.IsChoice():
+			nodes := make(map[string][]string, len(dirEntry.Dir))
+			for name, caseEntry := range dirEntry.Dir {
+				nodes[name] = dataNodes(caseEntry)
+			}
+			defaultCase := ""
+			if len(dirEntry.Default) > 0 {
+				defaultCase = dirEntry.Default[0]
+			}
+			for name, caseEntry := range dirEntry.Dir {
+				c := &choiceCase{name: name, defaultCase: defaultCase, nodes: nodes}
+				m.indexCases(caseEntry, parentPath, append(cases[:len(cases):len(cases)], c))
+			}
+		case dirEntry.IsLeaf():
+			if _, ok := dirEntry.SingleDefaultValue(); ok && len(cases) > 0 {
+				m.cases[fmt.Sprintf("%s/%s", parentPath, dirEntry.Name)] = cases
+			}
+		case !dirEntry.IsLeafList():
+			m.indexCases(dirEntry, fmt.Sprintf("%s/%s", parentPath, dirEntry.Name), nil)
+		}
+	}
+}
+
+// dataNodes returns the names of the data nodes of a case, through the
+// choices in the case
+func dataNodes(entry *yang.Entry) []string {
+	if !entry.IsChoice() && !entry.IsCase() {
+		return []string{entry.Name}
+	}
+	names := make([]string, 0, len(entry.Dir))
+	for _, child := range entry.Dir {
+		names = append(names, dataNodes(child)...)
+	}
+	return names
+}
+
+// defaultValue converts the default of a leaf, as it is written in YANG, in
+// to a path value
+func (m *PathModel) defaultValue(value string, valueType configapi.ValueType, leafPath string) (*configapi.PathValue, error) {
+	if members, isUnion := m.unions[stripNamespace(removePathIndices(leafPath))]; isUnion {
+		typedValue, err := unionDefault(value, valueType, members, leafPath)
+		if err != nil {
+			return nil, fmt.Errorf("error converting default %s of %s %v", value, leafPath, err)
+		}
+		return &configapi.PathValue{Path: leafPath, Value: *typedValue}, nil
+	}
+	var jsonValue interface{} = value
+	if valueType == configapi.ValueType_BOOL {
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("error converting default %s of %s to bool %v", value, leafPath, err)
+		}
+		jsonValue = boolValue
+	}
+	pathValue, err := m.handleAttribute(jsonValue, leafPath)
+	if err != nil {
+		return nil, fmt.Errorf("error converting default %s of %s %v", value, leafPath, err)
+	}
+	pathValue.Path = leafPath
+	return pathValue, nil
+}
+
+// parentOfPath removes the last element of a path, ignoring the slashes in
+// the values of the indices; the parent of a top level node is ""
+func parentOfPath(path string) string {
+	depth := 0
+	for i := len(path) - 1; i >= 0; i-- {
+		switch path[i] {
+		case ']':
+			depth++
+		case '[':
+			depth--
+		case '/':
+			if depth == 0 {
+				return path[:i]
+			}
+		}
+	}
+	return ""
+}
+
+func isLeafListType(valueType configapi.ValueType) bool {
+	switch valueType {
+	case configapi.ValueType_LEAFLIST_STRING, configapi.ValueType_LEAFLIST_INT,
+		configapi.ValueType_LEAFLIST_UINT, configapi.ValueType_LEAFLIST_BOOL,
+		configapi.ValueType_LEAFLIST_DECIMAL, configapi.ValueType_LEAFLIST_FLOAT,
+		configapi.ValueType_LEAFLIST_DOUBLE, configapi.ValueType_LEAFLIST_BYTES:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ModelOption is an option of NewPathModel
+type ModelOption func(m *PathModel)
+
+// WithEnumTypes adds the values of the enumerations and identities of the
+// Go bindings of the model, e.g. the ΛEnumTypes of a YGOT generated package,
+// to the leaves whose values are not in the schema. The schema of the bindings
+// has no values of the identities, and older ones none of the enumerations.
+// The enumerated members of a union get the values of all the types of its
+// leaf.
+func WithEnumTypes(enumTypes map[string][]reflect.Type) ModelOption {
+	return func(m *PathModel) {
+		for schemaPath, types := range enumTypes {
+			enums := make([]*enumValues, 0)
+			if enum, ok := m.enums[schemaPath]; ok {
+				enums = append(enums, enum)
+			}
+			for _, member := range m.unions[schemaPath] {
+				if member.enum != nil {
+					enums = append(enums, member.enum)
+				}
+			}
+			for _, enum := range enums {
+				if len(enum.values) > 0 {
+					continue
+				}
+				for _, t := range types {
+					goEnum, ok := reflect.Zero(t).Interface().(ygot.GoEnum)
+					if !ok {
+						continue
+					}
+					for value, def := range goEnum.ΛMap()[t.Name()] {
+						enum.values[value] = def.Name
+					}
+				}
+				enum.sortNames()
+			}
+		}
+	}
+}
+
+// enumValues are the values of an enumeration or identityref leaf; they are
+// unknown when there are none
+type enumValues struct {
+	identity bool
+	// values maps the numbers of an enumeration to its names; the identities
+	// are numbered from 1 in the order of their names, as in YGOT
+	values map[int64]string
+	// names are the names of the values, in the order of their numbers
+	names []string
+	// namesOnly is set when the values may not be given by their numbers
+	namesOnly bool
+}
+
+func (e *enumValues) sortNames() {
+	numbers := make([]int64, 0, len(e.values))
+	for number := range e.values {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+	e.names = make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		e.names = append(e.names, e.values[number])
+	}
+}
+
+// indexTypes finds the enumeration, identityref and union leaves of the
+// schema, and indexes their values and member types by their path without
+// namespaces and indices, e.g. /interfaces/interface/state/admin-status
+func (m *PathModel) indexTypes(entry *yang.Entry, parentPath string) {
+	if entry == nil {
+		return
+	}
+	for _, dirEntry := range entry.Dir {
+		itemPath := parentPath
+		if !dirEntry.IsChoice() && !dirEntry.IsCase() {
+			itemPath = fmt.Sprintf("%s/%s", parentPath, dirEntry.Name)
+		}
+		if !dirEntry.IsLeaf() && !dirEntry.IsLeafList() {
+			m.indexTypes(dirEntry, itemPath)
+			continue
+		}
+		if dirEntry.Type == nil {
+			continue
+		}
+		if dirEntry.Type.Kind == yang.Yunion {
+			m.unions[itemPath] = newUnionMembers(dirEntry.Type)
+		} else if enum := newEnumValues(dirEntry.Type); enum != nil {
+			m.enums[itemPath] = enum
+		}
+	}
+}
+
+// newEnumValues returns the values of an enumeration or identityref type, or
+// nil for the other types
+func newEnumValues(yangType *yang.YangType) *enumValues {
+	var enum *enumValues
+	switch yangType.Kind {
+	case yang.Yenum:
+		enum = &enumValues{values: make(map[int64]string)}
+		if yangType.Enum != nil {
+			enum.values = yangType.Enum.ValueMap()
+		}
+	case yang.Yidentityref:
+		enum = &enumValues{identity: true, values: handleIdentity(yangType)}
+	default:
+		return nil
+	}
+	enum.sortNames()
+	return enum
+}
+
+// handleIdentity - the identities derived from the base of an identityref,
+// which goyang sorts by name
+func handleIdentity(yangType *yang.YangType) map[int64]string {
+	identityMap := make(map[int64]string)
+	if yangType.IdentityBase == nil {
+		return identityMap
+	}
+	for i, val := range yangType.IdentityBase.Values {
+		identityMap[int64(i+1)] = val.Name
+	}
+	return identityMap
+}
+
+// convertEnumIdx canonicalises the value of an enumeration or identityref
+// leaf: the module prefix of an identity is removed, and a number is replaced
+// by its name. A value that is not one of the names is an error, unless the
+// values are unknown.
+func convertEnumIdx(valueTyped string, enum *enumValues, parentPath string) (string, error) {
+	if colonIdx := strings.LastIndex(valueTyped, colon); colonIdx >= 0 && enum.identity {
+		valueTyped = valueTyped[colonIdx+1:]
+	}
+	if len(enum.values) == 0 {
+		return valueTyped, nil
+	}
+	for _, name := range enum.names {
+		if name == valueTyped {
+			return name, nil
+		}
+	}
+	if number, err := strconv.ParseInt(valueTyped, 10, 64); err == nil && !enum.namesOnly {
+		if name, ok := enum.values[number]; ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("value %s for %s does not match any enumerated value [%s]",
+		valueTyped, parentPath, strings.Join(enum.names, ", "))
+}
@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+// testInterfaceType stands for an identity of the Go bindings of devicesim
+type testInterfaceType int64
+
+func (testInterfaceType) IsYANGGoEnum() {}
+
+func (e testInterfaceType) String() string {
+	return ygot.EnumLogString(e, int64(e), "testInterfaceType")
+}
+
+func (testInterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return map[string]map[int64]ygot.EnumDefinition{
+		"testInterfaceType": {
+			1: {Name: "ethernetCsmacd", DefiningModule: "iana-if-type"},
+			2: {Name: "softwareLoopback", DefiningModule: "iana-if-type"},
+		},
+	}
+}
+
+// interfaceValue is the path value of a leaf of a container of an interface
+func interfaceValue(t *testing.T, model *PathModel, container string, leaf string, value string) (string, error) {
+	pathValues, err := model.GetPathValues("", []byte(fmt.Sprintf(
+		`{"interfaces": {"interface": [{"name": "eth0", "%s": {"%s": "%s"}}]}}`, container, leaf, value)))
+	if err != nil {
+		return "", err
+	}
+	assert.Equal(t, 1, len(pathValues))
+	return (&pathValues[0].Value).ValueToString(), nil
+}
+
+func Test_GetPathValuesEnumeration(t *testing.T) {
+	model := devicesimModel(t)
+
+	value, err := interfaceValue(t, model, "state", "admin-status", "DOWN")
+	assert.NoError(t, err)
+	assert.Equal(t, "DOWN", value)
+
+	value, err = interfaceValue(t, model, "state", "admin-status", "2")
+	assert.NoError(t, err)
+	assert.Equal(t, "TESTING", value)
+
+	_, err = interfaceValue(t, model, "state", "admin-status", "SLEEPING")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value SLEEPING for /interfaces/interface[0]/state/admin-status does not match any enumerated value [UP, DOWN, TESTING]")
+}
+
+func Test_GetPathValuesIdentity(t *testing.T) {
+	// The identities are not in the schema of the bindings
+	model := devicesimModel(t)
+	value, err := interfaceValue(t, model, "config", "type", "iana-if-type:other")
+	assert.NoError(t, err)
+	assert.Equal(t, "other", value)
+
+	model = devicesimModel(t, WithEnumTypes(map[string][]reflect.Type{
+		"/interfaces/interface/config/type": {reflect.TypeOf(testInterfaceType(0))},
+	}))
+	value, err = interfaceValue(t, model, "config", "type", "iana-if-type:softwareLoopback")
+	assert.NoError(t, err)
+	assert.Equal(t, "softwareLoopback", value)
+
+	value, err = interfaceValue(t, model, "config", "type", "ethernetCsmacd")
+	assert.NoError(t, err)
+	assert.Equal(t, "ethernetCsmacd", value)
+
+	_, err = interfaceValue(t, model, "config", "type", "iana-if-type:other")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value other for /interfaces/interface[0]/config/type does not match any enumerated value [ethernetCsmacd, softwareLoopback]")
+}
@@ -22,26 +22,21 @@ var log = logging.GetLogger("utils", "pathWithIdx")
 
 const Prefixed = "PREFIXED"
 
-var roPaths []*admin.ReadOnlyPath
-var rwPaths []*admin.ReadWritePath
-var nsMappings []*admin.Namespace
+// defaultModel is the model of the last call to ExtractPaths, which
+// GetPathValues uses
+var defaultModel = &PathModel{}
 
-// ExtractPaths parse the schema entries out in to flat paths
+// ExtractPaths parse the schema entries out in to flat paths. The model also
+// becomes the one of GetPathValues; use NewPathModel instead to handle more
+// than one model in a process.
 func ExtractPaths(entries map[string]*yang.Entry) ([]*admin.ReadOnlyPath, []*admin.ReadWritePath, []*admin.Namespace) {
-	var err error
-	var namespaceMappings map[string]string
-	roPaths, rwPaths, namespaceMappings, err = extractPaths(entries["Device"], yang.TSUnset, "", "")
+	model, err := NewPathModel(entries)
 	if err != nil {
 		log.Errorf(err.Error())
 		panic(err)
 	}
-	for k, v := range namespaceMappings {
-		nsMappings = append(nsMappings, &admin.Namespace{
-			Module: k,
-			Prefix: v,
-		})
-	}
-	return roPaths, rwPaths, nsMappings
+	defaultModel = model
+	return model.roPaths, model.rwPaths, model.namespaces
 }
 
 // extractPaths - recursive function that walks the YGOT tree to extract paths
@@ -75,11 +70,6 @@ func extractPaths(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 				IsAKey:      false,
 				AttrName:    dirEntry.Name,
 			}
-			var enum map[int]string
-			if dirEntry.Type.Kind == yang.Yidentityref {
-				enum = handleIdentity(dirEntry.Type)
-				fmt.Println(enum)
-			}
 			// Check to see if this attribute is a key in a list
 			if dirEntry.Parent.IsList() {
 				keyNames := strings.Split(dirEntry.Parent.Key, " ")
@@ -264,6 +254,8 @@ func formatNameOfChildEntry(dirEntry *yang.Entry) string {
 	return name
 }
 
+// toValueType - the value type of a YANG type and its width or fraction digits;
+// a union is a string, with the TypeOpts of unionTypeOpts
 func toValueType(entry *yang.YangType, isLeafList bool) (configapi.ValueType, []uint64, error) {
 	switch entry.Kind.String() {
 	case "int8", "int16", "int32", "int64":
@@ -283,7 +275,16 @@ func toValueType(entry *yang.YangType, isLeafList bool) (configapi.ValueType, []
 			return configapi.ValueType_LEAFLIST_DECIMAL, []uint64{uint64(entry.FractionDigits)}, nil
 		}
 		return configapi.ValueType_DECIMAL, []uint64{uint64(entry.FractionDigits)}, nil
-	case "string", "enumeration", "leafref", "identityref", "union", "instance-identifier":
+	case "union":
+		typeOpts, err := unionTypeOpts(entry)
+		if err != nil {
+			return configapi.ValueType_EMPTY, nil, err
+		}
+		if isLeafList {
+			return configapi.ValueType_LEAFLIST_STRING, typeOpts, nil
+		}
+		return configapi.ValueType_STRING, typeOpts, nil
+	case "string", "enumeration", "leafref", "identityref", "instance-identifier":
 		if isLeafList {
 			return configapi.ValueType_LEAFLIST_STRING, nil, nil
 		}
@@ -307,15 +308,6 @@ func toValueType(entry *yang.YangType, isLeafList bool) (configapi.ValueType, []
 	}
 }
 
-func handleIdentity(yangType *yang.YangType) map[int]string {
-	identityMap := make(map[int]string)
-	identityMap[0] = "UNSET"
-	for i, val := range yangType.IdentityBase.Values {
-		identityMap[i+1] = val.Name
-	}
-	return identityMap
-}
-
 func extractIntegerWidth(typeName string) configapi.Width {
 	switch typeName {
 	case "int8", "uint8":
@@ -7,6 +7,7 @@
 package path
 
 import (
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,9 @@ import (
 	"testing"
 )
 
+// testModel is the model of testdevice 1.0.x
+var testModel *PathModel
+
 func TestMain(m *testing.M) {
 	oldValue, wasPreviouslySet := os.LookupEnv(Prefixed)
 	if !wasPreviouslySet {
@@ -35,7 +39,7 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 
-	roPaths, rwPaths, nsMappings = ExtractPaths(schemaTree)
+	testModel, err = NewPathModel(schemaTree)
 	if err != nil {
 		panic(err)
 	}
@@ -46,8 +50,8 @@ func TestMain(m *testing.M) {
 }
 
 func Test_ExtractPaths(t *testing.T) {
-	assert.Equal(t, 2, len(roPaths))
-	for _, roPath := range roPaths {
+	assert.Equal(t, 2, len(testModel.roPaths))
+	for _, roPath := range testModel.roPaths {
 		switch path := roPath.Path; path {
 		case "/t1:cont1a/cont2a/leaf2c":
 			assert.Equal(t, 1, len(roPath.SubPath))
@@ -72,8 +76,8 @@ func Test_ExtractPaths(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, 21, len(rwPaths))
-	for _, rwPath := range rwPaths {
+	assert.Equal(t, 22, len(testModel.rwPaths))
+	for _, rwPath := range testModel.rwPaths {
 		switch path := rwPath.Path; path {
 		case "/t1:leafAtTopLevel":
 			assert.Equal(t, "leafAtTopLevel", rwPath.AttrName)
@@ -89,6 +93,10 @@ func Test_ExtractPaths(t *testing.T) {
 			assert.Equal(t, "leaf2f", rwPath.AttrName)
 		case "/t1:cont1a/cont2a/leaf2g":
 			assert.Equal(t, "leaf2g", rwPath.AttrName)
+		case "/t1:cont1a/cont2a/leaf2h":
+			assert.Equal(t, "leaf2h", rwPath.AttrName)
+			assert.Equal(t, configapi.ValueType_STRING, rwPath.ValueType)
+			assert.Equal(t, []uint64{uint64(configapi.ValueType_UINT), 16, uint64(configapi.ValueType_STRING), 0}, rwPath.TypeOpts)
 		case "/t1:cont1a/leaf1a":
 			assert.Equal(t, "leaf1a", rwPath.AttrName)
 		case "/t1:cont1a/t1e:list4[id=*]/id":
@@ -132,23 +140,17 @@ func Test_ExtractPaths_Prefixed(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	roPaths, rwPaths, nsMappings = ExtractPaths(schemaTree)
-	if err != nil {
-		assert.NoError(t, err)
-	}
+	model, err := NewPathModel(schemaTree)
+	assert.NoError(t, err)
 
 	defer func() {
 		if err := os.Setenv(Prefixed, Prefixed); err != nil {
 			assert.NoError(t, err)
 		}
-		roPaths, rwPaths, nsMappings = ExtractPaths(schemaTree)
-		if err != nil {
-			assert.NoError(t, err)
-		}
 	}()
 
-	assert.Equal(t, 2, len(roPaths))
-	for _, roPath := range roPaths {
+	assert.Equal(t, 2, len(model.roPaths))
+	for _, roPath := range model.roPaths {
 		switch path := roPath.Path; path {
 		case "/cont1a/cont2a/leaf2c":
 			assert.Equal(t, 1, len(roPath.SubPath))
@@ -173,8 +175,8 @@ func Test_ExtractPaths_Prefixed(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, 21, len(rwPaths))
-	for _, rwPath := range rwPaths {
+	assert.Equal(t, 22, len(model.rwPaths))
+	for _, rwPath := range model.rwPaths {
 		switch path := rwPath.Path; path {
 		case "/leafAtTopLevel":
 			assert.Equal(t, "leafAtTopLevel", rwPath.AttrName)
@@ -190,6 +192,10 @@ func Test_ExtractPaths_Prefixed(t *testing.T) {
 			assert.Equal(t, "leaf2f", rwPath.AttrName)
 		case "/cont1a/cont2a/leaf2g":
 			assert.Equal(t, "leaf2g", rwPath.AttrName)
+		case "/cont1a/cont2a/leaf2h":
+			assert.Equal(t, "leaf2h", rwPath.AttrName)
+			assert.Equal(t, configapi.ValueType_STRING, rwPath.ValueType)
+			assert.Equal(t, []uint64{uint64(configapi.ValueType_UINT), 16, uint64(configapi.ValueType_STRING), 0}, rwPath.TypeOpts)
 		case "/cont1a/leaf1a":
 			assert.Equal(t, "leaf1a", rwPath.AttrName)
 		case "/cont1a/list4[id=*]/id":
@@ -488,207 +494,161 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	testdevice10XSchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5b, 0x6f, 0xdb, 0x38,
-		0x16, 0x7e, 0xcf, 0xaf, 0x38, 0xd0, 0x4b, 0x93, 0x45, 0x9c, 0xd8, 0x8a, 0x9d, 0xb6, 0x01, 0x06,
-		0x58, 0xf7, 0xb6, 0x0b, 0x4c, 0xdb, 0x29, 0x3a, 0xc1, 0x00, 0xbb, 0xdd, 0x60, 0x41, 0x5b, 0x47,
-		0x36, 0x11, 0x99, 0xf2, 0x48, 0x54, 0x1a, 0x63, 0x90, 0xff, 0x3e, 0xd0, 0xc5, 0xf2, 0x4d, 0x96,
-		0x0e, 0x75, 0x71, 0xec, 0x98, 0xf3, 0x30, 0x75, 0x64, 0x4a, 0x16, 0x79, 0xae, 0xfc, 0xce, 0x47,
-		0xf2, 0xaf, 0x13, 0x00, 0x00, 0xe3, 0x2b, 0x9b, 0xa0, 0x71, 0x03, 0x86, 0x85, 0x0f, 0x7c, 0x88,
-		0xc6, 0x79, 0x7c, 0xf5, 0x57, 0x2e, 0x2c, 0xe3, 0x06, 0x3a, 0xc9, 0x9f, 0xef, 0x5d, 0x61, 0xf3,
-		0x91, 0x71, 0x03, 0xed, 0xe4, 0xc2, 0x07, 0xee, 0x19, 0x37, 0x10, 0x3f, 0x22, 0xba, 0x30, 0x74,
-		0x85, 0xec, 0xb0, 0x95, 0x6b, 0x2b, 0x8f, 0x4f, 0xbe, 0x3f, 0x5f, 0xfd, 0xf6, 0x03, 0xfa, 0x43,
-		0x8f, 0x4f, 0x25, 0x77, 0x45, 0xd8, 0xe8, 0x76, 0x8c, 0x20, 0xdd, 0x29, 0x38, 0xf8, 0x80, 0x0e,
-		0x84, 0xb7, 0x30, 0x2e, 0xd0, 0x5b, 0xbf, 0x6b, 0xf5, 0xe5, 0xd2, 0xcb, 0xeb, 0x2f, 0x99, 0x7e,
-		0xf1, 0xcd, 0x43, 0x9b, 0x3f, 0x6e, 0xbc, 0xdb, 0xca, 0xfb, 0xc9, 0xce, 0xda, 0xaf, 0x44, 0xdf,
-		0xfe, 0xee, 0x06, 0xde, 0x10, 0x33, 0xef, 0x8c, 0xdf, 0x04, 0x67, 0x3f, 0x5d, 0x2f, 0x7c, 0x19,
-		0x63, 0x1a, 0xff, 0xc8, 0x79, 0x76, 0xc3, 0x7f, 0x33, 0xbf, 0xef, 0x8d, 0x82, 0x09, 0x0a, 0x69,
-		0xdc, 0x80, 0xf4, 0x02, 0xdc, 0xd2, 0x70, 0xa9, 0x55, 0xf8, 0x4e, 0x1b, 0x8d, 0x9e, 0x56, 0xae,
-		0x3c, 0xad, 0x8f, 0xe7, 0x9a, 0x58, 0x56, 0xc4, 0x63, 0xb2, 0xed, 0x1d, 0x59, 0x16, 0x93, 0xc9,
-		0xb6, 0xf5, 0x22, 0x43, 0x5c, 0xa6, 0xb0, 0x0a, 0xc4, 0x55, 0x20, 0xb6, 0x42, 0xf1, 0x51, 0xc4,
-		0x48, 0x13, 0x27, 0x55, 0xac, 0xca, 0xe2, 0x55, 0x16, 0x33, 0x59, 0xdc, 0xd9, 0x62, 0xdf, 0x22,
-		0xfe, 0x42, 0x35, 0x48, 0x1b, 0x38, 0xc8, 0xec, 0x1c, 0x75, 0xd8, 0x18, 0xce, 0xa4, 0x7d, 0x41,
-		0x67, 0xd6, 0xd4, 0xe3, 0x6b, 0x30, 0x41, 0x8f, 0x0f, 0x21, 0xbc, 0x19, 0xb8, 0xf0, 0xb9, 0x85,
-		0xf0, 0x7e, 0xae, 0x24, 0x40, 0x79, 0x9c, 0xcd, 0x02, 0x27, 0x1c, 0x9a, 0x1f, 0xb9, 0x0d, 0xa3,
-		0xc6, 0xa6, 0x91, 0xdb, 0xe6, 0xae, 0xe0, 0xb7, 0x12, 0xdd, 0x6c, 0x17, 0x34, 0x2b, 0xd2, 0x51,
-		0x15, 0x5d, 0x55, 0xd3, 0x59, 0x55, 0xdd, 0x2d, 0xad, 0xc3, 0xa5, 0x75, 0x59, 0x59, 0xa7, 0xf3,
-		0x75, 0xbb, 0x40, 0xc7, 0xd3, 0x5f, 0xbb, 0x9d, 0x4d, 0x51, 0x6d, 0x9c, 0x03, 0x2e, 0xe4, 0x1b,
-		0xca, 0x50, 0x27, 0x4a, 0xd1, 0x23, 0x34, 0xfd, 0xce, 0xc4, 0x08, 0x49, 0x9a, 0x1a, 0xfe, 0x47,
-		0x13, 0x5d, 0xf4, 0xe0, 0x2f, 0x5c, 0x90, 0x65, 0x9d, 0xde, 0xf4, 0x07, 0x73, 0x02, 0xdc, 0xee,
-		0x6a, 0xb7, 0xde, 0xf7, 0xc9, 0x63, 0xc3, 0xd0, 0x7a, 0x3f, 0xf0, 0x11, 0x97, 0x7e, 0xb1, 0x9a,
-		0x6f, 0x0e, 0x31, 0x8e, 0x98, 0xe4, 0x0f, 0xe1, 0x6f, 0xdb, 0xcc, 0xf1, 0x91, 0x7c, 0xf7, 0xd3,
-		0xb9, 0xc2, 0x90, 0xb0, 0xc7, 0xf2, 0x43, 0x72, 0x75, 0x38, 0x43, 0x72, 0x52, 0xe3, 0xc0, 0xed,
-		0x4c, 0xe3, 0xb4, 0xca, 0x6d, 0x8e, 0xc9, 0x8b, 0xd3, 0xb9, 0xc2, 0x56, 0x77, 0x95, 0x5c, 0x3a,
-		0x3e, 0x4a, 0x8f, 0xb5, 0x02, 0xe1, 0x4b, 0x36, 0x70, 0x88, 0xce, 0xdd, 0x43, 0x1b, 0x3d, 0x14,
-		0xc3, 0x46, 0x9c, 0xf0, 0x3c, 0x72, 0x7c, 0xff, 0xf4, 0x1e, 0xae, 0xdb, 0xdd, 0xb6, 0xa1, 0xa0,
-		0x3a, 0x8a, 0xf1, 0x3a, 0x2b, 0x6e, 0x2f, 0xfa, 0xa6, 0xa8, 0x07, 0x65, 0x43, 0x78, 0x66, 0x28,
-		0x4f, 0x3b, 0xbf, 0x6f, 0xda, 0x74, 0x52, 0x42, 0xcf, 0xe2, 0x8c, 0x76, 0xa0, 0x98, 0x01, 0x0f,
-		0x14, 0x33, 0xe0, 0x3f, 0x5c, 0x47, 0xb2, 0x11, 0x96, 0xce, 0x80, 0x75, 0x56, 0x7a, 0xa8, 0x59,
-		0xe9, 0x17, 0x26, 0x2c, 0x26, 0x5d, 0x6f, 0x56, 0x9c, 0x85, 0x95, 0xc8, 0x60, 0x2d, 0x1c, 0xf2,
-		0x09, 0x73, 0xae, 0xbb, 0x0a, 0x59, 0x6c, 0xc7, 0x24, 0xb4, 0xdd, 0x88, 0x3c, 0x57, 0xc7, 0x9b,
-		0xfb, 0x5e, 0xbd, 0xb8, 0x44, 0xc4, 0x6c, 0xb7, 0xdb, 0x87, 0x33, 0x2a, 0xfb, 0x1e, 0x3c, 0x86,
-		0x8a, 0xc1, 0x63, 0xa8, 0x18, 0x3c, 0xbe, 0x23, 0xb3, 0xc0, 0x15, 0xce, 0x6c, 0x67, 0xe1, 0xc3,
-		0xd4, 0xe1, 0xe3, 0x60, 0x41, 0x0d, 0x5f, 0x7a, 0x5c, 0x8c, 0x54, 0xe2, 0xc1, 0x9b, 0xa6, 0x0c,
-		0xc3, 0x52, 0x34, 0x0c, 0x4b, 0xd1, 0x30, 0xfa, 0xc2, 0x95, 0x63, 0xf4, 0x20, 0x89, 0x82, 0x3a,
-		0xb1, 0xd2, 0x96, 0xa1, 0x93, 0x25, 0x9d, 0x2c, 0xe9, 0x64, 0x69, 0x8f, 0x93, 0x25, 0x54, 0x8c,
-		0x09, 0xa8, 0x18, 0x13, 0xa2, 0x14, 0xc9, 0xe1, 0xbe, 0xd4, 0xd1, 0x40, 0x47, 0x83, 0xbc, 0x71,
-		0xe6, 0x42, 0x76, 0xae, 0x15, 0x22, 0x81, 0x79, 0xb8, 0x3e, 0xbd, 0xba, 0xff, 0xaa, 0x80, 0x3b,
-		0x87, 0x2a, 0xb3, 0x97, 0x4e, 0x5d, 0x63, 0xf1, 0x4a, 0x16, 0xf6, 0x99, 0xfb, 0xb2, 0x2f, 0xa5,
-		0x47, 0xb3, 0xb2, 0x2f, 0x5c, 0x7c, 0x74, 0x30, 0xb4, 0x7f, 0xe2, 0x50, 0x85, 0xe2, 0x5c, 0xba,
-		0xa3, 0xf3, 0xa6, 0xdb, 0xbd, 0x7e, 0xdd, 0xed, 0xb6, 0x5f, 0x5f, 0xbd, 0x6e, 0xbf, 0xed, 0xf5,
-		0x3a, 0xd7, 0x1d, 0x4a, 0xf5, 0xf5, 0x37, 0xcf, 0x42, 0x0f, 0xad, 0x77, 0x33, 0xe3, 0x06, 0x44,
-		0xe0, 0x38, 0x4d, 0x45, 0x31, 0x5b, 0x31, 0x8a, 0xd9, 0x8a, 0x51, 0x6c, 0xc0, 0x05, 0xf3, 0x56,
-		0xe7, 0xfb, 0x43, 0x1d, 0xc7, 0x74, 0x1c, 0xcb, 0x18, 0xe7, 0x58, 0x55, 0x14, 0x02, 0xd9, 0x5b,
-		0x42, 0xd3, 0xcf, 0x28, 0x46, 0x72, 0xbc, 0x77, 0x91, 0xcc, 0x6c, 0xeb, 0xa2, 0xf2, 0x21, 0x8f,
-		0xc9, 0xbe, 0x4f, 0x4e, 0x46, 0x8a, 0x6e, 0x7d, 0xa4, 0xe8, 0xd6, 0xdf, 0xb9, 0xae, 0x83, 0x4c,
-		0xe8, 0x32, 0xa0, 0xf6, 0xeb, 0xc5, 0x7e, 0x3d, 0xd6, 0x15, 0x15, 0xac, 0xaa, 0x53, 0xd6, 0x2e,
-		0x94, 0x38, 0xa5, 0x7d, 0x21, 0x5c, 0xc9, 0x12, 0x95, 0xce, 0xa1, 0x96, 0xfa, 0xc3, 0x31, 0x4e,
-		0xd8, 0x94, 0x45, 0x71, 0xc4, 0xb8, 0x74, 0x85, 0xdd, 0x92, 0xe8, 0xcb, 0xce, 0x65, 0xcc, 0x00,
-		0xbf, 0xcc, 0x65, 0x18, 0xc7, 0x4f, 0x90, 0x5e, 0x30, 0x94, 0x22, 0x19, 0x90, 0xdf, 0x84, 0x7d,
-		0x1b, 0xde, 0xff, 0xff, 0xd0, 0x62, 0x3a, 0xfd, 0xe8, 0x1f, 0xb3, 0x9f, 0x2d, 0xb8, 0xcd, 0x1e,
-		0x65, 0xf4, 0x26, 0xb2, 0xe1, 0x0e, 0x81, 0x0b, 0x9d, 0xb4, 0xa3, 0x71, 0xa1, 0x3f, 0x67, 0xda,
-		0xf6, 0xf6, 0xdb, 0xf3, 0x6d, 0x5a, 0x93, 0xa1, 0xeb, 0x23, 0x43, 0x17, 0xda, 0x20, 0xbd, 0x86,
-		0xb2, 0xa8, 0x9d, 0xe4, 0xb4, 0x21, 0x26, 0x51, 0xb4, 0xd9, 0x14, 0xdd, 0x95, 0xce, 0x13, 0x83,
-		0x1e, 0xd1, 0x11, 0x96, 0xcd, 0x07, 0xd4, 0xf3, 0x80, 0x27, 0xda, 0x34, 0x50, 0xbd, 0xab, 0x9d,
-		0xf6, 0xfe, 0xf5, 0xb5, 0xa4, 0x2f, 0xbe, 0xab, 0xe2, 0xcf, 0xb8, 0x4f, 0x5a, 0xdb, 0x91, 0xb4,
-		0xa3, 0xf9, 0xb3, 0x3e, 0xf8, 0x7c, 0x32, 0x75, 0x30, 0x06, 0x55, 0x5d, 0x3b, 0x9c, 0x88, 0xda,
-		0x7c, 0x14, 0x78, 0x51, 0x08, 0x00, 0x2e, 0x71, 0xe2, 0xeb, 0x85, 0x1e, 0x7b, 0xbf, 0xd0, 0x23,
-		0x89, 0xa2, 0xc4, 0xec, 0x36, 0x6a, 0xad, 0x96, 0xdb, 0xde, 0x8e, 0x13, 0x15, 0xe1, 0x3e, 0xdc,
-		0xe3, 0x0c, 0x2d, 0x18, 0xcc, 0x80, 0xf2, 0x1c, 0x9d, 0xd4, 0x1e, 0x4f, 0x52, 0x5b, 0x82, 0x9c,
-		0x70, 0xb8, 0x68, 0x45, 0x57, 0x83, 0x15, 0xeb, 0x43, 0xf2, 0x46, 0x63, 0x15, 0x35, 0x60, 0x15,
-		0x1e, 0x13, 0x23, 0x6c, 0x4d, 0x08, 0x82, 0x48, 0x0d, 0x6f, 0x71, 0x8b, 0x22, 0xc5, 0x06, 0x26,
-		0xec, 0x11, 0x1e, 0x42, 0xf1, 0x81, 0xed, 0x7a, 0x20, 0xc7, 0x08, 0xd1, 0xb3, 0xb4, 0x57, 0xd7,
-		0x5e, 0xfd, 0xf8, 0xd6, 0xd1, 0x69, 0xfc, 0x79, 0x13, 0x7f, 0xee, 0xf5, 0xb4, 0x53, 0xaf, 0xcf,
-		0xa9, 0x13, 0xb4, 0x73, 0xdd, 0xa9, 0x73, 0xa1, 0xec, 0xd4, 0x93, 0x29, 0x5d, 0xf4, 0x00, 0x90,
-		0x2e, 0x48, 0xf4, 0x25, 0x78, 0x81, 0x83, 0x3e, 0x70, 0x01, 0xff, 0xe9, 0x7f, 0xfd, 0xd7, 0x05,
-		0x7c, 0xe1, 0x02, 0x26, 0x81, 0x2f, 0x61, 0x80, 0xf0, 0xbf, 0xa0, 0xdd, 0xbe, 0x1a, 0xfe, 0x02,
-		0x84, 0x00, 0xa2, 0x1d, 0xff, 0xa1, 0x3a, 0xfe, 0x66, 0x97, 0xaa, 0xe8, 0x20, 0xa1, 0x83, 0x84,
-		0x0e, 0x12, 0x55, 0x83, 0x04, 0x2a, 0xb1, 0xea, 0xe3, 0xe6, 0xaa, 0xc1, 0x21, 0x5d, 0x65, 0x1a,
-		0x46, 0x86, 0x98, 0x98, 0x1f, 0x46, 0x85, 0x30, 0xf5, 0x37, 0x59, 0xe1, 0xee, 0x2e, 0x3a, 0x10,
-		0x1c, 0xe1, 0x0c, 0x20, 0xd4, 0x12, 0x0f, 0x6d, 0x15, 0x60, 0xe7, 0x35, 0xa1, 0xed, 0xb7, 0x79,
-		0xf1, 0x70, 0xa5, 0x64, 0x78, 0x99, 0x2c, 0x16, 0x69, 0xc0, 0xbe, 0xe4, 0x63, 0x6b, 0xea, 0xfe,
-		0x44, 0x8f, 0x6e, 0x62, 0xe9, 0x1d, 0x8a, 0x68, 0xa9, 0xc7, 0x84, 0x3f, 0xe1, 0x12, 0x48, 0x37,
-		0x6b, 0x53, 0x3a, 0xae, 0xc9, 0xb4, 0x12, 0x31, 0xf9, 0x5a, 0xef, 0x4a, 0xf3, 0x82, 0x12, 0x25,
-		0xcd, 0xe6, 0x22, 0xfb, 0x71, 0xa5, 0x02, 0xd9, 0xaf, 0x38, 0x2b, 0x28, 0x6c, 0xd1, 0x18, 0xce,
-		0x74, 0x66, 0xf3, 0x1a, 0xa3, 0x39, 0xa7, 0x1a, 0x40, 0xa3, 0x2d, 0x6f, 0xeb, 0x99, 0xc2, 0x26,
-		0x29, 0x46, 0x38, 0x9f, 0xaf, 0x83, 0x90, 0x90, 0xd6, 0x09, 0x83, 0xc9, 0x00, 0xbd, 0xd3, 0x8b,
-		0x4b, 0xd9, 0xb9, 0x49, 0xd1, 0x88, 0xb3, 0x14, 0x2e, 0xc8, 0xf8, 0x9a, 0x3d, 0x9e, 0x51, 0x7c,
-		0xdb, 0x6a, 0xb8, 0x24, 0x06, 0x93, 0x34, 0x54, 0x8d, 0xb9, 0x0f, 0xdc, 0x07, 0x16, 0xe3, 0x17,
-		0xbe, 0x64, 0x32, 0x12, 0x03, 0x35, 0xb6, 0x94, 0xd8, 0xa9, 0x65, 0x39, 0x90, 0x59, 0x4b, 0xef,
-		0xae, 0xe0, 0x37, 0xaa, 0xec, 0xd1, 0xb2, 0x1a, 0xd5, 0xb6, 0x75, 0xbf, 0x26, 0x83, 0xa5, 0xd0,
-		0x38, 0x3e, 0x7a, 0x9e, 0xeb, 0xf5, 0xa7, 0xd3, 0x5b, 0x36, 0x52, 0x97, 0x5f, 0x0c, 0x4b, 0x45,
-		0xba, 0xba, 0x1b, 0x89, 0x61, 0xf8, 0xb6, 0x2d, 0x36, 0x9d, 0xb6, 0x24, 0x1b, 0x3d, 0x8b, 0xcc,
-		0x96, 0xba, 0xbc, 0x6b, 0x29, 0x7d, 0x41, 0xdf, 0x67, 0x23, 0x2c, 0x29, 0xa6, 0xd0, 0xe0, 0x53,
-		0x98, 0xd0, 0x41, 0xdf, 0x07, 0x39, 0x66, 0x02, 0x5c, 0x0f, 0xf0, 0xcf, 0x80, 0x39, 0xe1, 0x14,
-		0x92, 0x5a, 0x7b, 0xaa, 0x57, 0x9a, 0x93, 0xa4, 0x5b, 0xcf, 0x26, 0x4d, 0xa5, 0x91, 0xa9, 0x4b,
-		0xe8, 0xf5, 0xb2, 0x8e, 0x1a, 0x66, 0x80, 0xe6, 0xf2, 0x90, 0xa0, 0x98, 0x01, 0x1a, 0x06, 0xec,
-		0x8a, 0x0c, 0x50, 0xee, 0xcb, 0x2e, 0x8d, 0x30, 0xd5, 0x25, 0xf3, 0xa5, 0x22, 0x16, 0xcc, 0x4f,
-		0x2e, 0xc7, 0xc0, 0x20, 0x99, 0x18, 0x03, 0x17, 0x16, 0x3e, 0xee, 0x07, 0x4d, 0x0a, 0x0f, 0x91,
-		0x27, 0x85, 0x3b, 0x23, 0x4a, 0x71, 0x05, 0x7c, 0x8d, 0xab, 0x82, 0x6b, 0x9f, 0xb9, 0xb8, 0x8f,
-		0x50, 0xb5, 0x48, 0xf3, 0x23, 0x7a, 0x94, 0x7f, 0x18, 0x93, 0x7f, 0x7c, 0x89, 0xb3, 0x7f, 0x3c,
-		0x3e, 0x24, 0x4d, 0x76, 0x6e, 0x12, 0xef, 0x2b, 0x3b, 0x37, 0xb1, 0x1a, 0x86, 0x9f, 0x22, 0x17,
-		0xdb, 0xd0, 0xb2, 0x9a, 0xae, 0xe2, 0xee, 0x7a, 0xdd, 0x41, 0x99, 0x35, 0xff, 0x5d, 0x06, 0xae,
-		0x88, 0x0c, 0xab, 0xcb, 0x00, 0xe7, 0xf3, 0x2e, 0x6d, 0x5b, 0xda, 0xb6, 0x8e, 0x93, 0x7d, 0xa8,
-		0xc1, 0x35, 0x0d, 0xae, 0x35, 0xb4, 0x54, 0x32, 0xf2, 0xb1, 0x0a, 0x3e, 0x3d, 0x6e, 0xaf, 0x5a,
-		0x86, 0x4c, 0xd3, 0x68, 0x2e, 0xc2, 0x44, 0x3a, 0x4d, 0xaa, 0x4d, 0xb8, 0xc7, 0x99, 0x0f, 0xcc,
-		0x8f, 0x97, 0x51, 0x7a, 0x68, 0x53, 0xdd, 0x7c, 0x47, 0xbb, 0xf9, 0xc3, 0x73, 0xf3, 0x45, 0x09,
-		0x7b, 0xda, 0xd0, 0xe2, 0xfe, 0xd4, 0x61, 0x33, 0xd2, 0x42, 0x87, 0x0d, 0xe9, 0x2c, 0xdf, 0x4c,
-		0x1c, 0x87, 0x35, 0x85, 0x0d, 0xa7, 0xf6, 0xd1, 0x1f, 0xcc, 0x81, 0xe4, 0x69, 0x51, 0x7e, 0x0f,
-		0x4c, 0x4a, 0x8f, 0x0f, 0x02, 0x89, 0x73, 0xf5, 0xb5, 0xb8, 0x1d, 0x15, 0xd8, 0x25, 0x38, 0x51,
-		0xe8, 0x88, 0x61, 0x00, 0x9f, 0xfa, 0xbb, 0xb4, 0x9c, 0x45, 0x59, 0xa9, 0xcb, 0x28, 0x77, 0x49,
-		0x25, 0xaf, 0x02, 0xf4, 0x54, 0x52, 0xfa, 0x5a, 0x90, 0x9e, 0x72, 0x46, 0xa0, 0xe8, 0xd8, 0x89,
-		0xb2, 0x22, 0xe7, 0x40, 0xe5, 0x73, 0xa1, 0x32, 0x39, 0x51, 0xd9, 0xdc, 0x48, 0x3d, 0x47, 0xaa,
-		0x94, 0x2b, 0x55, 0xcd, 0x99, 0x6a, 0xcb, 0x13, 0xaa, 0xe7, 0x0b, 0x25, 0x72, 0xa9, 0x4a, 0x39,
-		0xd5, 0xc6, 0xd0, 0xf5, 0x0e, 0x7f, 0xe8, 0x4e, 0x1a, 0x1c, 0xe8, 0x67, 0xd3, 0xe8, 0xb6, 0x56,
-		0xe9, 0xb2, 0x63, 0x67, 0xb6, 0x8f, 0x4d, 0xa7, 0xc9, 0xad, 0xef, 0x76, 0x58, 0xa4, 0xb2, 0xef,
-		0x71, 0xd6, 0x51, 0xcf, 0xe8, 0xe2, 0xdb, 0xca, 0xe5, 0x72, 0xb6, 0xeb, 0x21, 0x1f, 0x89, 0x70,
-		0xa2, 0x01, 0x1d, 0x68, 0x85, 0x73, 0x8c, 0x39, 0x6a, 0xdb, 0x63, 0x97, 0x2a, 0x0f, 0xd6, 0xc9,
-		0x9a, 0x4e, 0xd6, 0xaa, 0x27, 0x6b, 0x74, 0x50, 0xb8, 0x0c, 0x38, 0x9c, 0x0f, 0x12, 0x63, 0x84,
-		0x12, 0xf7, 0xa2, 0x4f, 0x91, 0xe6, 0xef, 0xd8, 0xf4, 0xcd, 0x72, 0xa6, 0x6f, 0xd6, 0x60, 0xfa,
-		0x66, 0x96, 0xe9, 0x9b, 0xda, 0xf4, 0xb5, 0xe9, 0x1f, 0xa5, 0xe9, 0x9b, 0x3b, 0x62, 0x29, 0x9c,
-		0x17, 0x96, 0x9e, 0xd3, 0xf8, 0x0e, 0x14, 0x53, 0x7f, 0x89, 0x5b, 0x97, 0x36, 0x70, 0x70, 0x1a,
-		0x89, 0x1b, 0xa8, 0x3e, 0x9f, 0x59, 0x3e, 0x51, 0x78, 0xc8, 0xe4, 0xe9, 0xab, 0x28, 0xbd, 0x86,
-		0x57, 0xe7, 0x10, 0x43, 0x10, 0xa7, 0x17, 0x17, 0x97, 0xff, 0x0c, 0x35, 0x8c, 0x5b, 0x67, 0xe7,
-		0xf0, 0xaa, 0xb5, 0xf4, 0x45, 0x7c, 0x3d, 0x12, 0xf4, 0xf6, 0xaf, 0xcc, 0xb3, 0x33, 0xf8, 0x65,
-		0x71, 0x3d, 0xbc, 0xbc, 0x04, 0xe6, 0x9d, 0xa9, 0x18, 0x4e, 0x19, 0xd2, 0xe1, 0xa6, 0x6b, 0x4d,
-		0xd9, 0x77, 0x3e, 0x8b, 0x16, 0x59, 0x96, 0xe2, 0x20, 0x56, 0x75, 0xbd, 0x50, 0x0b, 0x27, 0xb1,
-		0x36, 0x3f, 0x0c, 0xdb, 0x39, 0x8a, 0xd9, 0xa3, 0xd4, 0xd0, 0xbc, 0x45, 0xa5, 0xd4, 0x54, 0x86,
-		0xc2, 0x98, 0x87, 0x2b, 0x83, 0xed, 0x7a, 0x13, 0xf6, 0x5c, 0x0a, 0x50, 0x96, 0xe2, 0xd8, 0x9c,
-		0x0a, 0x64, 0x0c, 0xcd, 0xbe, 0x48, 0x5d, 0x95, 0x12, 0x99, 0x2b, 0xf6, 0x39, 0x05, 0x30, 0xee,
-		0xa3, 0x44, 0x2b, 0x71, 0x56, 0xe0, 0xf0, 0x7b, 0x84, 0xc4, 0x1d, 0xc6, 0x8c, 0xe9, 0x8b, 0x8b,
-		0x4b, 0x6e, 0x45, 0x1f, 0xb1, 0x15, 0x5f, 0x89, 0x9c, 0xdf, 0xc6, 0x15, 0x33, 0xbe, 0xf2, 0xea,
-		0x59, 0x75, 0x49, 0x9d, 0x60, 0xb9, 0x1b, 0x5d, 0x6a, 0x72, 0xbc, 0x9b, 0x52, 0xd0, 0xbd, 0xd8,
-		0xe1, 0x9d, 0x48, 0xeb, 0x4c, 0xdb, 0x53, 0xe8, 0x9d, 0xdd, 0x4b, 0x52, 0xf5, 0x17, 0x68, 0x64,
-		0xcf, 0x6e, 0xfc, 0xff, 0xbe, 0xb1, 0xcb, 0x25, 0x23, 0x5b, 0x49, 0x7e, 0x0d, 0x2f, 0x18, 0x51,
-		0xcb, 0x23, 0xab, 0xad, 0x21, 0xa9, 0x93, 0xd1, 0xdb, 0xad, 0x46, 0xe8, 0xed, 0x56, 0xe5, 0xf3,
-		0xf6, 0x68, 0x7c, 0xde, 0x5e, 0x09, 0x3e, 0x6f, 0x4c, 0x3d, 0xd0, 0x34, 0xde, 0x03, 0xa0, 0xf1,
-		0x92, 0x40, 0xe3, 0x74, 0x38, 0x09, 0x90, 0xee, 0xba, 0x5e, 0x44, 0xd3, 0x4f, 0x37, 0x39, 0x6b,
-		0xa8, 0x07, 0x2d, 0x20, 0x55, 0x54, 0x35, 0xe3, 0xb0, 0xc6, 0x74, 0x40, 0x33, 0x0e, 0x35, 0xe3,
-		0x50, 0x33, 0x0e, 0xeb, 0x1a, 0x93, 0x3d, 0x66, 0x1c, 0x92, 0xca, 0x00, 0xcb, 0xce, 0xdc, 0x54,
-		0x77, 0xe6, 0xe6, 0x8a, 0x33, 0x8f, 0x57, 0xae, 0x6a, 0x67, 0xae, 0x9d, 0xf9, 0x31, 0xee, 0x60,
-		0x65, 0x6a, 0x4f, 0xbe, 0x11, 0xdc, 0xb4, 0x27, 0xaf, 0xc3, 0x93, 0x3b, 0xc8, 0xec, 0x1e, 0x53,
-		0x5b, 0x0f, 0xd4, 0x53, 0xe5, 0x8e, 0x0b, 0x37, 0xae, 0xdf, 0x2e, 0x98, 0xb7, 0x3a, 0x4f, 0xd7,
-		0xae, 0x5d, 0xe7, 0xe9, 0x3a, 0x4f, 0xd7, 0x79, 0x7a, 0x75, 0xef, 0x5e, 0x06, 0x43, 0x8d, 0xc0,
-		0x92, 0x9c, 0xbc, 0xfc, 0x05, 0x41, 0xa9, 0xcf, 0xb9, 0x1d, 0xcf, 0xbc, 0xc4, 0xde, 0x63, 0xb0,
-		0x51, 0x2b, 0xdf, 0x5e, 0x45, 0xcf, 0x2e, 0xa2, 0xc7, 0x91, 0x77, 0x97, 0x9b, 0xf5, 0x54, 0xa9,
-		0x97, 0xbf, 0x9c, 0x3d, 0x7b, 0x2a, 0xd4, 0xc3, 0xf7, 0x60, 0xeb, 0x9e, 0x58, 0x6b, 0xd4, 0x4a,
-		0xdd, 0x87, 0xbf, 0x7b, 0xcf, 0x6a, 0xaf, 0x0f, 0x66, 0x03, 0x9f, 0xe4, 0xb5, 0x0b, 0x0a, 0xa6,
-		0x3d, 0x96, 0x54, 0x4b, 0x37, 0x4a, 0xa3, 0xea, 0x95, 0xe8, 0xc3, 0xdf, 0xda, 0xa7, 0xce, 0x31,
-		0x3b, 0xda, 0x4d, 0x7f, 0x7a, 0xd5, 0x4a, 0x84, 0x3d, 0x72, 0x89, 0xf0, 0x24, 0xa7, 0x67, 0x46,
-		0x3f, 0x18, 0x85, 0x52, 0x45, 0x2b, 0x33, 0x04, 0x17, 0x94, 0x10, 0x17, 0x44, 0x49, 0x6a, 0x1d,
-		0xd1, 0xb2, 0x80, 0xc1, 0xd0, 0x0d, 0x42, 0xef, 0xee, 0xda, 0x20, 0xf0, 0x67, 0x34, 0x27, 0xf5,
-		0x41, 0xba, 0x90, 0xff, 0x24, 0x5d, 0x58, 0xac, 0x32, 0xa7, 0xac, 0xb9, 0xb0, 0x98, 0xbf, 0x65,
-		0xd4, 0xa6, 0x8f, 0x2d, 0xa8, 0x88, 0x43, 0x2d, 0x5b, 0x48, 0x11, 0x55, 0x45, 0xc3, 0x16, 0xfb,
-		0x0c, 0x5b, 0x14, 0xf1, 0x4d, 0xd4, 0x26, 0x4b, 0xea, 0x93, 0xa6, 0x5a, 0x26, 0x4f, 0x6a, 0x93,
-		0xa8, 0x8a, 0x60, 0x62, 0x2e, 0xdb, 0x23, 0xd3, 0x14, 0x7b, 0x15, 0x4c, 0x31, 0x97, 0xfd, 0xa1,
-		0x2d, 0xf0, 0xe5, 0x58, 0x60, 0x11, 0x5a, 0xf1, 0x92, 0x0d, 0xf1, 0x80, 0x17, 0x16, 0xec, 0x16,
-		0xf5, 0xa8, 0x84, 0x7e, 0x34, 0x84, 0x82, 0x54, 0x99, 0x66, 0xd5, 0x87, 0x8a, 0xd4, 0x32, 0xe9,
-		0x6a, 0x0e, 0x25, 0x51, 0x03, 0x52, 0xe1, 0x39, 0x56, 0x0d, 0x94, 0x41, 0x51, 0xea, 0x96, 0xfd,
-		0xfe, 0x2d, 0x18, 0x28, 0x81, 0xb2, 0xec, 0x40, 0xd6, 0x95, 0xd7, 0x0a, 0xec, 0x1e, 0x85, 0x69,
-		0x46, 0x55, 0xf6, 0x69, 0x3d, 0xc0, 0xce, 0x51, 0x9a, 0x12, 0x8a, 0xb6, 0x9f, 0x75, 0x9c, 0x02,
-		0x0c, 0xe5, 0x6e, 0x0d, 0x43, 0x21, 0xe4, 0x03, 0xf9, 0xf1, 0x9f, 0x00, 0x49, 0x08, 0x57, 0x9e,
-		0xa6, 0x9b, 0x95, 0xfe, 0xf0, 0x51, 0xb6, 0x92, 0x33, 0xa8, 0xfc, 0x53, 0xdb, 0x75, 0x1c, 0xf7,
-		0x27, 0x17, 0xa3, 0x96, 0xcf, 0x07, 0x0e, 0x17, 0xa3, 0x9b, 0x9b, 0x95, 0x6d, 0x4d, 0xe7, 0xa7,
-		0xf3, 0x9c, 0xc3, 0xd2, 0x1f, 0x67, 0x77, 0x79, 0xf1, 0x5d, 0x29, 0x9e, 0xa7, 0xef, 0xf8, 0xc0,
-		0x1c, 0x6e, 0xc5, 0x27, 0xe2, 0xdb, 0x8c, 0x3b, 0x3e, 0x70, 0x1b, 0xe6, 0xbf, 0x17, 0x46, 0x2c,
-		0xe1, 0x4a, 0x08, 0x04, 0xff, 0x33, 0xc0, 0xf9, 0x96, 0x76, 0x85, 0x9b, 0x5f, 0xab, 0x5a, 0x68,
-		0xf9, 0xc0, 0x5d, 0xca, 0xfe, 0x56, 0xec, 0xad, 0x74, 0xf7, 0x1b, 0x98, 0x15, 0xaa, 0x44, 0xe0,
-		0x95, 0xb9, 0xa1, 0xc9, 0x28, 0x67, 0x0e, 0x94, 0x15, 0x89, 0x6a, 0x3c, 0xad, 0x2e, 0x94, 0xe5,
-		0x3e, 0x35, 0x35, 0xce, 0xd4, 0xe8, 0xb7, 0x71, 0x5e, 0x56, 0xec, 0x9b, 0x43, 0xbd, 0x18, 0x20,
-		0x78, 0x38, 0x45, 0x16, 0xba, 0xe7, 0xa6, 0xed, 0x42, 0x35, 0x52, 0x55, 0x17, 0x02, 0xb5, 0xbf,
-		0x0d, 0x7b, 0xf1, 0xbb, 0x8c, 0xc5, 0x32, 0x53, 0x0f, 0x7d, 0x14, 0x43, 0xac, 0xe2, 0x9b, 0x6f,
-		0xdd, 0x29, 0x38, 0xf8, 0x80, 0x4e, 0x68, 0xe5, 0xf1, 0x03, 0x65, 0xfd, 0xf8, 0x71, 0xfc, 0x9a,
-		0x4d, 0x22, 0xc8, 0x99, 0xfd, 0xa8, 0x3e, 0xea, 0xf9, 0xf5, 0x87, 0xfc, 0x8a, 0x4a, 0x51, 0x25,
-		0x25, 0x63, 0x3c, 0xf2, 0x6b, 0x27, 0xab, 0xfd, 0x59, 0xbc, 0xed, 0xd2, 0x7b, 0x19, 0xd1, 0xa3,
-		0x07, 0xad, 0x68, 0x4a, 0xb5, 0xf1, 0x56, 0xcb, 0x33, 0xee, 0x45, 0xab, 0xb5, 0x5e, 0x6d, 0x9e,
-		0x35, 0x8c, 0x43, 0x57, 0x58, 0x20, 0xd3, 0x11, 0x4e, 0x4f, 0x90, 0x84, 0x16, 0x44, 0x33, 0x3a,
-		0x57, 0xc4, 0x87, 0xcb, 0x47, 0xcf, 0x5b, 0xb0, 0xf6, 0xfc, 0x0b, 0xf8, 0x68, 0x71, 0x09, 0xfe,
-		0x6c, 0x32, 0x70, 0x1d, 0xf0, 0xc7, 0x6e, 0xe0, 0x58, 0x73, 0x13, 0x7a, 0xe0, 0x61, 0xc4, 0xdf,
-		0xf8, 0xf5, 0x6c, 0xf4, 0x6d, 0x81, 0xb6, 0xad, 0x31, 0x5b, 0xf3, 0xd0, 0xb5, 0xfc, 0x93, 0xef,
-		0x8a, 0x34, 0x99, 0x0c, 0x96, 0x91, 0xf5, 0xb6, 0xf0, 0xe4, 0xba, 0xfc, 0x6a, 0xd7, 0xb6, 0xc2,
-		0x86, 0x91, 0x9c, 0xa6, 0x58, 0xbc, 0x68, 0x2e, 0x6e, 0x47, 0x5d, 0x35, 0xb7, 0x26, 0xcc, 0x82,
-		0xda, 0x56, 0xbb, 0xa8, 0xb6, 0x65, 0xd6, 0x52, 0xdb, 0x3a, 0xc4, 0xd2, 0x56, 0x5d, 0x95, 0xad,
-		0x42, 0x56, 0x24, 0xfd, 0x08, 0x42, 0xc2, 0xd1, 0x83, 0x44, 0x66, 0x3b, 0x0d, 0x36, 0xa5, 0x43,
-		0xd4, 0x0b, 0xba, 0x76, 0xbb, 0x4d, 0xdd, 0x48, 0xa9, 0x2c, 0x97, 0x4f, 0x9d, 0xc3, 0xf7, 0x44,
-		0xc3, 0x7c, 0xd5, 0xbb, 0x6b, 0xee, 0x67, 0x77, 0xeb, 0x25, 0x26, 0x90, 0x57, 0x00, 0x9b, 0x03,
-		0xda, 0x12, 0x60, 0x73, 0x40, 0xf7, 0x66, 0xf1, 0x81, 0xf9, 0x51, 0x31, 0xc8, 0xb5, 0x13, 0xe7,
-		0xc6, 0x25, 0x4e, 0x2a, 0xaf, 0x06, 0xd6, 0x8e, 0xad, 0xaa, 0x63, 0x2b, 0x3e, 0xd2, 0x27, 0xaa,
-		0x9c, 0xd3, 0x4f, 0xf5, 0xa1, 0x14, 0xda, 0xd7, 0xcf, 0xf3, 0x1d, 0x27, 0xca, 0xa1, 0x52, 0xa5,
-		0xd7, 0xe7, 0xf9, 0xd6, 0x5e, 0x22, 0xd4, 0xab, 0xc6, 0x2a, 0x95, 0xd4, 0x2a, 0xad, 0x2b, 0x68,
-		0xeb, 0x75, 0x05, 0x1b, 0x71, 0xb9, 0xd7, 0xd3, 0x0b, 0x0b, 0x6a, 0xc0, 0x9a, 0xc2, 0xb9, 0xc7,
-		0xd5, 0x50, 0x6d, 0xd9, 0xd8, 0xd5, 0x50, 0x99, 0xeb, 0x91, 0x54, 0x07, 0x16, 0xeb, 0xc6, 0xb8,
-		0x00, 0x99, 0x78, 0xf6, 0xba, 0x7d, 0xba, 0xa9, 0x7d, 0xfa, 0xc1, 0xfa, 0x74, 0xbd, 0x5c, 0xec,
-		0xc8, 0xdd, 0xba, 0x5e, 0x2e, 0x56, 0x11, 0xa0, 0x2e, 0xd8, 0x72, 0x2b, 0xef, 0xb4, 0x54, 0xbd,
-		0xeb, 0xd6, 0x2a, 0x10, 0x9c, 0xe0, 0xb0, 0x97, 0xb9, 0x33, 0xda, 0x42, 0x74, 0xf8, 0xdd, 0xef,
-		0xe1, 0x43, 0xe2, 0x23, 0x75, 0xdf, 0xd5, 0xc4, 0xaf, 0xaf, 0x84, 0x6f, 0x67, 0xc3, 0xcb, 0xc4,
-		0x7e, 0x50, 0xa0, 0xee, 0x30, 0x47, 0xe8, 0xcb, 0x5b, 0x77, 0xfa, 0x19, 0x1f, 0xd0, 0xd9, 0x0e,
-		0x76, 0xaf, 0xb5, 0x2b, 0x82, 0xbb, 0xa3, 0x43, 0xc9, 0x98, 0x8c, 0xf2, 0x86, 0x05, 0xe6, 0x7d,
-		0x2a, 0x5c, 0x09, 0x1e, 0x0e, 0xdd, 0xc9, 0x04, 0x85, 0x85, 0x16, 0x0c, 0x02, 0x99, 0xd2, 0x13,
-		0xfc, 0x60, 0x3a, 0x75, 0x3d, 0x89, 0xd6, 0xd9, 0x16, 0x38, 0xbb, 0xbd, 0x0d, 0xce, 0x6e, 0x1f,
-		0x2d, 0x9c, 0xbd, 0x35, 0x68, 0x17, 0x07, 0xe9, 0xbc, 0xa0, 0x6c, 0x7c, 0x63, 0x52, 0xa2, 0x27,
-		0xb6, 0x46, 0x61, 0xe3, 0x47, 0xbf, 0xf5, 0xdf, 0xbb, 0xbf, 0xae, 0x9e, 0x5a, 0x3f, 0xda, 0xad,
-		0xb7, 0x77, 0xff, 0x30, 0x8a, 0x8a, 0x3e, 0x27, 0xab, 0x9f, 0x92, 0x7e, 0x6c, 0x33, 0x0e, 0x83,
-		0xfb, 0x9f, 0xd8, 0x3d, 0x7e, 0x77, 0xdd, 0xcd, 0xd1, 0x5b, 0x37, 0x18, 0x63, 0xf9, 0xab, 0x15,
-		0x93, 0xf8, 0x80, 0x0f, 0x7c, 0x98, 0x18, 0xc1, 0xd3, 0xc9, 0xd3, 0xdf, 0x00, 0x00, 0x00, 0xff,
-		0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xf8, 0x5b, 0x38, 0xe1, 0xd3, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x5c, 0x79, 0x6f, 0xdb, 0x36,
+		0x14, 0xff, 0x2a, 0x84, 0xfe, 0x69, 0x32, 0xd4, 0xb1, 0xa4, 0x58, 0xe9, 0x81, 0xb6, 0x98, 0x7b,
+		0x6d, 0x43, 0x4f, 0xb4, 0x59, 0x81, 0x2d, 0x08, 0x06, 0xda, 0xa2, 0x6c, 0xa2, 0x32, 0xe5, 0x4a,
+		0x54, 0x0e, 0x04, 0xf9, 0xee, 0x7b, 0x8f, 0x94, 0x2c, 0xc9, 0xa2, 0x1d, 0x29, 0x76, 0x32, 0xa5,
+		0x0b, 0x10, 0x24, 0x12, 0xc5, 0xe3, 0x5d, 0x7c, 0xef, 0xf7, 0x78, 0xe4, 0xc2, 0xfa, 0x48, 0x67,
+		0xcc, 0x7a, 0x4a, 0x2c, 0x9f, 0x9d, 0xf0, 0x31, 0xb3, 0x1e, 0x12, 0xeb, 0x1d, 0x17, 0x3e, 0x94,
+		0x38, 0xf0, 0xf8, 0x2a, 0x12, 0x01, 0x9f, 0xc0, 0x8b, 0x0d, 0x2f, 0xaf, 0x79, 0x0c, 0x4f, 0x17,
+		0xd6, 0x38, 0x12, 0xd2, 0xa1, 0xea, 0x31, 0x6f, 0x9c, 0x15, 0x61, 0x25, 0x96, 0x8c, 0x63, 0x3e,
+		0x97, 0x3c, 0x12, 0xf8, 0xe1, 0x70, 0xca, 0x88, 0x8c, 0xe6, 0x24, 0x64, 0x27, 0x2c, 0x24, 0x58,
+		0x8d, 0x72, 0xc1, 0xe2, 0x75, 0xc3, 0x7c, 0x8e, 0x59, 0xc0, 0xcf, 0x2a, 0xdd, 0x4b, 0x07, 0x1b,
+		0x7c, 0x8d, 0xd2, 0x78, 0xcc, 0xd4, 0x87, 0x77, 0xec, 0xfc, 0x34, 0x8a, 0xb1, 0xb9, 0x35, 0xd7,
+		0xd5, 0xe1, 0xfb, 0xef, 0x34, 0x19, 0xc6, 0x93, 0x74, 0xc6, 0x84, 0x84, 0x0f, 0x32, 0x4e, 0x19,
+		0x14, 0x96, 0x4a, 0xb0, 0x9b, 0xcb, 0xcb, 0x25, 0x46, 0xdc, 0x3a, 0x23, 0xee, 0x2a, 0x46, 0x5c,
+		0xe1, 0x77, 0x93, 0x91, 0x90, 0xd1, 0x60, 0x89, 0x91, 0xac, 0xa8, 0xce, 0xc8, 0x47, 0xe8, 0x25,
+		0xe6, 0x63, 0x82, 0x15, 0x08, 0x17, 0x09, 0xf7, 0x19, 0x79, 0x95, 0xb3, 0x43, 0xf2, 0x26, 0x01,
+		0x4d, 0x43, 0x1c, 0xea, 0xc8, 0x72, 0xad, 0xe3, 0x82, 0x47, 0xfb, 0xf6, 0x79, 0x3c, 0x3c, 0x9f,
+		0xb3, 0x4a, 0xff, 0x29, 0x17, 0xf2, 0x71, 0x49, 0xee, 0x1e, 0x3c, 0x7e, 0xa1, 0x62, 0x82, 0x1f,
+		0x8f, 0x2e, 0xac, 0x0f, 0x5c, 0xa8, 0xea, 0xdf, 0x68, 0x98, 0xb2, 0x4c, 0x2d, 0x6f, 0x63, 0x3a,
+		0x46, 0xfe, 0x5f, 0xf3, 0x09, 0x97, 0x49, 0x46, 0xfa, 0x47, 0x36, 0xa1, 0x92, 0x9f, 0x60, 0x9d,
+		0x80, 0x86, 0x09, 0xc3, 0xc1, 0x3e, 0xd0, 0xb3, 0x4a, 0xe3, 0xfd, 0xc6, 0x8d, 0xa1, 0xb5, 0x61,
+		0xec, 0x8d, 0x06, 0x77, 0x5a, 0x8c, 0x7e, 0x8c, 0x1d, 0xb0, 0x33, 0x19, 0xd3, 0x5e, 0x2a, 0x12,
+		0x49, 0x47, 0xa1, 0x16, 0x1a, 0xc8, 0x9b, 0xc5, 0x4c, 0x8c, 0x33, 0xe1, 0xe4, 0x32, 0xfc, 0xf2,
+		0xf6, 0x15, 0x39, 0xb0, 0x07, 0xf6, 0x6a, 0x4d, 0x15, 0x0d, 0x9b, 0x28, 0x6b, 0xd1, 0x1f, 0x52,
+		0x82, 0xa4, 0x28, 0xf3, 0x1b, 0xd5, 0x2d, 0x72, 0x64, 0xb0, 0xc8, 0x6f, 0x51, 0x28, 0xe9, 0x84,
+		0xad, 0xb5, 0xc8, 0xff, 0xce, 0xfe, 0x3e, 0x50, 0xe1, 0x53, 0x19, 0xc5, 0xe7, 0x99, 0x2d, 0xd5,
+		0xec, 0xd1, 0x67, 0x63, 0x3e, 0xa3, 0xe1, 0xc1, 0xa0, 0xec, 0x0b, 0x5c, 0x93, 0xea, 0xf6, 0xaf,
+		0x61, 0xa9, 0xfb, 0x0d, 0x8d, 0xc5, 0xb5, 0x6d, 0xbb, 0x71, 0xfb, 0xb2, 0x92, 0xc6, 0x75, 0x25,
+		0x8d, 0x0d, 0x4a, 0xfa, 0xc2, 0xa8, 0x4f, 0x22, 0x11, 0x9e, 0xb7, 0x56, 0x93, 0xfb, 0xdf, 0xb8,
+		0x89, 0x44, 0xc6, 0x5c, 0x4c, 0xca, 0x3a, 0x79, 0x5c, 0x30, 0xed, 0xd7, 0x99, 0xf6, 0x0d, 0x4c,
+		0x0f, 0x45, 0x24, 0xa7, 0xc0, 0x5c, 0xa6, 0xe2, 0xee, 0x19, 0xe7, 0xcf, 0x66, 0x8c, 0xac, 0xae,
+		0x17, 0x66, 0xd0, 0x8b, 0x32, 0xc1, 0x90, 0x27, 0xf2, 0x0e, 0x68, 0x04, 0xa2, 0x95, 0x73, 0x50,
+		0xa2, 0xc9, 0xbd, 0x42, 0xee, 0x66, 0xc9, 0x2d, 0x79, 0x7d, 0x24, 0x60, 0x85, 0xe0, 0x5b, 0xc6,
+		0x8c, 0xf7, 0x20, 0xc5, 0xa1, 0x94, 0x1a, 0x47, 0x00, 0x31, 0x6f, 0x42, 0x86, 0x2c, 0xe5, 0xad,
+		0x60, 0x80, 0x52, 0x89, 0xf3, 0x78, 0x30, 0x38, 0x78, 0x34, 0x18, 0xd8, 0x8f, 0xf6, 0x1f, 0xd9,
+		0x4f, 0x3c, 0xcf, 0x39, 0x70, 0x30, 0xfa, 0x7e, 0x8a, 0x7d, 0x88, 0x15, 0xfe, 0x4b, 0x74, 0x91,
+		0x22, 0x0d, 0xc3, 0x42, 0x9f, 0x41, 0x5d, 0x9f, 0x81, 0x41, 0x9f, 0x23, 0x2e, 0x68, 0x5c, 0xf5,
+		0x2c, 0xe3, 0xee, 0x6a, 0x54, 0x53, 0x5b, 0x22, 0xea, 0x09, 0xca, 0x91, 0x89, 0x89, 0x9c, 0x9a,
+		0x75, 0xea, 0xda, 0x9b, 0xc0, 0x00, 0xb7, 0x95, 0x4a, 0x17, 0xa2, 0x9f, 0xd4, 0x45, 0x3f, 0x31,
+		0x88, 0xfe, 0x65, 0x14, 0xc1, 0x37, 0xd1, 0xd1, 0xe0, 0x5b, 0x97, 0xbd, 0x26, 0xb7, 0xec, 0xdd,
+		0x9c, 0x82, 0xe7, 0x69, 0x9d, 0xe7, 0xa9, 0x81, 0xe7, 0x3f, 0x05, 0x3c, 0xdc, 0x15, 0x8e, 0x53,
+		0x24, 0xb6, 0xcc, 0xef, 0x93, 0xa2, 0xd6, 0x51, 0x15, 0x15, 0x57, 0xfc, 0xcc, 0xc1, 0x0d, 0xc2,
+		0x62, 0xa7, 0xad, 0x9b, 0x29, 0xe8, 0x64, 0x02, 0xf3, 0x0f, 0x2a, 0x97, 0x98, 0x1a, 0xc0, 0xf3,
+		0x1b, 0xf8, 0xa4, 0x86, 0x39, 0x8c, 0xbe, 0xea, 0xe0, 0x8d, 0x2f, 0x36, 0xb6, 0xa2, 0xa9, 0x8c,
+		0xb0, 0xba, 0x83, 0x2f, 0x51, 0x10, 0x58, 0x4a, 0x54, 0xd1, 0x1f, 0x4a, 0x76, 0x17, 0xfa, 0xb3,
+		0x1e, 0x1f, 0x3f, 0x42, 0x7f, 0x97, 0x6a, 0x2e, 0x60, 0xad, 0xa1, 0x80, 0x20, 0x4e, 0x33, 0xd5,
+		0x5f, 0x58, 0xc9, 0x78, 0xca, 0x66, 0x74, 0x4e, 0xd5, 0x5c, 0xb5, 0xfa, 0xa0, 0xdc, 0x9e, 0x64,
+		0x89, 0x74, 0xfa, 0x3a, 0x85, 0xed, 0x17, 0x09, 0x20, 0x00, 0x88, 0x74, 0x2c, 0x45, 0x46, 0xf7,
+		0x27, 0x11, 0x1c, 0x62, 0xbd, 0x7f, 0xd0, 0x54, 0x9c, 0xa1, 0xfa, 0xe3, 0x0e, 0xad, 0x85, 0xed,
+		0x39, 0xf5, 0xf4, 0xcb, 0x98, 0x10, 0xbf, 0x37, 0x5a, 0x9d, 0xd3, 0x35, 0xab, 0x33, 0xa0, 0xa7,
+		0x2b, 0x9c, 0x9c, 0xb7, 0x99, 0x41, 0xb5, 0xf6, 0x71, 0x10, 0xb7, 0x96, 0x53, 0x5e, 0x5d, 0x64,
+		0x80, 0x71, 0x24, 0xe1, 0xb3, 0x79, 0xc8, 0x34, 0x64, 0x88, 0x02, 0x0c, 0x2e, 0x20, 0xdd, 0x54,
+		0x9b, 0x21, 0xe1, 0x92, 0xcd, 0x92, 0x6e, 0x24, 0xf2, 0x99, 0xb5, 0x15, 0xe3, 0xa8, 0x02, 0xf3,
+		0x6a, 0x84, 0xc6, 0x3f, 0x09, 0xf9, 0xce, 0xce, 0x99, 0x4f, 0x46, 0xe7, 0x24, 0xaf, 0x7b, 0xa7,
+		0xcd, 0x68, 0xb0, 0x89, 0x19, 0x3d, 0x6e, 0x6b, 0x45, 0x31, 0x3a, 0xc8, 0xde, 0x8c, 0x56, 0xa5,
+		0x52, 0x94, 0x9a, 0x6c, 0x09, 0xca, 0xc9, 0x09, 0x0e, 0x48, 0x82, 0x28, 0x26, 0x90, 0x1f, 0x10,
+		0x55, 0xdf, 0xba, 0xd3, 0xab, 0x24, 0x9b, 0x21, 0x14, 0xcf, 0xbb, 0xa6, 0xe0, 0x33, 0x2a, 0x96,
+		0x04, 0xcf, 0xc5, 0xba, 0x49, 0xac, 0x2a, 0x11, 0x19, 0x11, 0xf4, 0xdb, 0x24, 0x4e, 0x43, 0x96,
+		0x80, 0x43, 0x25, 0x7f, 0x0d, 0x3f, 0xfe, 0xb6, 0x47, 0x80, 0x2f, 0x32, 0x4b, 0xa1, 0x7c, 0xc4,
+		0xc8, 0xb3, 0xe7, 0x24, 0xd3, 0x61, 0x87, 0x57, 0x0f, 0xba, 0xae, 0x27, 0xb6, 0x9c, 0x2c, 0xeb,
+		0x12, 0x93, 0x7e, 0x16, 0x4b, 0x46, 0xa8, 0x1c, 0x9d, 0x53, 0xa3, 0x62, 0x70, 0x86, 0xb8, 0xd4,
+		0xb8, 0x64, 0xda, 0x85, 0x89, 0x82, 0x84, 0x42, 0x37, 0x65, 0x1f, 0xf5, 0x08, 0x29, 0xc9, 0x11,
+		0x42, 0x05, 0x17, 0xf4, 0xb3, 0xa5, 0x02, 0xd5, 0x95, 0x3c, 0xeb, 0xcd, 0xa3, 0x53, 0x16, 0x57,
+		0xc9, 0xcd, 0x0b, 0x0d, 0x4e, 0x1b, 0x2c, 0x37, 0x99, 0x71, 0x49, 0x16, 0x15, 0x3a, 0xe6, 0x2f,
+		0x6e, 0x0d, 0x3f, 0xb6, 0x4c, 0x69, 0xb0, 0x0b, 0x60, 0xb7, 0x1c, 0x0f, 0x5b, 0xa5, 0xad, 0x03,
+		0x53, 0x8e, 0x6a, 0x5e, 0x2f, 0x45, 0xdf, 0x51, 0x05, 0xd6, 0x00, 0x4a, 0x47, 0x2c, 0xde, 0xd9,
+		0xeb, 0x4b, 0xe7, 0xe9, 0xc2, 0x3f, 0xed, 0xa2, 0x73, 0x31, 0x7c, 0xa1, 0x67, 0xbb, 0x75, 0xc5,
+		0x97, 0x94, 0x38, 0x85, 0x78, 0x0d, 0x3f, 0x54, 0xfb, 0x28, 0x18, 0x57, 0x2a, 0x22, 0x57, 0xab,
+		0xd6, 0x2f, 0xf5, 0xd4, 0x48, 0xbf, 0xab, 0x46, 0x50, 0x42, 0x7c, 0x13, 0xc7, 0x51, 0x3c, 0x9c,
+		0xcf, 0x0f, 0xe9, 0xa4, 0xee, 0x77, 0x55, 0x8b, 0xd5, 0x94, 0x30, 0x6c, 0xdb, 0xa3, 0xf3, 0x79,
+		0x4f, 0xd2, 0x49, 0x23, 0x5a, 0x4a, 0xbd, 0x16, 0xa3, 0x7f, 0x60, 0x49, 0x42, 0x27, 0xcc, 0xec,
+		0xf6, 0x17, 0xae, 0x1b, 0x7c, 0x7a, 0x02, 0x8e, 0x03, 0xf2, 0x52, 0x08, 0xb1, 0xec, 0x47, 0x4a,
+		0x43, 0xf4, 0x29, 0x95, 0xb8, 0xbc, 0x8e, 0xca, 0x59, 0x36, 0x48, 0x63, 0x2a, 0x5b, 0x0d, 0x7e,
+		0x99, 0x2d, 0x9d, 0xb4, 0xcc, 0x2c, 0x0a, 0x78, 0xba, 0x3e, 0xb3, 0x40, 0xd3, 0x5e, 0x64, 0x16,
+		0xf0, 0x3c, 0xa8, 0x81, 0xdc, 0x81, 0xd1, 0xfd, 0x2a, 0x3c, 0x78, 0xca, 0xe5, 0x14, 0x94, 0x9f,
+		0x39, 0x35, 0x70, 0xbf, 0x3e, 0x3b, 0x6b, 0x0d, 0x6d, 0xd9, 0x96, 0x5c, 0x0d, 0xab, 0x82, 0x5b,
+		0x5e, 0x0d, 0x24, 0xdc, 0x14, 0x45, 0xde, 0x73, 0xf1, 0x5d, 0x85, 0x0f, 0x25, 0x2c, 0x05, 0x69,
+		0x93, 0xd6, 0x9e, 0x72, 0xcb, 0xf4, 0xb7, 0x0e, 0x19, 0xe0, 0x0f, 0x32, 0x9d, 0xc3, 0x93, 0xe6,
+		0x04, 0x9f, 0x94, 0xc2, 0x17, 0x09, 0xe3, 0xa0, 0xbe, 0x3b, 0x32, 0x18, 0xad, 0x5a, 0xeb, 0x1c,
+		0x50, 0x82, 0x2b, 0x16, 0xa8, 0x7b, 0x4a, 0x58, 0xee, 0xd9, 0xba, 0x26, 0x97, 0xf6, 0x68, 0xdf,
+		0xb9, 0xe5, 0x85, 0x31, 0x25, 0xc0, 0xfa, 0x7c, 0xa2, 0xeb, 0x27, 0x14, 0xf8, 0x06, 0x5a, 0x9a,
+		0x5e, 0x2e, 0xa6, 0x5c, 0xe0, 0x62, 0x13, 0xbd, 0x84, 0x04, 0x92, 0x4b, 0xba, 0x32, 0xc5, 0x7c,
+		0x9e, 0xcc, 0x43, 0x7a, 0x5e, 0x4b, 0x23, 0xcb, 0xe5, 0x75, 0x56, 0xd1, 0xd3, 0xa9, 0x17, 0xf0,
+		0x74, 0x59, 0x4d, 0x35, 0xf3, 0x08, 0x85, 0x08, 0xcb, 0x47, 0xa9, 0x64, 0x39, 0xe3, 0x3e, 0x0f,
+		0x14, 0xc6, 0x93, 0xc0, 0x3a, 0x6a, 0x55, 0x7b, 0xc5, 0xff, 0xbd, 0x25, 0x7a, 0x1b, 0x6e, 0x13,
+		0xdf, 0xf2, 0xfa, 0x70, 0x00, 0xf6, 0xeb, 0x54, 0xe4, 0xa5, 0x4b, 0xea, 0x96, 0x01, 0x19, 0x2e,
+		0xe3, 0x13, 0x81, 0x06, 0x4f, 0x1c, 0xd2, 0x43, 0x5b, 0xcf, 0xbd, 0xb3, 0x47, 0xfb, 0x79, 0xa3,
+		0x3b, 0xeb, 0x9c, 0x99, 0xf2, 0xce, 0x9e, 0x7a, 0x52, 0xcc, 0x2c, 0xc4, 0xe3, 0xd6, 0xc4, 0xe3,
+		0x5e, 0x21, 0x1e, 0xd7, 0x24, 0x1e, 0xf7, 0x67, 0x12, 0x8f, 0x6b, 0x55, 0xa0, 0xb8, 0xb2, 0x19,
+		0xb2, 0x10, 0xcd, 0x8d, 0x6c, 0x24, 0x35, 0x05, 0xe9, 0x40, 0xf2, 0x98, 0xca, 0x9d, 0x07, 0x6a,
+		0x4e, 0x90, 0x07, 0x0f, 0x89, 0x76, 0x00, 0x3b, 0x7b, 0x7b, 0xfd, 0x5f, 0x91, 0x7a, 0xee, 0xef,
+		0x3e, 0x24, 0x0f, 0x7a, 0xa5, 0x0f, 0xba, 0x5c, 0xf1, 0xb0, 0xfa, 0x93, 0xbb, 0xbb, 0x4b, 0x9e,
+		0x17, 0xe5, 0x58, 0x5c, 0xf2, 0xa4, 0x0d, 0xa1, 0x7e, 0x42, 0xd5, 0xb2, 0xc5, 0xcd, 0x23, 0x7e,
+		0xf3, 0x40, 0x6b, 0x81, 0x7f, 0x89, 0x1b, 0x5c, 0xcd, 0x9a, 0xd1, 0xad, 0x26, 0x00, 0x86, 0xde,
+		0xd7, 0x27, 0x02, 0xe5, 0x06, 0x39, 0x1a, 0xd7, 0x0d, 0x25, 0xf3, 0x33, 0x3d, 0xc0, 0xec, 0xfa,
+		0x0e, 0x2a, 0xd6, 0x9a, 0x7e, 0x06, 0x0a, 0xe6, 0xfe, 0x8b, 0xde, 0x33, 0xa5, 0xc8, 0xec, 0xaf,
+		0xfb, 0xe2, 0xc1, 0x36, 0x13, 0x84, 0xed, 0x11, 0xb5, 0x41, 0xe2, 0x30, 0xe8, 0x17, 0x40, 0xe5,
+		0xea, 0xf4, 0x61, 0xa0, 0x7f, 0x0f, 0xab, 0x13, 0x56, 0x03, 0xee, 0x9b, 0x9a, 0xa7, 0xd7, 0x61,
+		0xaa, 0x21, 0x37, 0x45, 0x2e, 0xe4, 0xd5, 0xb0, 0x9b, 0x77, 0x45, 0x2e, 0xa4, 0xc1, 0x5a, 0x57,
+		0xf0, 0x59, 0x2d, 0xf0, 0xae, 0x88, 0xbb, 0xca, 0xb5, 0x46, 0xd9, 0x19, 0x07, 0x0f, 0x22, 0x4b,
+		0x0d, 0xd0, 0xdc, 0x23, 0xfe, 0x46, 0x50, 0xa7, 0x16, 0xca, 0x57, 0x44, 0x72, 0x2c, 0xae, 0x08,
+		0x5c, 0x2f, 0xee, 0x74, 0x4e, 0xe0, 0x2d, 0x97, 0x8b, 0xdd, 0x5b, 0xde, 0x94, 0x03, 0x70, 0xe1,
+		0xd5, 0x37, 0x42, 0x3d, 0x53, 0x7e, 0x25, 0x22, 0x8d, 0x9b, 0x8a, 0x1c, 0xe3, 0xde, 0xde, 0xb7,
+		0xb7, 0x4e, 0xaa, 0x1c, 0x48, 0xa7, 0xa0, 0x99, 0x47, 0x49, 0x0d, 0x63, 0xad, 0x46, 0x5f, 0x66,
+		0xf0, 0xa5, 0x8d, 0xe9, 0x27, 0xc0, 0x5d, 0x9a, 0x91, 0x1b, 0x80, 0x5c, 0xd5, 0x8e, 0xd7, 0xa3,
+		0xad, 0xac, 0xee, 0x15, 0x98, 0x06, 0x6a, 0x3c, 0xcb, 0x70, 0xcc, 0xf6, 0xb1, 0xd5, 0x75, 0x49,
+		0xd8, 0x00, 0x49, 0x79, 0xcd, 0x40, 0x87, 0x97, 0x61, 0xa7, 0x61, 0x3a, 0x41, 0x62, 0x99, 0x5f,
+		0xb5, 0xea, 0x22, 0x4f, 0x32, 0xe1, 0x0f, 0xdf, 0x27, 0xb8, 0xe1, 0x95, 0xa2, 0x59, 0x80, 0x57,
+		0x13, 0xec, 0x54, 0x79, 0xb6, 0x04, 0x93, 0xc3, 0xa2, 0x55, 0x17, 0x00, 0xc9, 0x4f, 0xb1, 0xc0,
+		0x7c, 0xf3, 0xf8, 0xf6, 0xae, 0xe3, 0xcf, 0xfb, 0xa8, 0x70, 0x1f, 0x15, 0xfe, 0x27, 0x51, 0x01,
+		0x7f, 0x35, 0xde, 0xdb, 0x8d, 0xe4, 0xce, 0x62, 0x8b, 0xe6, 0x28, 0x61, 0xb2, 0x97, 0x9d, 0x52,
+		0x48, 0x76, 0x82, 0x28, 0x0c, 0xa3, 0x53, 0x18, 0xad, 0x97, 0xf0, 0x51, 0x08, 0x7f, 0x9f, 0x3e,
+		0xad, 0x6c, 0xe6, 0xe4, 0xfb, 0xfc, 0x0f, 0x49, 0xe9, 0x65, 0xf7, 0x78, 0xad, 0x15, 0x9e, 0xd0,
+		0x90, 0xfb, 0xfa, 0xcc, 0x59, 0x40, 0x79, 0x08, 0x86, 0x12, 0x90, 0xbc, 0x29, 0x1a, 0x0d, 0x90,
+		0x43, 0x52, 0xc1, 0x7f, 0xa4, 0x2c, 0xdf, 0x76, 0x28, 0xf6, 0x0c, 0xb7, 0x63, 0x9e, 0xd7, 0xa6,
+		0x60, 0xbd, 0xdd, 0xea, 0xcd, 0xba, 0x6d, 0xef, 0x1f, 0x97, 0xbb, 0x5d, 0x6f, 0xb3, 0x0b, 0x16,
+		0x94, 0xc9, 0x20, 0x13, 0x60, 0x36, 0x31, 0x9b, 0x33, 0x8a, 0x56, 0xd3, 0x40, 0x8e, 0xed, 0x6d,
+		0xb4, 0xe9, 0x90, 0x99, 0x39, 0x82, 0xd3, 0x4e, 0xea, 0xd7, 0xb0, 0x0e, 0x17, 0x37, 0x23, 0x41,
+		0xf6, 0xba, 0x8a, 0x5c, 0xeb, 0xf8, 0x93, 0xc6, 0x37, 0xb2, 0x8c, 0x5d, 0x5f, 0x0b, 0x35, 0x5d,
+		0x89, 0x97, 0xb4, 0x72, 0x54, 0xe5, 0x51, 0x4f, 0xb9, 0xba, 0xfa, 0xdd, 0xd0, 0xfc, 0x83, 0xf1,
+		0x5c, 0x17, 0x83, 0x2a, 0xbe, 0xe9, 0x9a, 0x28, 0x24, 0x82, 0xca, 0xa1, 0x46, 0x42, 0x1f, 0xb6,
+		0x53, 0x7d, 0x14, 0x19, 0x63, 0xb2, 0x47, 0xde, 0xf8, 0x1c, 0xdc, 0xeb, 0xf9, 0x6c, 0x14, 0x85,
+		0x24, 0x99, 0x46, 0x69, 0xe8, 0xe7, 0xba, 0x38, 0xe1, 0x38, 0x71, 0x57, 0x5e, 0x68, 0x75, 0x6f,
+		0xff, 0x82, 0x66, 0xd3, 0x4b, 0x47, 0xcb, 0x6c, 0x76, 0xec, 0x62, 0x55, 0xeb, 0x93, 0x42, 0xb6,
+		0x6d, 0x6f, 0xb8, 0xc1, 0x65, 0xdb, 0xd7, 0x3b, 0x1f, 0x3c, 0xaa, 0xbb, 0xa9, 0x51, 0x93, 0xf3,
+		0xc1, 0x5a, 0x01, 0x6b, 0xcf, 0x05, 0xdf, 0xae, 0xfd, 0x68, 0x98, 0x5d, 0xbd, 0x56, 0x94, 0x01,
+		0xef, 0x95, 0x27, 0x83, 0x97, 0x90, 0xf9, 0xfd, 0xa1, 0xd4, 0x46, 0xcb, 0x57, 0xfb, 0xf5, 0xfb,
+		0x90, 0xfb, 0xe3, 0x15, 0xb3, 0x54, 0x81, 0x91, 0x62, 0xfd, 0x2a, 0x3b, 0xeb, 0x88, 0xd2, 0xef,
+		0xfe, 0x5d, 0xc8, 0x6e, 0x2e, 0x5b, 0x2d, 0x8c, 0xb6, 0x13, 0xbb, 0x14, 0x59, 0xd0, 0xea, 0x17,
+		0x8e, 0x63, 0x5d, 0x1c, 0x7c, 0xf9, 0x15, 0x2b, 0xeb, 0xe3, 0x5b, 0x2f, 0xad, 0x6b, 0x5c, 0x3e,
+		0x29, 0xc5, 0xc8, 0xab, 0xc7, 0x29, 0x0e, 0x12, 0x0d, 0x25, 0xc4, 0xfa, 0xf7, 0x18, 0x38, 0x6b,
+		0x96, 0x5b, 0xfa, 0x64, 0xcc, 0x52, 0xf1, 0xf8, 0x0a, 0x95, 0xca, 0x6a, 0x8b, 0xe8, 0xbb, 0x83,
+		0x01, 0x34, 0x86, 0x98, 0x3c, 0x03, 0xf9, 0xfa, 0x78, 0xb5, 0x20, 0x95, 0x0b, 0x2c, 0x9e, 0xa4,
+		0xf3, 0x79, 0x14, 0x03, 0xc4, 0xd9, 0xbd, 0x13, 0x37, 0x0d, 0x3e, 0x63, 0xde, 0x10, 0x0b, 0xf5,
+		0x8f, 0x0c, 0x8e, 0x86, 0xbd, 0xbf, 0x8f, 0x2f, 0xf6, 0x2f, 0x7b, 0x47, 0x76, 0xef, 0xc9, 0xf1,
+		0x2f, 0x96, 0xf9, 0x7e, 0x10, 0x4f, 0xde, 0xd2, 0xef, 0xec, 0x4b, 0x14, 0x95, 0x08, 0x58, 0x52,
+		0x5b, 0x5d, 0x41, 0xaf, 0xf5, 0xff, 0xce, 0xb8, 0xbc, 0xfc, 0x17, 0xf4, 0x5b, 0xa8, 0x96, 0x4f,
+		0x43, 0x00, 0x00,
 	}
 )
+
+func Test_ExtractPathsDefaultModel(t *testing.T) {
+	schemaTree, err := ygot.GzipToSchema(testdevice10XSchema)
+	assert.NoError(t, err)
+	defer func() {
+		defaultModel = &PathModel{}
+	}()
+
+	// The namespaces do not build up over calls
+	_, _, namespaces := ExtractPaths(schemaTree)
+	assert.ElementsMatch(t, testModel.Namespaces(), namespaces)
+	_, _, namespaces = ExtractPaths(schemaTree)
+	assert.ElementsMatch(t, testModel.Namespaces(), namespaces)
+
+	pathValues, err := GetPathValues("", []byte(`{"cont1a": {"leaf1a": "leaf1aval"}}`))
+	assert.NoError(t, err)
+	assert.Len(t, pathValues, 1)
+	assert.Equal(t, "/t1:cont1a/leaf1a", pathValues[0].Path)
+}
@@ -0,0 +1,285 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"strconv"
+	"strings"
+)
+
+// JSONOption is an option of BuildJSON
+type JSONOption func(o *jsonOptions)
+
+type jsonOptions struct {
+	moduleNames bool
+	modules     map[string]string
+}
+
+// WithModuleNames qualifies the names of the top level nodes, and those of the
+// nodes of another module than their parent, with the name of their module,
+// as RFC 7951 requires. modules maps the prefixes of the paths to the names of
+// their modules; the modules of the top level nodes of the model are known.
+func WithModuleNames(modules map[string]string) JSONOption {
+	return func(o *jsonOptions) {
+		o.moduleNames = true
+		for prefix, module := range modules {
+			o.modules[prefix] = module
+		}
+	}
+}
+
+// BuildJSON builds a JSON tree from path values, with the model of the last
+// call to ExtractPaths
+func BuildJSON(pathValues []*configapi.PathValue, opts ...JSONOption) ([]byte, error) {
+	return defaultModel.BuildJSON(pathValues, opts...)
+}
+
+// BuildJSON builds the RFC 7951 JSON tree of a set of path values; it is the
+// inverse of GetPathValues. The entries of the lists are rebuilt from the
+// indices of the paths, whose keys also take the values of the indices unless
+// there is a path value for them. Deleted path values are ignored.
+func (m *PathModel) BuildJSON(pathValues []*configapi.PathValue, opts ...JSONOption) ([]byte, error) {
+	options := &jsonOptions{modules: make(map[string]string)}
+	for prefix, module := range m.modules {
+		options.modules[prefix] = module
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	root := make(map[string]interface{})
+	// listEntries indexes the list entries by their path, e.g.
+	// /t1:cont1a/list2a[name=l2a1]
+	listEntries := make(map[string]map[string]interface{})
+	for _, pathValue := range pathValues {
+		if pathValue.Deleted {
+			continue
+		}
+		elems := splitPath(pathValue.Path)
+		if len(elems) == 0 {
+			return nil, fmt.Errorf("invalid path %s", pathValue.Path)
+		}
+		node := root
+		var parentModule, pathSoFar string
+		for i, elem := range elems {
+			pathSoFar = fmt.Sprintf("%s/%s", pathSoFar, elem)
+			name, predicates := splitPredicates(elem)
+			module := parentModule
+			if colonIdx := strings.Index(name, colon); colonIdx > 0 {
+				module = options.modules[name[:colonIdx]]
+				name = name[colonIdx+1:]
+			} else if i == 0 {
+				module = m.topModules[name]
+			}
+			memberName := name
+			if options.moduleNames && module != parentModule {
+				if module == "" {
+					return nil, fmt.Errorf("unknown module of %s in %s", elem, pathValue.Path)
+				}
+				memberName = fmt.Sprintf("%s:%s", module, name)
+			}
+			parentModule = module
+
+			if i == len(elems)-1 {
+				value, err := jsonValue(&pathValue.Value)
+				if err != nil {
+					return nil, fmt.Errorf("error encoding %s %v", pathValue.Path, err)
+				}
+				node[memberName] = value
+				continue
+			}
+			if len(predicates) == 0 {
+				child, ok := node[memberName].(map[string]interface{})
+				if !ok {
+					child = make(map[string]interface{})
+					node[memberName] = child
+				}
+				node = child
+				continue
+			}
+			entry, ok := listEntries[pathSoFar]
+			if !ok {
+				entry = make(map[string]interface{})
+				list, _ := node[memberName].([]interface{})
+				node[memberName] = append(list, entry)
+				listEntries[pathSoFar] = entry
+				m.addListKeys(entry, pathSoFar, predicates)
+			}
+			node = entry
+		}
+	}
+	return json.Marshal(root)
+}
+
+// addListKeys sets the keys of a new list entry from the indices of its path
+func (m *PathModel) addListKeys(entry map[string]interface{}, entryPath string, predicates [][2]string) {
+	entryPathNoIndices := stripNamespace(removePathIndices(entryPath))
+	for _, predicate := range predicates {
+		keyName, keyValue := predicate[0], predicate[1]
+		if keyValue == "*" {
+			continue
+		}
+		keyPath := fmt.Sprintf("%s/%s", entryPathNoIndices, keyName)
+		var valueType configapi.ValueType
+		var width uint64
+		if rwPath, ok := m.rwPathsNoIndices[keyPath]; ok {
+			valueType, width = rwPath.ValueType, typeWidth(rwPath.TypeOpts)
+		} else if roPath, ok := m.roPathsNoIndices[keyPath]; ok {
+			valueType, width = roPath.subPath.ValueType, typeWidth(roPath.subPath.TypeOpts)
+		}
+		entry[keyName] = keyValue
+		// RFC 7951 encodes the integers of up to 32 bits as numbers
+		if width <= 32 {
+			switch valueType {
+			case configapi.ValueType_INT:
+				if intVal, err := strconv.ParseInt(keyValue, 10, 64); err == nil {
+					entry[keyName] = intVal
+				}
+			case configapi.ValueType_UINT:
+				if uintVal, err := strconv.ParseUint(keyValue, 10, 64); err == nil {
+					entry[keyName] = uintVal
+				}
+			}
+		}
+	}
+}
+
+func typeWidth(typeOpts []uint64) uint64 {
+	if len(typeOpts) == 0 {
+		return 0
+	}
+	return typeOpts[0]
+}
+
+// jsonValue encodes a value as in RFC 7951: 64 bit integers and decimals are
+// strings, so that they do not lose precision, and binary values are base64
+func jsonValue(tv *configapi.TypedValue) (interface{}, error) {
+	width := configapi.WidthThirtyTwo
+	if len(tv.TypeOpts) > 0 {
+		width = configapi.Width(tv.TypeOpts[0])
+	}
+	switch tv.Type {
+	case configapi.ValueType_STRING:
+		return (*configapi.TypedString)(tv).String(), nil
+	case configapi.ValueType_BOOL:
+		return (*configapi.TypedBool)(tv).Bool(), nil
+	case configapi.ValueType_INT:
+		return jsonInt(int64((*configapi.TypedInt)(tv).Int()), width), nil
+	case configapi.ValueType_UINT:
+		return jsonUint(uint64((*configapi.TypedUint)(tv).Uint()), width), nil
+	case configapi.ValueType_DECIMAL:
+		return formatDecimal64((*configapi.TypedDecimal)(tv).Decimal64()), nil
+	case configapi.ValueType_FLOAT:
+		return (*configapi.TypedFloat)(tv).Float32(), nil
+	case configapi.ValueType_DOUBLE:
+		return (*configapi.TypedDouble)(tv).Double(), nil
+	case configapi.ValueType_BYTES:
+		return base64.StdEncoding.EncodeToString((*configapi.TypedBytes)(tv).ByteArray()), nil
+	}
+
+	values := make([]interface{}, 0)
+	switch tv.Type {
+	case configapi.ValueType_LEAFLIST_STRING:
+		for _, v := range (*configapi.TypedLeafListString)(tv).List() {
+			values = append(values, v)
+		}
+	case configapi.ValueType_LEAFLIST_BOOL:
+		for _, v := range (*configapi.TypedLeafListBool)(tv).List() {
+			values = append(values, v)
+		}
+	case configapi.ValueType_LEAFLIST_INT:
+		list, listWidth := (*configapi.TypedLeafListInt)(tv).List()
+		for _, v := range list {
+			values = append(values, jsonInt(v, listWidth))
+		}
+	case configapi.ValueType_LEAFLIST_UINT:
+		list, listWidth := (*configapi.TypedLeafListUint)(tv).List()
+		for _, v := range list {
+			values = append(values, jsonUint(v, listWidth))
+		}
+	case configapi.ValueType_LEAFLIST_DECIMAL:
+		digits, precision := (*configapi.TypedLeafListDecimal)(tv).List()
+		for _, d := range digits {
+			values = append(values, formatDecimal64(d, precision))
+		}
+	case configapi.ValueType_LEAFLIST_FLOAT:
+		for _, v := range (*configapi.TypedLeafListFloat)(tv).List() {
+			values = append(values, v)
+		}
+	case configapi.ValueType_LEAFLIST_DOUBLE:
+		for _, v := range (*configapi.TypedLeafListDouble)(tv).ListDouble() {
+			values = append(values, v)
+		}
+	case configapi.ValueType_LEAFLIST_BYTES:
+		for _, v := range (*configapi.TypedLeafListBytes)(tv).List() {
+			values = append(values, base64.StdEncoding.EncodeToString(v))
+		}
+	default:
+		return nil, fmt.Errorf("unhandled value type %v", tv.Type)
+	}
+	return values, nil
+}
+
+func jsonInt(value int64, width configapi.Width) interface{} {
+	if width == configapi.WidthSixtyFour {
+		return strconv.FormatInt(value, 10)
+	}
+	return value
+}
+
+func jsonUint(value uint64, width configapi.Width) interface{} {
+	if width == configapi.WidthSixtyFour {
+		return strconv.FormatUint(value, 10)
+	}
+	return value
+}
+
+// splitPath splits a path in to its elements, ignoring the slashes in the
+// values of the indices
+func splitPath(path string) []string {
+	elems := make([]string, 0)
+	depth, start := 0, 0
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				if i > start {
+					elems = append(elems, path[start:i])
+				}
+				start = i + 1
+			}
+		}
+	}
+	if start < len(path) {
+		elems = append(elems, path[start:])
+	}
+	return elems
+}
+
+// splitPredicates splits an element of a path in to its name and its indices,
+// e.g. list5[key1=five][key2=6]
+func splitPredicates(elem string) (string, [][2]string) {
+	bracketIdx := strings.Index(elem, bracketsq)
+	if bracketIdx < 0 {
+		return elem, nil
+	}
+	predicates := make([][2]string, 0)
+	for _, predicate := range strings.Split(elem[bracketIdx+1:len(elem)-1], "][") {
+		if eqIdx := strings.Index(predicate, equals); eqIdx > 0 {
+			predicates = append(predicates, [2]string{predicate[:eqIdx], predicate[eqIdx+1:]})
+		}
+	}
+	return elem[:bracketIdx], predicates
+}
@@ -0,0 +1,114 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func assertRoundTrip(t *testing.T, model *PathModel, config []byte, opts ...JSONOption) []byte {
+	pathValues, err := model.GetPathValues("", config)
+	assert.NoError(t, err)
+
+	builtJSON, err := model.BuildJSON(pathValues, opts...)
+	assert.NoError(t, err)
+	roundTrip, err := model.GetPathValues("", builtJSON)
+	assert.NoError(t, err)
+
+	assert.Equal(t, len(pathValues), len(roundTrip))
+	values := make(map[string]string)
+	for _, pathValue := range pathValues {
+		values[pathValue.Path] = (&pathValue.Value).ValueToString()
+	}
+	for _, pathValue := range roundTrip {
+		value, ok := values[pathValue.Path]
+		assert.True(t, ok, "unexpected path %s", pathValue.Path)
+		assert.Equal(t, value, (&pathValue.Value).ValueToString(), pathValue.Path)
+	}
+	return builtJSON
+}
+
+func Test_BuildJSONTestdevice(t *testing.T) {
+	sampleConfig, err := ioutil.ReadFile("testdata/sample-testdevice-1-config.json")
+	assert.NoError(t, err)
+	builtJSON := assertRoundTrip(t, testModel, sampleConfig)
+
+	tree := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal(builtJSON, &tree))
+	cont2a := tree["cont1a"].(map[string]interface{})["cont2a"].(map[string]interface{})
+	assert.Equal(t, "0.432", cont2a["leaf2b"])
+	assert.Equal(t, "1.540", cont2a["leaf2d"])
+	assert.Equal(t, []interface{}{5.0, 4.0, 3.0, 2.0, 1.0}, cont2a["leaf2e"])
+	assert.Equal(t, "dGhpcyBpcyBhIHRlc3QgdGVzdAo=", cont2a["leaf2f"])
+	assert.Equal(t, true, cont2a["leaf2g"])
+}
+
+func Test_BuildJSONDevicesim(t *testing.T) {
+	model := devicesimModel(t)
+	builtJSON := assertRoundTrip(t, model, devicesimConfig(t, 3))
+
+	// The keys of the list entries come from the indices of the paths
+	tree := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal(builtJSON, &tree))
+	interfaces := tree["interfaces"].(map[string]interface{})["interface"].([]interface{})
+	assert.Equal(t, 3, len(interfaces))
+	assert.Equal(t, "eth0", interfaces[0].(map[string]interface{})["name"])
+
+	// The module names are those of the top level nodes
+	builtJSON = assertRoundTrip(t, model, devicesimConfig(t, 1), WithModuleNames(nil))
+	tree = make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal(builtJSON, &tree))
+	_, ok := tree["openconfig-interfaces:interfaces"]
+	assert.True(t, ok)
+
+	// 64 bit integers are strings
+	builtJSON, err := model.BuildJSON([]*configapi.PathValue{
+		{Path: "/interfaces/interface[name=eth0]/state/counters/in-octets", Value: *configapi.NewTypedValueUint(12345678901, configapi.WidthSixtyFour)},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"interfaces": {"interface": [{"name": "eth0", "state": {"counters": {"in-octets": "12345678901"}}}]}}`, string(builtJSON))
+
+	// Decimals between -1 and 0 keep their sign
+	temperature := []byte(`{"components": {"component": [{"name": "cpu", "state": {"temperature": {"instant": "-0.5"}}}]}}`)
+	builtJSON = assertRoundTrip(t, model, temperature)
+	assert.JSONEq(t, string(temperature), string(builtJSON))
+	pathValues, err := model.GetPathValues("", builtJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pathValues))
+	digits, precision := (*configapi.TypedDecimal)(&pathValues[0].Value).Decimal64()
+	assert.Equal(t, int64(-5), digits)
+	assert.Equal(t, uint8(1), precision)
+
+	leafList, err := jsonValue(configapi.NewLeafListDecimalTv([]int64{-1, 1540}, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"-0.001", "1.540"}, leafList)
+}
+
+func Test_BuildJSONModuleNames(t *testing.T) {
+	pathValues := []*configapi.PathValue{
+		{Path: "/t1:cont1a/leaf1a", Value: *configapi.NewTypedValueString("leaf1aval")},
+		{Path: "/t1:cont1a/t1e:list4[id=l4]/id", Value: *configapi.NewTypedValueString("l4")},
+		{Path: "/t1:cont1a/list2a[name=l2a]/tx-power", Value: *configapi.NewTypedValueUint(5, configapi.WidthSixteen)},
+		{Path: "/t1:cont1a/leaf1b", Value: *configapi.NewTypedValueString("deleted"), Deleted: true},
+	}
+	builtJSON, err := testModel.BuildJSON(pathValues, WithModuleNames(map[string]string{
+		"t1e": "onf-test1-extra",
+	}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"onf-test1:cont1a": {
+		"leaf1a": "leaf1aval",
+		"onf-test1-extra:list4": [{"id": "l4"}],
+		"list2a": [{"name": "l2a", "tx-power": 5}]
+	}}`, string(builtJSON))
+
+	_, err = testModel.BuildJSON(pathValues, WithModuleNames(nil))
+	assert.EqualError(t, err, "unknown module of t1e:list4[id=l4] in /t1:cont1a/t1e:list4[id=l4]/id")
+}
@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/openconfig/goyang/pkg/yang"
+	"strings"
+)
+
+// PathModel is the read-only and read-write paths of a model, as extracted
+// from its schema, with the lookup tables that GetPathValues uses
+type PathModel struct {
+	roPaths    []*admin.ReadOnlyPath
+	rwPaths    []*admin.ReadWritePath
+	namespaces []*admin.Namespace
+	// rwPathsNoIndices and roPathsNoIndices index the read-write paths and
+	// read-only subpaths by their path without namespaces and indices, e.g.
+	// /cont1a/list2a/name
+	rwPathsNoIndices map[string]*admin.ReadWritePath
+	roPathsNoIndices map[string]*roSubPath
+	// indexNames indexes the index names of indicesOfPath by the path
+	// without indices of the parent of the read-only paths
+	indexNames map[string][]string
+	// modules and topModules map the prefixes and the names of the top level
+	// nodes to the names of their modules, for BuildJSON
+	modules    map[string]string
+	topModules map[string]string
+	// enums indexes the values of the enumeration and identityref leaves by
+	// their path without namespaces and indices
+	enums map[string]*enumValues
+	// unions indexes the member types of the union leaves in the same way
+	unions map[string][]*unionMember
+	// defaults indexes the read-write leaves that have a default by the path
+	// without namespaces and indices of their parent, for
+	// GetEffectivePathValues
+	defaults map[string][]*admin.ReadWritePath
+	// cases indexes the choice cases that the leaves with a default are in,
+	// from the outermost one, by the path of the leaves without namespaces
+	cases map[string][]*choiceCase
+}
+
+// roSubPath is a read-only subpath with the full path of the model, e.g.
+// /t1:cont1b-state/list2b[index=*]/leaf3c
+type roSubPath struct {
+	subPath  *admin.ReadOnlySubPath
+	fullPath string
+}
+
+// NewPathModel parses the schema entries out in to flat paths
+func NewPathModel(entries map[string]*yang.Entry, opts ...ModelOption) (*PathModel, error) {
+	roPaths, rwPaths, namespaceMappings, err := extractPaths(entries["Device"], yang.TSUnset, "", "")
+	if err != nil {
+		return nil, err
+	}
+	m := &PathModel{
+		roPaths:          roPaths,
+		rwPaths:          rwPaths,
+		namespaces:       make([]*admin.Namespace, 0, len(namespaceMappings)),
+		rwPathsNoIndices: make(map[string]*admin.ReadWritePath, len(rwPaths)),
+		roPathsNoIndices: make(map[string]*roSubPath),
+		indexNames:       make(map[string][]string),
+		modules:          make(map[string]string),
+		topModules:       make(map[string]string),
+		enums:            make(map[string]*enumValues),
+		unions:           make(map[string][]*unionMember),
+		defaults:         make(map[string][]*admin.ReadWritePath),
+		cases:            make(map[string][]*choiceCase),
+	}
+	for k, v := range namespaceMappings {
+		m.namespaces = append(m.namespaces, &admin.Namespace{
+			Module: k,
+			Prefix: v,
+		})
+	}
+	for _, rwPath := range rwPaths {
+		key := stripNamespace(removePathIndices(rwPath.Path))
+		if _, ok := m.rwPathsNoIndices[key]; !ok {
+			m.rwPathsNoIndices[key] = rwPath
+		}
+		if rwPath.Default != "" && !rwPath.IsAKey && !isLeafListType(rwPath.ValueType) {
+			parent := stripNamespace(removePathIndices(parentOfPath(rwPath.Path)))
+			m.defaults[parent] = append(m.defaults[parent], rwPath)
+		}
+	}
+	m.indexReadOnlyPaths()
+	m.indexTopModules(entries["Device"])
+	m.indexTypes(entries["Device"], "")
+	m.indexCases(entries["Device"], "", nil)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
+}
+
+// indexTopModules finds the modules of the top level nodes. The schema of
+// YGOT has no module names but in the schemapath of its directories, which
+// starts with the name of the module of the top level node.
+func (m *PathModel) indexTopModules(device *yang.Entry) {
+	if device == nil {
+		return
+	}
+	for name, dirEntry := range device.Dir {
+		schemaPath, ok := dirEntry.Annotation["schemapath"].(string)
+		if !ok {
+			continue
+		}
+		elems := strings.Split(strings.TrimPrefix(schemaPath, slash), slash)
+		if len(elems) < 2 || elems[0] == "" {
+			continue
+		}
+		m.topModules[name] = elems[0]
+		if dirEntry.Prefix != nil {
+			m.modules[dirEntry.Prefix.Name] = elems[0]
+		}
+	}
+}
+
+// indexReadOnlyPaths fills the lookup tables of the read-only paths; the
+// first path wins, as in a scan of the paths
+func (m *PathModel) indexReadOnlyPaths() {
+	for _, roPath := range m.roPaths {
+		for _, subPath := range roPath.SubPath {
+			fullPath := roPath.Path
+			if subPath.SubPath != slash {
+				fullPath = fmt.Sprintf("%s%s", roPath.Path, subPath.SubPath)
+			}
+			key := stripNamespace(removePathIndices(fullPath))
+			if _, ok := m.roPathsNoIndices[key]; !ok {
+				m.roPathsNoIndices[key] = &roSubPath{subPath: subPath, fullPath: fullPath}
+			}
+		}
+	}
+
+	// The paths of the read-only containers take precedence over those of
+	// their subpaths
+	for _, roPath := range m.roPaths {
+		m.addIndexNames(stripNamespace(removePathIndices(roPath.Path)), roPath.Path)
+	}
+	for _, roPath := range m.roPaths {
+		for _, subPath := range roPath.SubPath {
+			if subPath.SubPath == slash {
+				m.addIndexNames(removePathIndices(roPath.Path), roPath.Path)
+			}
+		}
+	}
+}
+
+func (m *PathModel) addIndexNames(pathNoIndices string, path string) {
+	parent := pathNoIndices[:strings.LastIndex(pathNoIndices, slash)]
+	if _, ok := m.indexNames[parent]; !ok {
+		m.indexNames[parent], _ = ExtractIndexNames(path)
+	}
+}
+
+// ReadOnlyPaths returns the read-only paths of the model
+func (m *PathModel) ReadOnlyPaths() []*admin.ReadOnlyPath {
+	return m.roPaths
+}
+
+// ReadWritePaths returns the read-write paths of the model
+func (m *PathModel) ReadWritePaths() []*admin.ReadWritePath {
+	return m.rwPaths
+}
+
+// Namespaces returns the prefixes of the modules of the model
+func (m *PathModel) Namespaces() []*admin.Namespace {
+	return m.namespaces
+}
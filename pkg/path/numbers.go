@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const rangeSeparator = ".."
+
+// numberString is the text of a number of the JSON. RFC 7951 encodes 64 bit
+// integers and decimal64 values as strings, and the other ones as numbers,
+// which GetPathValues decodes as json.Number so that they are exact.
+func numberString(value interface{}) (string, bool) {
+	switch valueTyped := value.(type) {
+	case string:
+		return valueTyped, true
+	case json.Number:
+		return valueTyped.String(), true
+	}
+	return "", false
+}
+
+// intValue converts an integer value of the JSON, and checks it against the
+// width and the ranges of the leaf
+func intValue(value interface{}, width uint64, ranges []string, parentPath string) (int64, error) {
+	number, ok := numberString(value)
+	if !ok {
+		return 0, fmt.Errorf("unhandled conversion of %v for %s to int%d", value, parentPath, width)
+	}
+	intVal, err := strconv.ParseInt(number, 10, int(width))
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s for %s is out of the range of int%d", number, parentPath, width)
+	} else if err != nil {
+		return 0, fmt.Errorf("value %s for %s is not an int%d", number, parentPath, width)
+	}
+	minVal, maxVal := int64(-1)<<(width-1), int64(1)<<(width-1)-1
+	inRange, err := valueInRange(ranges, strconv.FormatInt(minVal, 10), strconv.FormatInt(maxVal, 10),
+		func(bound string) (int, error) {
+			boundVal, err := strconv.ParseInt(bound, 10, 64)
+			return compareInt64(intVal, boundVal), err
+		})
+	if err != nil {
+		return 0, fmt.Errorf("%s of %s", err, parentPath)
+	} else if !inRange {
+		return 0, outOfRangeError(number, parentPath, ranges)
+	}
+	return intVal, nil
+}
+
+// uintValue converts an unsigned integer value of the JSON, and checks it
+// against the width and the ranges of the leaf
+func uintValue(value interface{}, width uint64, ranges []string, parentPath string) (uint64, error) {
+	number, ok := numberString(value)
+	if !ok {
+		return 0, fmt.Errorf("unhandled conversion of %v for %s to uint%d", value, parentPath, width)
+	}
+	uintVal, err := strconv.ParseUint(number, 10, int(width))
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s for %s is out of the range of uint%d", number, parentPath, width)
+	} else if err != nil {
+		return 0, fmt.Errorf("value %s for %s is not a uint%d", number, parentPath, width)
+	}
+	maxVal := uint64(math.MaxUint64) >> (64 - width)
+	inRange, err := valueInRange(ranges, "0", strconv.FormatUint(maxVal, 10),
+		func(bound string) (int, error) {
+			boundVal, err := strconv.ParseUint(bound, 10, 64)
+			return compareUint64(uintVal, boundVal), err
+		})
+	if err != nil {
+		return 0, fmt.Errorf("%s of %s", err, parentPath)
+	} else if !inRange {
+		return 0, outOfRangeError(number, parentPath, ranges)
+	}
+	return uintVal, nil
+}
+
+// decimalValue converts a decimal64 value of the JSON in to its digits at the
+// precision of the leaf, and checks it against the ranges of the leaf
+func decimalValue(value interface{}, precision uint8, ranges []string, parentPath string) (int64, error) {
+	decimal, ok := numberString(value)
+	if !ok {
+		return 0, fmt.Errorf("unhandled conversion of %v for %s to decimal64", value, parentPath)
+	}
+	digits, err := parseDecimal64(decimal, precision)
+	if err != nil {
+		return 0, fmt.Errorf("value %s for %s %v", decimal, parentPath, err)
+	}
+	inRange, err := valueInRange(ranges, formatDecimal64(math.MinInt64, precision), formatDecimal64(math.MaxInt64, precision),
+		func(bound string) (int, error) {
+			boundDigits, err := parseDecimal64(bound, precision)
+			return compareInt64(digits, boundDigits), err
+		})
+	if err != nil {
+		return 0, fmt.Errorf("%s of %s", err, parentPath)
+	} else if !inRange {
+		return 0, outOfRangeError(decimal, parentPath, ranges)
+	}
+	return digits, nil
+}
+
+// parseDecimal64 parses a decimal64, e.g. -12.345, in to its digits at a
+// precision, e.g. -1234500 at 5 fraction digits, without rounding. Zeros
+// beyond the precision are ignored; other fraction digits are an error.
+func parseDecimal64(decimal string, precision uint8) (int64, error) {
+	sign := ""
+	unsigned := decimal
+	if strings.HasPrefix(unsigned, "-") {
+		sign, unsigned = "-", unsigned[1:]
+	}
+	integer, fraction := unsigned, ""
+	if dotIdx := strings.Index(unsigned, "."); dotIdx >= 0 {
+		integer, fraction = unsigned[:dotIdx], unsigned[dotIdx+1:]
+		if fraction == "" {
+			return 0, fmt.Errorf("is not a decimal64")
+		}
+	}
+	if integer == "" || !isDigits(integer) || !isDigits(fraction) {
+		return 0, fmt.Errorf("is not a decimal64")
+	}
+	if len(fraction) > int(precision) {
+		if strings.Trim(fraction[precision:], "0") != "" {
+			return 0, fmt.Errorf("has more than %d fraction digits", precision)
+		}
+		fraction = fraction[:precision]
+	}
+	fraction += strings.Repeat("0", int(precision)-len(fraction))
+	digits, err := strconv.ParseInt(sign+integer+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("is out of the range of decimal64 with %d fraction digits", precision)
+	}
+	return digits, nil
+}
+
+// formatDecimal64 formats the digits of a decimal64 at a precision, e.g.
+// -1234500 at 5 fraction digits as -12.34500, keeping the sign of the
+// values between -1 and 0
+func formatDecimal64(digits int64, precision uint8) string {
+	sign := ""
+	unsigned := uint64(digits)
+	if digits < 0 {
+		sign, unsigned = "-", uint64(-digits)
+	}
+	decimal := strconv.FormatUint(unsigned, 10)
+	if precision == 0 {
+		return sign + decimal
+	}
+	if len(decimal) <= int(precision) {
+		decimal = strings.Repeat("0", int(precision)-len(decimal)+1) + decimal
+	}
+	dotIdx := len(decimal) - int(precision)
+	return sign + decimal[:dotIdx] + "." + decimal[dotIdx:]
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// valueInRange checks a value against the ranges of a leaf, e.g. 1..3 11..13,
+// with a function that compares it to the bounds of the ranges. The min and
+// max bounds are the limits of the type of the leaf, minBound and maxBound.
+// The value is in range if there are no ranges; a bound that cannot be
+// parsed is an error.
+func valueInRange(ranges []string, minBound string, maxBound string, compare func(bound string) (int, error)) (bool, error) {
+	if len(ranges) == 0 {
+		return true, nil
+	}
+	resolve := func(bound string) string {
+		switch bound = strings.TrimSpace(bound); bound {
+		case "min":
+			return minBound
+		case "max":
+			return maxBound
+		}
+		return bound
+	}
+	for _, r := range ranges {
+		bounds := strings.SplitN(r, rangeSeparator, 2)
+		minCmp, err := compare(resolve(bounds[0]))
+		if err != nil {
+			return false, fmt.Errorf("invalid range %s", r)
+		}
+		maxCmp, err := compare(resolve(bounds[len(bounds)-1]))
+		if err != nil {
+			return false, fmt.Errorf("invalid range %s", r)
+		}
+		if minCmp >= 0 && maxCmp <= 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func compareInt64(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func outOfRangeError(value string, parentPath string, ranges []string) error {
+	return fmt.Errorf("value %s for %s is out of range %s", value, parentPath, strings.Join(ranges, " | "))
+}
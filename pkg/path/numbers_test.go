@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_parseDecimal64(t *testing.T) {
+	tests := []struct {
+		decimal   string
+		precision uint8
+		digits    int64
+		errString string
+	}{
+		{decimal: "0.1", precision: 18, digits: 100000000000000000},
+		{decimal: "-12.345", precision: 5, digits: -1234500},
+		{decimal: "1.540", precision: 3, digits: 1540},
+		{decimal: "1.54000", precision: 3, digits: 1540},
+		{decimal: "7", precision: 1, digits: 70},
+		{decimal: "-922337203685477.5808", precision: 4, digits: -9223372036854775808},
+		{decimal: "922337203685477.5808", precision: 4, errString: "is out of the range of decimal64 with 4 fraction digits"},
+		{decimal: "0.4321", precision: 3, errString: "has more than 3 fraction digits"},
+		{decimal: "1.", precision: 3, errString: "is not a decimal64"},
+		{decimal: ".5", precision: 3, errString: "is not a decimal64"},
+		{decimal: "1e3", precision: 3, errString: "is not a decimal64"},
+		{decimal: "", precision: 3, errString: "is not a decimal64"},
+	}
+	for _, tt := range tests {
+		digits, err := parseDecimal64(tt.decimal, tt.precision)
+		if tt.errString != "" {
+			assert.EqualError(t, err, tt.errString, tt.decimal)
+		} else {
+			assert.NoError(t, err, tt.decimal)
+			assert.Equal(t, tt.digits, digits, tt.decimal)
+		}
+	}
+}
+
+func Test_formatDecimal64(t *testing.T) {
+	tests := []struct {
+		digits    int64
+		precision uint8
+		decimal   string
+	}{
+		{digits: -1234500, precision: 5, decimal: "-12.34500"},
+		{digits: 1540, precision: 3, decimal: "1.540"},
+		{digits: -1, precision: 3, decimal: "-0.001"},
+		{digits: 5, precision: 1, decimal: "0.5"},
+		{digits: 0, precision: 2, decimal: "0.00"},
+		{digits: -7, precision: 0, decimal: "-7"},
+		{digits: -9223372036854775808, precision: 4, decimal: "-922337203685477.5808"},
+	}
+	for _, tt := range tests {
+		decimal := formatDecimal64(tt.digits, tt.precision)
+		assert.Equal(t, tt.decimal, decimal)
+		digits, err := parseDecimal64(decimal, tt.precision)
+		assert.NoError(t, err, decimal)
+		assert.Equal(t, tt.digits, digits, decimal)
+	}
+}
+
+func Test_decimalValue(t *testing.T) {
+	ranges := []string{"-1.5..-0.5", "0.001..2.000", "3"}
+
+	digits, err := decimalValue("3.000", 3, ranges, "/cont1a/cont2a/leaf2b")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3000), digits)
+
+	// JSON numbers are converted without rounding errors
+	digits, err = decimalValue(json.Number("1.001"), 3, ranges, "/cont1a/cont2a/leaf2b")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1001), digits)
+
+	_, err = decimalValue("2.5", 3, ranges, "/cont1a/cont2a/leaf2b")
+	assert.EqualError(t, err, "value 2.5 for /cont1a/cont2a/leaf2b is out of range -1.5..-0.5 | 0.001..2.000 | 3")
+
+	_, err = decimalValue("0.0001", 3, ranges, "/cont1a/cont2a/leaf2b")
+	assert.EqualError(t, err, "value 0.0001 for /cont1a/cont2a/leaf2b has more than 3 fraction digits")
+
+	_, err = decimalValue(1.001, 3, ranges, "/cont1a/cont2a/leaf2b")
+	assert.EqualError(t, err, "unhandled conversion of 1.001 for /cont1a/cont2a/leaf2b to decimal64")
+}
+
+func Test_intValue(t *testing.T) {
+	ranges := []string{"-100..200", "300"}
+
+	intVal, err := intValue(json.Number("-100"), 16, ranges, "/cont1a/cont2a/leaf2e")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-100), intVal)
+
+	intVal, err = intValue("300", 16, ranges, "/cont1a/cont2a/leaf2e")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), intVal)
+
+	_, err = intValue(json.Number("250"), 16, ranges, "/cont1a/cont2a/leaf2e")
+	assert.EqualError(t, err, "value 250 for /cont1a/cont2a/leaf2e is out of range -100..200 | 300")
+
+	_, err = intValue(json.Number("40000"), 16, nil, "/cont1a/cont2a/leaf2e")
+	assert.EqualError(t, err, "value 40000 for /cont1a/cont2a/leaf2e is out of the range of int16")
+
+	_, err = intValue(json.Number("1.5"), 16, nil, "/cont1a/cont2a/leaf2e")
+	assert.EqualError(t, err, "value 1.5 for /cont1a/cont2a/leaf2e is not an int16")
+
+	// 64 bit integers are exact
+	intVal, err = intValue("-9223372036854775807", 64, nil, "/counter")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-9223372036854775807), intVal)
+
+	// min and max are the limits of the type
+	intVal, err = intValue(json.Number("-128"), 8, []string{"min..5"}, "/cont1a/cont2a/leaf2e")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-128), intVal)
+
+	_, err = intValue(json.Number("6"), 8, []string{"min..5"}, "/cont1a/cont2a/leaf2e")
+	assert.EqualError(t, err, "value 6 for /cont1a/cont2a/leaf2e is out of range min..5")
+
+	_, err = intValue(json.Number("2"), 8, []string{"1..abc"}, "/cont1a/cont2a/leaf2e")
+	assert.EqualError(t, err, "invalid range 1..abc of /cont1a/cont2a/leaf2e")
+}
+
+func Test_uintValue(t *testing.T) {
+	uintVal, err := uintValue("18446744073709551615", 64, nil, "/counter")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(18446744073709551615), uintVal)
+
+	uintVal, err = uintValue(json.Number("12"), 8, []string{"1..3", "11..13"}, "/cont1a/cont2a/leaf2a")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), uintVal)
+
+	_, err = uintValue(json.Number("4"), 8, []string{"1..3", "11..13"}, "/cont1a/cont2a/leaf2a")
+	assert.EqualError(t, err, "value 4 for /cont1a/cont2a/leaf2a is out of range 1..3 | 11..13")
+
+	_, err = uintValue(json.Number("-1"), 8, nil, "/cont1a/cont2a/leaf2a")
+	assert.EqualError(t, err, "value -1 for /cont1a/cont2a/leaf2a is not a uint8")
+
+	uintVal, err = uintValue(json.Number("255"), 8, []string{"10..max"}, "/cont1a/cont2a/leaf2a")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(255), uintVal)
+
+	_, err = uintValue(json.Number("4"), 8, []string{"abc"}, "/cont1a/cont2a/leaf2a")
+	assert.EqualError(t, err, "invalid range abc of /cont1a/cont2a/leaf2a")
+}
@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/goyang/pkg/yang"
+	"strings"
+)
+
+// unionMember is a member type of a union leaf
+type unionMember struct {
+	// name is the name of the YANG type, e.g. uint16 or ipv4-address
+	name      string
+	valueType configapi.ValueType
+	typeOpts  []uint64
+	ranges    []string
+	enum      *enumValues
+}
+
+// unionMemberTypes returns the member types of a union, with the members of
+// the nested unions in their place
+func unionMemberTypes(yangType *yang.YangType) []*yang.YangType {
+	members := make([]*yang.YangType, 0, len(yangType.Type))
+	for _, member := range yangType.Type {
+		if member.Kind == yang.Yunion {
+			members = append(members, unionMemberTypes(member)...)
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// unionTypeOpts are the TypeOpts of a union leaf: the value type of each of
+// its members, followed by the width or the fraction digits of the member, or
+// 0 when it has none, e.g. [UINT, 16, STRING, 0] for union {uint16; string}
+func unionTypeOpts(yangType *yang.YangType) ([]uint64, error) {
+	members := unionMemberTypes(yangType)
+	typeOpts := make([]uint64, 0, 2*len(members))
+	for _, member := range members {
+		valueType, memberOpts, err := toValueType(member, false)
+		if err != nil {
+			return nil, err
+		}
+		typeOpts = append(typeOpts, uint64(valueType), typeWidth(memberOpts))
+	}
+	return typeOpts, nil
+}
+
+// newUnionMembers returns the member types of a union, in their order in
+// YANG; the types that ExtractPaths does not handle are left out
+func newUnionMembers(yangType *yang.YangType) []*unionMember {
+	members := make([]*unionMember, 0)
+	for _, memberType := range unionMemberTypes(yangType) {
+		valueType, typeOpts, err := toValueType(memberType, false)
+		if err != nil {
+			continue
+		}
+		ranges := make([]string, 0)
+		for _, r := range memberType.Range {
+			ranges = append(ranges, fmt.Sprintf("%v", r))
+		}
+		enum := newEnumValues(memberType)
+		if enum != nil {
+			// A number in a union is not an enumerated value, it is for the
+			// integer members, or a string
+			enum.namesOnly = true
+		}
+		members = append(members, &unionMember{
+			name:      memberType.Name,
+			valueType: valueType,
+			typeOpts:  typeOpts,
+			ranges:    ranges,
+			enum:      enum,
+		})
+	}
+	return members
+}
+
+// accepts tells if the JSON value may be of the member type, as RFC 7951
+// encodes it: a boolean is only a boolean, a number only an integer of 32
+// bits or fewer, and a string a string, a binary, an enumerated value, a 64
+// bit integer or a decimal
+func (u *unionMember) accepts(value interface{}) bool {
+	switch value.(type) {
+	case bool:
+		return u.valueType == configapi.ValueType_BOOL
+	case json.Number:
+		switch u.valueType {
+		case configapi.ValueType_INT, configapi.ValueType_UINT:
+			return typeWidth(u.typeOpts) <= 32
+		}
+	case string:
+		switch u.valueType {
+		case configapi.ValueType_STRING, configapi.ValueType_BYTES, configapi.ValueType_DECIMAL:
+			return true
+		case configapi.ValueType_INT, configapi.ValueType_UINT:
+			return typeWidth(u.typeOpts) == 64
+		}
+	}
+	return false
+}
+
+// lexicalValue is the JSON value of a value written in YANG, e.g. a default,
+// as RFC 7951 encodes it for the member type
+func (u *unionMember) lexicalValue(value string) interface{} {
+	switch u.valueType {
+	case configapi.ValueType_BOOL:
+		if value == "true" || value == "false" {
+			return value == "true"
+		}
+	case configapi.ValueType_INT, configapi.ValueType_UINT:
+		if typeWidth(u.typeOpts) <= 32 {
+			return json.Number(value)
+		}
+	}
+	return value
+}
+
+// unionValue converts a JSON value of a union leaf to the first member type
+// that it matches. The values of a leaf list of unions are strings, because
+// the values of a leaf list all have the same type.
+func unionValue(value interface{}, modeltype configapi.ValueType, members []*unionMember,
+	parentPath string) (*configapi.TypedValue, error) {
+	return matchUnion(value, modeltype, members, parentPath, func(*unionMember) interface{} {
+		return value
+	})
+}
+
+// unionDefault converts the default of a union leaf, as it is written in
+// YANG, to the first member type that it matches
+func unionDefault(value string, modeltype configapi.ValueType, members []*unionMember,
+	parentPath string) (*configapi.TypedValue, error) {
+	return matchUnion(value, modeltype, members, parentPath, func(member *unionMember) interface{} {
+		return member.lexicalValue(value)
+	})
+}
+
+// matchUnion tries the members of a union in turn, with the value that
+// memberValue gives for each of them
+func matchUnion(value interface{}, modeltype configapi.ValueType, members []*unionMember,
+	parentPath string, memberValue func(*unionMember) interface{}) (*configapi.TypedValue, error) {
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		names = append(names, member.name)
+		jsonValue := memberValue(member)
+		if !member.accepts(jsonValue) {
+			continue
+		}
+		typedValue, err := toTypedValue(jsonValue, member.valueType, member.typeOpts, member.ranges, member.enum, parentPath)
+		if err != nil {
+			continue
+		}
+		if modeltype == configapi.ValueType_LEAFLIST_STRING {
+			stringVal := typedValue.ValueToString()
+			if typedValue.Type == configapi.ValueType_DECIMAL {
+				stringVal = formatDecimal64((*configapi.TypedDecimal)(typedValue).Decimal64())
+			}
+			return configapi.NewLeafListStringTv([]string{stringVal}), nil
+		}
+		return typedValue, nil
+	}
+	return nil, fmt.Errorf("value %v for %s does not match any member type of the union [%s]",
+		value, parentPath, strings.Join(names, ", "))
+}
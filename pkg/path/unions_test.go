@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+// leaf2hValue is the path value of the union leaf2h of testdevice 1.0.x
+func leaf2hValue(t *testing.T, value string) (*configapi.TypedValue, error) {
+	pathValues, err := testModel.GetPathValues("", []byte(fmt.Sprintf(
+		`{"cont1a": {"cont2a": {"leaf2h": %s}}}`, value)))
+	if err != nil {
+		return nil, err
+	}
+	assert.Equal(t, 1, len(pathValues))
+	assert.Equal(t, "/t1:cont1a/cont2a/leaf2h", pathValues[0].Path)
+	return &pathValues[0].Value, nil
+}
+
+func Test_GetPathValuesUnion(t *testing.T) {
+	value, err := leaf2hValue(t, `50`)
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.ValueType_UINT, value.Type)
+	assert.Equal(t, []int32{16}, value.TypeOpts)
+	assert.Equal(t, "50", value.ValueToString())
+
+	value, err = leaf2hValue(t, `"auto"`)
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.ValueType_STRING, value.Type)
+	assert.Equal(t, "auto", value.ValueToString())
+
+	_, err = leaf2hValue(t, `500`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value 500 for /cont1a/cont2a/leaf2h does not match any member type of the union [uint16, enumeration]")
+
+	_, err = leaf2hValue(t, `"manual"`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value manual for /cont1a/cont2a/leaf2h does not match any member type of the union [uint16, enumeration]")
+
+	_, err = leaf2hValue(t, `true`)
+	assert.Error(t, err)
+
+	// A uint16 is a number, and the enumerated values are only their names
+	_, err = leaf2hValue(t, `"50"`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value 50 for /cont1a/cont2a/leaf2h does not match any member type of the union [uint16, enumeration]")
+
+	_, err = leaf2hValue(t, `0`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value 0 for /cont1a/cont2a/leaf2h does not match any member type of the union [uint16, enumeration]")
+}
+
+func Test_BuildJSONUnion(t *testing.T) {
+	value, err := leaf2hValue(t, `50`)
+	assert.NoError(t, err)
+	jsonTree, err := testModel.BuildJSON([]*configapi.PathValue{{Path: "/t1:cont1a/cont2a/leaf2h", Value: *value}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"cont1a":{"cont2a":{"leaf2h":50}}}`, string(jsonTree))
+}
+
+func Test_GetPathValuesUnionKinds(t *testing.T) {
+	// union {string; boolean; int64; uint64; decimal64}: the members match the
+	// kind of the JSON value as RFC 7951 encodes it, so 64 bit integers are
+	// strings, and strings are taken by the first member
+	model := devicesimModel(t)
+	for _, test := range []struct {
+		value     string
+		valueType configapi.ValueType
+		expected  string
+	}{
+		{`"fast"`, configapi.ValueType_STRING, "fast"},
+		{`true`, configapi.ValueType_BOOL, "true"},
+		{`"-5"`, configapi.ValueType_STRING, "-5"},
+		{`"18446744073709551615"`, configapi.ValueType_STRING, "18446744073709551615"},
+	} {
+		pathValues, err := model.GetPathValues("", []byte(fmt.Sprintf(
+			`{"components": {"component": [{"name": "fan1", "properties": {"property": [{"name": "speed", "config": {"value": %s}}]}}]}}`,
+			test.value)))
+		assert.NoError(t, err)
+		found := false
+		for _, pathValue := range pathValues {
+			if pathValue.Path == "/oc-platform:components/component[name=fan1]/properties/property[name=speed]/config/value" {
+				found = true
+				assert.Equal(t, test.valueType, pathValue.Value.Type, test.value)
+				assert.Equal(t, test.expected, (&pathValue.Value).ValueToString())
+			}
+		}
+		assert.True(t, found, test.value)
+	}
+
+	_, err := model.GetPathValues("", []byte(
+		`{"components": {"component": [{"name": "fan1", "properties": {"property": [{"name": "speed", "config": {"value": -5}}]}}]}}`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value -5 for /components/component[0]/properties/property[0]/config/value does not match any member type of the union")
+}
+
+func Test_unionValue(t *testing.T) {
+	members := []*unionMember{
+		{name: "int64", valueType: configapi.ValueType_INT, typeOpts: []uint64{64}},
+		{name: "int8", valueType: configapi.ValueType_INT, typeOpts: []uint64{8}},
+		{name: "decimal64", valueType: configapi.ValueType_DECIMAL, typeOpts: []uint64{2}},
+		{name: "enumeration", valueType: configapi.ValueType_STRING,
+			enum: &enumValues{values: map[int64]string{0: "auto"}, names: []string{"auto"}, namesOnly: true}},
+	}
+	for _, test := range []struct {
+		value     interface{}
+		valueType configapi.ValueType
+		expected  string
+	}{
+		{"-5", configapi.ValueType_INT, "-5"},
+		{json.Number("-5"), configapi.ValueType_INT, "-5"},
+		{"1.50", configapi.ValueType_DECIMAL, "1.50"},
+		{"auto", configapi.ValueType_STRING, "auto"},
+	} {
+		value, err := unionValue(test.value, configapi.ValueType_STRING, members, "/leaf")
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.valueType, value.Type, test.value)
+		assert.Equal(t, test.expected, value.ValueToString(), test.value)
+	}
+
+	// 64 bit integers and decimals are strings, and the enumerated values
+	// are not given by their numbers
+	for _, value := range []interface{}{json.Number("300"), json.Number("1.5"), true} {
+		_, err := unionValue(value, configapi.ValueType_STRING, members, "/leaf")
+		assert.Error(t, err, value)
+	}
+	_, err := unionValue("0", configapi.ValueType_STRING, members[3:], "/leaf")
+	assert.Error(t, err)
+
+	// The values of a leaf list of unions are strings
+	value, err := unionValue("-0.5", configapi.ValueType_LEAFLIST_STRING, members, "/leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"-0.50"}, (*configapi.TypedLeafListString)(value).List())
+
+	// The defaults are written in YANG, and match as such
+	value, err = unionDefault("-5", configapi.ValueType_STRING, members[1:], "/leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.ValueType_INT, value.Type)
+	assert.Equal(t, "-5", value.ValueToString())
+}
+
+func Test_GetPathValuesUnionIdentities(t *testing.T) {
+	componentType := func(model *PathModel, value string) (string, error) {
+		pathValues, err := model.GetPathValues("", []byte(fmt.Sprintf(
+			`{"components": {"component": [{"name": "fan1", "state": {"type": "%s"}}]}}`, value)))
+		if err != nil {
+			return "", err
+		}
+		for _, pathValue := range pathValues {
+			if pathValue.Path == "/oc-platform:components/component[name=fan1]/state/type" {
+				return (&pathValue.Value).ValueToString(), nil
+			}
+		}
+		return "", fmt.Errorf("no path value of type")
+	}
+
+	// The members are identityrefs of different bases
+	model := devicesimModel(t)
+	value, err := componentType(model, "openconfig-platform-types:FAN")
+	assert.NoError(t, err)
+	assert.Equal(t, "FAN", value)
+
+	value, err = componentType(model, "openconfig-platform-types:OPERATING_SYSTEM")
+	assert.NoError(t, err)
+	assert.Equal(t, "OPERATING_SYSTEM", value)
+
+	_, err = componentType(model, "openconfig-platform-types:FRIDGE")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value openconfig-platform-types:FRIDGE for /components/component[0]/state/type does not match any member type of the union [identityref, identityref]")
+
+	// The members whose values are unknown get those of the Go bindings
+	for _, member := range model.unions["/components/component/state/type"] {
+		member.enum.values = make(map[int64]string)
+		member.enum.sortNames()
+	}
+	WithEnumTypes(map[string][]reflect.Type{
+		"/components/component/state/type": {reflect.TypeOf(testInterfaceType(0))},
+	})(model)
+	value, err = componentType(model, "iana-if-type:softwareLoopback")
+	assert.NoError(t, err)
+	assert.Equal(t, "softwareLoopback", value)
+
+	_, err = componentType(model, "openconfig-platform-types:FAN")
+	assert.Error(t, err)
+}
@@ -7,12 +7,12 @@
 package path
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
 	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
-	"math"
 	"regexp"
 	"sort"
 	"strconv"
@@ -38,10 +38,20 @@ const matchOnIndex = `(\[.*?]).*?`
 
 var rOnIndex = regexp.MustCompile(matchOnIndex)
 
+// GetPathValues flattens a JSON tree in to path values, with the model of the
+// last call to ExtractPaths
 func GetPathValues(prefixPath string, genericJSON []byte) ([]*configapi.PathValue, error) {
+	return defaultModel.GetPathValues(prefixPath, genericJSON)
+}
+
+// GetPathValues flattens a JSON tree in to path values; prefixPath is the
+// path of the root of the tree
+func (m *PathModel) GetPathValues(prefixPath string, genericJSON []byte) ([]*configapi.PathValue, error) {
 	var f interface{}
-	err := json.Unmarshal(genericJSON, &f)
-	if err != nil {
+	// The numbers are decoded as json.Number, so that 64 bit integers are exact
+	decoder := json.NewDecoder(bytes.NewReader(genericJSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&f); err != nil {
 		return nil, err
 	}
 	if fAsMap, ok := f.(map[string]interface{}); ok {
@@ -54,7 +64,7 @@ func GetPathValues(prefixPath string, genericJSON []byte) ([]*configapi.PathValu
 	if prefixPath == "/" {
 		prefixPath = ""
 	}
-	values, err := extractValuesWithPaths(f, removeIndexNames(prefixPath))
+	values, err := m.extractValuesWithPaths(f, removeIndexNames(prefixPath))
 	if err != nil {
 		return nil, fmt.Errorf("error decomposing JSON %v", err)
 	}
@@ -63,62 +73,61 @@ func GetPathValues(prefixPath string, genericJSON []byte) ([]*configapi.PathValu
 
 // extractValuesIntermediate recursively walks a JSON tree to create a flat set
 // of paths and values.
-func extractValuesWithPaths(f interface{}, parentPath string) ([]*configapi.PathValue, error) {
+func (m *PathModel) extractValuesWithPaths(f interface{}, parentPath string) ([]*configapi.PathValue, error) {
 	changes := make([]*configapi.PathValue, 0)
 
 	switch value := f.(type) {
 	case map[string]interface{}:
-		mapChanges, err := handleMap(value, parentPath)
+		mapChanges, err := m.handleMap(value, parentPath)
 		if err != nil {
 			return nil, err
 		}
 		changes = append(changes, mapChanges...)
 
 	case []interface{}:
-		indexNames := indicesOfPath(parentPath)
+		indexNames := m.indicesOfPath(parentPath)
 		// Iterate through to look for indexes first
 		for idx, v := range value {
 			indices := make([]indexValue, 0)
-			nonIndexPaths := make([]string, 0)
-			objs, err := extractValuesWithPaths(v, fmt.Sprintf("%s[%d]", parentPath, idx))
+			objs, err := m.extractValuesWithPaths(v, fmt.Sprintf("%s[%d]", parentPath, idx))
 			if err != nil {
 				return nil, err
 			}
-			for _, obj := range objs {
-				isIndex := false
-				for i, idxName := range indexNames {
-					if stripNamespace(removePathIndices(obj.Path)) == fmt.Sprintf("%s/%s", removePathIndices(parentPath), idxName) {
-						indices = append(indices, indexValue{name: idxName, value: &obj.Value, order: i})
-						isIndex = true
-						break
+			isIndex := make([]bool, len(objs))
+			if len(indexNames) > 0 {
+				parentPathNoIndices := removePathIndices(parentPath)
+				for o, obj := range objs {
+					objPathNoIndices := stripNamespace(removePathIndices(obj.Path))
+					for i, idxName := range indexNames {
+						if objPathNoIndices == fmt.Sprintf("%s/%s", parentPathNoIndices, idxName) {
+							indices = append(indices, indexValue{name: idxName, value: &objs[o].Value, order: i})
+							isIndex[o] = true
+							break
+						}
 					}
 				}
-				if !isIndex {
-					nonIndexPaths = append(nonIndexPaths, obj.Path)
-				}
 			}
 			sort.Slice(indices, func(i, j int) bool {
 				return indices[i].order < indices[j].order
 			})
 			// Now we have indices, need to go through again
-			for _, obj := range objs {
-				for _, nonIdxPath := range nonIndexPaths {
-					if obj.Path == nonIdxPath {
-						suffixLen := prefixLength(obj.Path, parentPath)
-						obj.Path, err = replaceIndices(obj.Path, suffixLen, indices)
-						if err != nil {
-							return nil, fmt.Errorf("error replacing indices in %s %v", obj.Path, err)
-						}
-						changes = append(changes, obj)
-					}
+			for o, obj := range objs {
+				if isIndex[o] {
+					continue
 				}
+				suffixLen := prefixLength(obj.Path, parentPath)
+				obj.Path, err = replaceIndices(obj.Path, suffixLen, indices)
+				if err != nil {
+					return nil, fmt.Errorf("error replacing indices in %s %v", obj.Path, err)
+				}
+				changes = append(changes, obj)
 			}
 		}
 	default:
-		attr, err := handleAttribute(value, parentPath)
+		attr, err := m.handleAttribute(value, parentPath)
 		if err != nil {
 			return nil, fmt.Errorf("error handling json attribute value %v. Parent %s. #RO:%d #RW:%d %s",
-				value, parentPath, len(roPaths), len(rwPaths), err.Error())
+				value, parentPath, len(m.roPaths), len(m.rwPaths), err.Error())
 		}
 		if attr != nil {
 			changes = append(changes, attr)
@@ -128,11 +137,11 @@ func extractValuesWithPaths(f interface{}, parentPath string) ([]*configapi.Path
 	return changes, nil
 }
 
-func handleMap(value map[string]interface{}, parentPath string) ([]*configapi.PathValue, error) {
+func (m *PathModel) handleMap(value map[string]interface{}, parentPath string) ([]*configapi.PathValue, error) {
 	changes := make([]*configapi.PathValue, 0)
 
 	for key, v := range value {
-		objs, err := extractValuesWithPaths(v, fmt.Sprintf("%s/%s", parentPath, stripNamespace(key)))
+		objs, err := m.extractValuesWithPaths(v, fmt.Sprintf("%s/%s", parentPath, stripNamespace(key)))
 		if err != nil {
 			return nil, err
 		}
@@ -223,46 +232,66 @@ func handleMap(value map[string]interface{}, parentPath string) ([]*configapi.Pa
 	return changes, nil
 }
 
-func handleAttribute(value interface{}, parentPath string) (*configapi.PathValue, error) {
+func (m *PathModel) handleAttribute(value interface{}, parentPath string) (*configapi.PathValue, error) {
 	var modeltype configapi.ValueType
 	var modelPath string
 	var ok bool
 	var pathElem *admin.ReadWritePath
 	var subPath *admin.ReadOnlySubPath
-	var enum map[int]string
+	var enum *enumValues
 	var typeOpts []uint64
-	var err error
-	pathElem, modelPath, ok = findModelRwPathNoIndices(parentPath)
+	// Only the read-write paths have the ranges of their leaves
+	var ranges []string
+	schemaPath := stripNamespace(removePathIndices(removeDoubleSlash(parentPath)))
+	enum = m.enums[schemaPath]
+	pathElem, modelPath, ok = m.findModelRwPathNoIndices(parentPath)
 	if !ok {
-		subPath, modelPath, ok = findModelRoPathNoIndices(parentPath)
+		subPath, modelPath, ok = m.findModelRoPathNoIndices(parentPath)
 		if !ok {
-			if roPaths == nil || rwPaths == nil {
+			if m.roPaths == nil || m.rwPaths == nil {
 				// If RO paths was not given - then we assume this missing pathWithIdx was a RO pathWithIdx
 				return nil, nil
 			}
 			return nil, fmt.Errorf("unable to locate %s in model", parentPath)
 		}
 		modeltype = subPath.ValueType
-		// enum = subPath.Enum  // TODO - fix this
 		if subPath.TypeOpts != nil {
 			typeOpts = make([]uint64, len(subPath.TypeOpts))
 			copy(typeOpts, subPath.TypeOpts)
 		}
 	} else {
 		modeltype = pathElem.ValueType
-		//enum = pathElem.Enum // TODO - fix this
+		ranges = pathElem.Range
 		if pathElem.TypeOpts != nil {
 			typeOpts = make([]uint64, len(pathElem.TypeOpts))
 			copy(typeOpts, pathElem.TypeOpts)
 		}
 	}
 	var typedValue *configapi.TypedValue
+	var err error
+	if members, isUnion := m.unions[schemaPath]; isUnion {
+		typedValue, err = unionValue(value, modeltype, members, parentPath)
+	} else {
+		typedValue, err = toTypedValue(value, modeltype, typeOpts, ranges, enum, parentPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &configapi.PathValue{Path: modelPath, Value: *typedValue}, nil
+}
+
+// toTypedValue converts a JSON value to the value type of a leaf, checking it
+// against the ranges and the enumerated values of the leaf
+func toTypedValue(value interface{}, modeltype configapi.ValueType, typeOpts []uint64, ranges []string,
+	enum *enumValues, parentPath string) (*configapi.TypedValue, error) {
+	var typedValue *configapi.TypedValue
+	var err error
 	switch modeltype {
 	case configapi.ValueType_STRING:
 		var stringVal string
 		switch valueTyped := value.(type) {
 		case string:
-			if len(enum) > 0 {
+			if enum != nil {
 				stringVal, err = convertEnumIdx(valueTyped, enum, parentPath)
 				if err != nil {
 					return nil, err
@@ -270,14 +299,14 @@ func handleAttribute(value interface{}, parentPath string) (*configapi.PathValue
 			} else {
 				stringVal = valueTyped
 			}
-		case float64:
-			if len(enum) > 0 {
-				stringVal, err = convertEnumIdx(fmt.Sprintf("%g", valueTyped), enum, parentPath)
+		case json.Number:
+			if enum != nil {
+				stringVal, err = convertEnumIdx(valueTyped.String(), enum, parentPath)
 				if err != nil {
 					return nil, err
 				}
 			} else {
-				stringVal = fmt.Sprintf("%g", valueTyped)
+				stringVal = valueTyped.String()
 			}
 		case bool:
 			stringVal = fmt.Sprintf("%v", value)
@@ -285,61 +314,46 @@ func handleAttribute(value interface{}, parentPath string) (*configapi.PathValue
 		typedValue = configapi.NewTypedValueString(stringVal)
 	case configapi.ValueType_BOOL:
 		typedValue = configapi.NewTypedValueBool(value.(bool))
-	case configapi.ValueType_INT:
-		var intVal int
-		switch valueTyped := value.(type) {
-		case string:
-			intVal64, err := strconv.ParseInt(valueTyped, 10, int(typeOpts[0]))
-			if err != nil {
-				return nil, fmt.Errorf("error converting to %v %s", modeltype, valueTyped)
-			}
-			intVal = int(intVal64)
-		case float64:
-			intVal = int(valueTyped)
-		default:
-			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
-		}
+	case configapi.ValueType_INT, configapi.ValueType_LEAFLIST_INT:
 		if len(typeOpts) == 0 {
 			return nil, fmt.Errorf("expected INT to have a field width e.g. 8, 16, 32, 64")
 		}
-		typedValue = configapi.NewTypedValueInt(intVal, configapi.Width(typeOpts[0]))
-	case configapi.ValueType_UINT:
-		var uintVal uint
-		switch valueTyped := value.(type) {
-		case string:
-			intVal, err := strconv.ParseInt(valueTyped, 10, int(typeOpts[0]))
-			if err != nil {
-				return nil, fmt.Errorf("error converting to %v %s", modeltype, valueTyped)
-			}
-			uintVal = uint(intVal)
-		case float64:
-			uintVal = uint(valueTyped)
-		default:
-			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
+		intVal, err := intValue(value, typeOpts[0], ranges, parentPath)
+		if err != nil {
+			return nil, err
+		}
+		if modeltype == configapi.ValueType_LEAFLIST_INT {
+			typedValue = configapi.NewLeafListIntTv([]int64{intVal}, configapi.Width(typeOpts[0]))
+		} else {
+			typedValue = configapi.NewTypedValueInt(int(intVal), configapi.Width(typeOpts[0]))
 		}
+	case configapi.ValueType_UINT, configapi.ValueType_LEAFLIST_UINT:
 		if len(typeOpts) == 0 {
 			return nil, fmt.Errorf("expected UINT to have a field width e.g. 8, 16, 32, 64")
 		}
-		typedValue = configapi.NewTypedValueUint(uintVal, configapi.Width(typeOpts[0]))
-	case configapi.ValueType_DECIMAL:
-		var digits int64
-		precision := typeOpts[0]
-		switch valueTyped := value.(type) {
-		case float64:
-			digits = int64(valueTyped * math.Pow(10, float64(precision)))
-		case string:
-			floatVal, err := strconv.ParseFloat(valueTyped, 64)
-			if err != nil {
-				return nil, fmt.Errorf("error converting string to float %v", err)
-			}
-			digits = int64(floatVal * math.Pow(10, float64(precision)))
-		default:
-			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
+		uintVal, err := uintValue(value, typeOpts[0], ranges, parentPath)
+		if err != nil {
+			return nil, err
+		}
+		if modeltype == configapi.ValueType_LEAFLIST_UINT {
+			typedValue = configapi.NewLeafListUintTv([]uint64{uintVal}, configapi.Width(typeOpts[0]))
+		} else {
+			typedValue = configapi.NewTypedValueUint(uint(uintVal), configapi.Width(typeOpts[0]))
 		}
+	case configapi.ValueType_DECIMAL, configapi.ValueType_LEAFLIST_DECIMAL:
 		if len(typeOpts) == 0 {
 			return nil, fmt.Errorf("expected DECIMAL to have a precision")
 		}
-		typedValue = configapi.NewTypedValueDecimal(digits, uint8(precision))
+		precision := uint8(typeOpts[0])
+		digits, err := decimalValue(value, precision, ranges, parentPath)
+		if err != nil {
+			return nil, err
+		}
+		if modeltype == configapi.ValueType_LEAFLIST_DECIMAL {
+			typedValue = configapi.NewLeafListDecimalTv([]int64{digits}, precision)
+		} else {
+			typedValue = configapi.NewTypedValueDecimal(digits, precision)
+		}
 	case configapi.ValueType_BYTES:
 		var dstBytes []byte
 		switch valueTyped := value.(type) {
@@ -354,12 +368,17 @@ func handleAttribute(value interface{}, parentPath string) (*configapi.PathValue
 		}
 		typedValue = configapi.NewTypedValueBytes(dstBytes)
 	default:
+		if stringVal, isString := value.(string); isString && enum != nil && modeltype == configapi.ValueType_LEAFLIST_STRING {
+			if value, err = convertEnumIdx(stringVal, enum, parentPath); err != nil {
+				return nil, err
+			}
+		}
 		typedValue, err = handleAttributeLeafList(modeltype, value)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return &configapi.PathValue{Path: modelPath, Value: *typedValue}, nil
+	return typedValue, nil
 }
 
 // A continuation of handle attribute above
@@ -369,29 +388,15 @@ func handleAttributeLeafList(modeltype configapi.ValueType,
 	var typedValue *configapi.TypedValue
 
 	switch modeltype {
-	case configapi.ValueType_LEAFLIST_INT:
-		var leafvalue int64
-		switch valueTyped := value.(type) {
-		case float64:
-			leafvalue = int64(valueTyped)
-		default:
-			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
-		}
-		typedValue = configapi.NewLeafListIntTv([]int64{leafvalue}, configapi.WidthThirtyTwo) // TODO: lookup width from model
-	case configapi.ValueType_LEAFLIST_UINT:
-		var leafvalue uint64
-		switch valueTyped := value.(type) {
-		case float64:
-			leafvalue = uint64(valueTyped)
-		default:
-			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
-		}
-		typedValue = configapi.NewLeafListUintTv([]uint64{leafvalue}, configapi.WidthThirtyTwo) // TODO: lookup width from model
 	case configapi.ValueType_LEAFLIST_FLOAT:
 		var leafvalue float32
 		switch valueTyped := value.(type) {
-		case float64:
-			leafvalue = float32(valueTyped)
+		case json.Number:
+			floatVal, err := strconv.ParseFloat(valueTyped.String(), 32)
+			if err != nil {
+				return nil, fmt.Errorf("error converting to %v %s", modeltype, valueTyped)
+			}
+			leafvalue = float32(floatVal)
 		default:
 			return nil, fmt.Errorf("unhandled conversion to %v %s", modeltype, valueTyped)
 		}
@@ -434,74 +439,36 @@ func handleAttributeLeafList(modeltype configapi.ValueType,
 	return typedValue, nil
 }
 
-func findModelRwPathNoIndices(searchpath string) (*admin.ReadWritePath, string, bool) {
+func (m *PathModel) findModelRwPathNoIndices(searchpath string) (*admin.ReadWritePath, string, bool) {
 	searchpath = removeDoubleSlash(searchpath)
-	searchpathNoIndices := stripNamespace(removePathIndices(searchpath))
-	for _, rwPath := range rwPaths {
-		if stripNamespace(removePathIndices(rwPath.Path)) == searchpathNoIndices {
-			pathWithNumericalIdx, err := insertNumericalIndices(rwPath.Path, searchpath)
-			if err != nil {
-				return nil, fmt.Sprintf("could not replace wildcards in model pathWithIdx with numerical ids %v", err), false
-			}
-			return rwPath, pathWithNumericalIdx, true
-		}
+	rwPath, ok := m.rwPathsNoIndices[stripNamespace(removePathIndices(searchpath))]
+	if !ok {
+		return nil, "", false
 	}
-	return nil, "", false
-}
-
-func findModelRoPathNoIndices(searchpath string) (*admin.ReadOnlySubPath, string, bool) {
-	searchpathNoIndices := stripNamespace(removePathIndices(searchpath))
-	for _, roPath := range roPaths {
-		for _, subpathValue := range roPath.SubPath {
-			var fullpath string
-			if subpathValue.SubPath == "/" {
-				fullpath = roPath.Path
-			} else {
-				fullpath = fmt.Sprintf("%s%s", roPath.Path, subpathValue.SubPath)
-			}
-			if stripNamespace(removePathIndices(fullpath)) == searchpathNoIndices {
-				pathWithNumericalIdx, err := insertNumericalIndices(fullpath, searchpath)
-				if err != nil {
-					return nil, fmt.Sprintf("could not replace wildcards in model pathWithIdx with numerical ids %v", err), false
-				}
-				return subpathValue, pathWithNumericalIdx, true
-			}
-		}
+	pathWithNumericalIdx, err := insertNumericalIndices(rwPath.Path, searchpath)
+	if err != nil {
+		return nil, fmt.Sprintf("could not replace wildcards in model pathWithIdx with numerical ids %v", err), false
 	}
-	return nil, "", false
+	return rwPath, pathWithNumericalIdx, true
 }
 
-// For RW paths
-func indicesOfPath(searchpath string) []string {
-	searchpathNoIndices := removePathIndices(searchpath)
-	// First search through the RW paths
-	for _, p := range roPaths {
-		pathNoIndices := stripNamespace(removePathIndices(p.Path))
-		// Find a short pathWithIdx
-		if pathNoIndices[:strings.LastIndex(pathNoIndices, slash)] == searchpathNoIndices {
-			idxNames, _ := ExtractIndexNames(p.Path)
-			return idxNames
-		}
+func (m *PathModel) findModelRoPathNoIndices(searchpath string) (*admin.ReadOnlySubPath, string, bool) {
+	roPath, ok := m.roPathsNoIndices[stripNamespace(removePathIndices(searchpath))]
+	if !ok {
+		return nil, "", false
 	}
-
-	// If not found then search through the RO paths
-	for _, value := range roPaths {
-		for _, subpath := range value.SubPath {
-			var fullpath string
-			if subpath.SubPath == "/" {
-				fullpath = value.Path
-			} else {
-				fullpath = fmt.Sprintf("%s%s", value.Path, subpath)
-			}
-			pathNoIndices := removePathIndices(fullpath)
-			// Find a short pathWithIdx
-			if pathNoIndices[:strings.LastIndex(pathNoIndices, slash)] == searchpathNoIndices {
-				idxNames, _ := ExtractIndexNames(fullpath)
-				return idxNames
-			}
-		}
+	pathWithNumericalIdx, err := insertNumericalIndices(roPath.fullPath, searchpath)
+	if err != nil {
+		return nil, fmt.Sprintf("could not replace wildcards in model pathWithIdx with numerical ids %v", err), false
 	}
+	return roPath.subPath, pathWithNumericalIdx, true
+}
 
+// indicesOfPath returns the index names of the list at searchpath
+func (m *PathModel) indicesOfPath(searchpath string) []string {
+	if idxNames, ok := m.indexNames[removePathIndices(searchpath)]; ok {
+		return idxNames
+	}
 	return []string{}
 }
 
@@ -566,8 +533,15 @@ func replaceIndices(path string, ignoreAfter int, indices []indexValue) (string,
 			switch index.value.Type {
 			case configapi.ValueType_STRING:
 				actualValue = string(index.value.Bytes)
-			case configapi.ValueType_UINT, configapi.ValueType_INT:
+			case configapi.ValueType_UINT:
 				actualValue = fmt.Sprintf("%d", (*configapi.TypedUint)(index.value).Uint())
+			case configapi.ValueType_INT:
+				actualValue = fmt.Sprintf("%d", (*configapi.TypedInt)(index.value).Int())
+			case configapi.ValueType_DECIMAL:
+				actualValue = formatDecimal64((*configapi.TypedDecimal)(index.value).Decimal64())
+			default:
+				// e.g. the key is a union whose value is not a string
+				actualValue = index.value.ValueToString()
 			}
 			pathParts[i] = fmt.Sprintf("%s=%s%s", idxName, actualValue, pathPart[closeIdx:])
 		}
@@ -576,32 +550,6 @@ func replaceIndices(path string, ignoreAfter int, indices []indexValue) (string,
 	return fmt.Sprintf("%s%s", strings.Join(pathParts, bracketsq), ignored), nil
 }
 
-func convertEnumIdx(valueTyped string, enum map[int]string,
-	parentPath string) (string, error) {
-	var stringVal string
-	for k, v := range enum {
-		if v == valueTyped {
-			stringVal = valueTyped
-			break
-		} else if fmt.Sprintf("%d", k) == valueTyped {
-			stringVal = v
-			break
-		}
-	}
-	if stringVal == "" {
-		enumOpts := make([]string, len(enum)*2)
-		i := 0
-		for k, v := range enum {
-			enumOpts[i*2] = fmt.Sprintf("%d", k)
-			enumOpts[i*2+1] = v
-			i++
-		}
-		return "", fmt.Errorf("value %s for %s does not match any enumerated value %s",
-			valueTyped, parentPath, strings.Join(enumOpts, ";"))
-	}
-	return stringVal, nil
-}
-
 // for a pathWithIdx like
 // "/interfaces/interface[name=eth1]/subinterfaces/subinterface[index=120]/config/description",
 // Remove the "name=" and "index="
@@ -617,12 +565,28 @@ func removeIndexNames(prefixPath string) string {
 	return strings.Join(splitPath, "")
 }
 
+// removePathIndices removes the indices in brackets from a path; it is on the
+// hot path of GetPathValues, so it does not use rOnIndex
 func removePathIndices(path string) string {
-	indices := rOnIndex.FindAllStringSubmatch(path, -1)
-	for _, i := range indices {
-		path = strings.Replace(path, i[0], "", 1)
+	if !strings.Contains(path, bracketsq) {
+		return path
 	}
-	return path
+	var pathNoIndices strings.Builder
+	pathNoIndices.Grow(len(path))
+	for {
+		openIdx := strings.Index(path, bracketsq)
+		if openIdx < 0 {
+			break
+		}
+		closeIdx := strings.Index(path[openIdx:], brktclose)
+		if closeIdx < 0 {
+			break
+		}
+		pathNoIndices.WriteString(path[:openIdx])
+		path = path[openIdx+closeIdx+1:]
+	}
+	pathNoIndices.WriteString(path)
+	return pathNoIndices.String()
 }
 
 func removeDoubleSlash(path string) string {
@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: 2022-present Intel Corporation
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package path
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	"io/ioutil"
+	"testing"
+)
+
+func Benchmark_GetPathValuesTestdevice(b *testing.B) {
+	sampleConfig, err := ioutil.ReadFile("testdata/sample-testdevice-1-config.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := testModel.GetPathValues("", sampleConfig); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_GetPathValuesDevicesim(b *testing.B) {
+	for _, interfaces := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("interfaces=%d", interfaces), func(b *testing.B) {
+			model := devicesimModel(b)
+			sampleConfig := devicesimConfig(b, interfaces)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := model.GetPathValues("", sampleConfig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Benchmark_GetEffectivePathValuesDevicesim(b *testing.B) {
+	for _, interfaces := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("interfaces=%d", interfaces), func(b *testing.B) {
+			model := devicesimModel(b)
+			sampleConfig := devicesimConfig(b, interfaces)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := model.GetEffectivePathValues("", sampleConfig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// devicesimModel extracts the paths of the schema of devicesim 1.0.x
+func devicesimModel(tb testing.TB, opts ...ModelOption) *PathModel {
+	gzipSchema, err := ioutil.ReadFile("testdata/devicesim-1.0.x-schema.json.gz")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	schemaTree, err := ygot.GzipToSchema(gzipSchema)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	model, err := NewPathModel(schemaTree, opts...)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return model
+}
+
+// devicesimConfig generates the configuration and state of a number of
+// interfaces of devicesim, with two subinterfaces each
+func devicesimConfig(tb testing.TB, interfaces int) []byte {
+	interfaceList := make([]interface{}, 0, interfaces)
+	for i := 0; i < interfaces; i++ {
+		name := fmt.Sprintf("eth%d", i)
+		subinterfaces := make([]interface{}, 0, 2)
+		for j := 0; j < 2; j++ {
+			subinterfaces = append(subinterfaces, map[string]interface{}{
+				"index": j,
+				"config": map[string]interface{}{
+					"index":       j,
+					"description": fmt.Sprintf("%s.%d", name, j),
+					"enabled":     true,
+				},
+			})
+		}
+		interfaceList = append(interfaceList, map[string]interface{}{
+			"name": name,
+			"config": map[string]interface{}{
+				"name":        name,
+				"type":        "ethernetCsmacd",
+				"mtu":         1500,
+				"description": fmt.Sprintf("interface %d", i),
+				"enabled":     true,
+			},
+			"state": map[string]interface{}{
+				"admin-status": "UP",
+				"oper-status":  "UP",
+				"ifindex":      i,
+				"counters": map[string]interface{}{
+					"in-octets":  1000 * i,
+					"out-octets": 2000 * i,
+				},
+			},
+			"hold-time": map[string]interface{}{
+				"config": map[string]interface{}{
+					"up":   10,
+					"down": 20,
+				},
+			},
+			"subinterfaces": map[string]interface{}{
+				"subinterface": subinterfaces,
+			},
+		})
+	}
+	config, err := json.Marshal(map[string]interface{}{
+		"openconfig-interfaces:interfaces": map[string]interface{}{
+			"interface": interfaceList,
+		},
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return config
+}
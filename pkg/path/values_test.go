@@ -10,6 +10,7 @@ import (
 	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
+	"strings"
 	"testing"
 )
 
@@ -31,7 +32,7 @@ func Test_GetPathValues(t *testing.T) {
 	sampleConfig, err := ioutil.ReadFile("testdata/sample-testdevice-1-config.json")
 	assert.NoError(t, err)
 
-	pathValues, err := GetPathValues("", sampleConfig)
+	pathValues, err := testModel.GetPathValues("", sampleConfig)
 	assert.NoError(t, err)
 	assert.Equal(t, 35, len(pathValues))
 
@@ -48,7 +49,7 @@ func Test_GetPathValues(t *testing.T) {
 			assert.Equal(t, "1.540", (&value).ValueToString())
 			assert.Equal(t, configapi.ValueType_DECIMAL, (&value).Type)
 		case "/t1:cont1a/cont2a/leaf2e":
-			assert.Equal(t, "[5 4 3 2 1] 32", (&value).ValueToString())
+			assert.Equal(t, "[5 4 3 2 1] 16", (&value).ValueToString())
 			assert.Equal(t, configapi.ValueType_LEAFLIST_INT, (&value).Type)
 		case "/t1:cont1a/cont2a/leaf2f":
 			assert.Equal(t, "dGhpcyBpcyBhIHRlc3QgdGVzdAo=", (&value).ValueToString())
@@ -150,6 +151,33 @@ func Test_GetPathValues(t *testing.T) {
 
 }
 
+func Test_GetPathValuesIntegers(t *testing.T) {
+	model := devicesimModel(t)
+	pathValues, err := model.GetPathValues("", []byte(`{"interfaces": {"interface": [{"name": "eth0",
+		"state": {"counters": {"in-octets": "18446744073709551615", "out-octets": 9007199254740993}}}]}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pathValues))
+	for _, pathValue := range pathValues {
+		value := pathValue.GetValue()
+		switch path := pathValue.Path; path {
+		case "/oc-if:interfaces/interface[name=eth0]/state/counters/in-octets":
+			assert.Equal(t, "18446744073709551615", (&value).ValueToString())
+		case "/oc-if:interfaces/interface[name=eth0]/state/counters/out-octets":
+			assert.Equal(t, "9007199254740993", (&value).ValueToString())
+		default:
+			t.Fatalf("unexpected path %s", path)
+		}
+	}
+
+	_, err = testModel.GetPathValues("", []byte(`{"cont1a": {"cont2a": {"leaf2a": 5}}}`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value 5 for /cont1a/cont2a/leaf2a is out of range 1..3 | 11..13")
+
+	_, err = testModel.GetPathValues("", []byte(`{"cont1a": {"cont2a": {"leaf2e": [1, 40000]}}}`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value 40000 for /cont1a/cont2a/leaf2e[1] is out of the range of int16")
+}
+
 func Test_findModelRwPathNoIndicesNew(t *testing.T) {
 	tests := map[string]*findIdxTestRwTest{
 		`/t1:cont1a/leaf1a`: {
@@ -181,7 +209,7 @@ func Test_findModelRwPathNoIndicesNew(t *testing.T) {
 	}
 
 	for searchPath, result := range tests {
-		pathObj, withNumIdx, found := findModelRwPathNoIndices(searchPath)
+		pathObj, withNumIdx, found := testModel.findModelRwPathNoIndices(searchPath)
 		assert.Equal(t, result.pathObjStr, pathObj.String())
 		assert.Equal(t, result.found, found)
 		assert.Equal(t, result.pathWithIdx, withNumIdx)
@@ -213,7 +241,7 @@ func Test_findModelRoPathNoIndicesNew(t *testing.T) {
 	}
 
 	for searchPath, result := range tests {
-		pathObj, withNumIdx, found := findModelRoPathNoIndices(searchPath)
+		pathObj, withNumIdx, found := testModel.findModelRoPathNoIndices(searchPath)
 		assert.Equal(t, result.pathObjStr, pathObj.String())
 		assert.Equal(t, result.found, found)
 		assert.Equal(t, result.pathWithIdx, withNumIdx)
@@ -247,13 +275,23 @@ func Test_handleAttribute(t *testing.T) {
 			expectedValue: `test-string`,
 			expectedType:  configapi.ValueType_STRING,
 		},
+		`/t1:cont1a/cont2a/leaf2b`: {
+			value:         `2.0`,
+			expectedPath:  `/t1:cont1a/cont2a/leaf2b`,
+			expectedValue: `2.000`,
+			expectedType:  configapi.ValueType_DECIMAL,
+		},
+		`/t1:cont1a/cont2a/leaf2d`: {
+			value:     `2.001`,
+			errString: `value 2.001 for /t1:cont1a/cont2a/leaf2d is out of range 0.001..2.000`,
+		},
 		`/t1:cont1a/leaf-non-existent`: {
 			errString: `unable to locate /t1:cont1a/leaf-non-existent in model`,
 		},
 	}
 
 	for parentPath, tt := range tests {
-		pathValue, err := handleAttribute(tt.value, parentPath)
+		pathValue, err := testModel.handleAttribute(tt.value, parentPath)
 		if tt.errString != "" {
 			assert.Errorf(t, err, tt.errString)
 		} else {
@@ -267,3 +305,61 @@ func Test_handleAttribute(t *testing.T) {
 	}
 
 }
+
+func Test_GetEffectivePathValues(t *testing.T) {
+	sampleConfig, err := ioutil.ReadFile("testdata/sample-testdevice-1-config.json")
+	assert.NoError(t, err)
+
+	// Every leaf with a default is present
+	effectiveValues, err := testModel.GetEffectivePathValues("", sampleConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, 35, len(effectiveValues))
+	for _, effectiveValue := range effectiveValues {
+		assert.False(t, effectiveValue.Defaulted, effectiveValue.Path)
+	}
+
+	// leaf2a takes its default in cont2a
+	withoutLeaf2a := strings.Replace(string(sampleConfig), `"leaf2a": 1,`, "", 1)
+	effectiveValues, err = testModel.GetEffectivePathValues("", []byte(withoutLeaf2a))
+	assert.NoError(t, err)
+	assert.Equal(t, 35, len(effectiveValues))
+	defaultedValue := effectiveValues[len(effectiveValues)-1]
+	assert.True(t, defaultedValue.Defaulted)
+	assert.Equal(t, "/t1:cont1a/cont2a/leaf2a", defaultedValue.Path)
+	assert.Equal(t, configapi.ValueType_UINT, defaultedValue.Value.Type)
+	assert.Equal(t, "2", defaultedValue.Value.ValueToString())
+	for _, effectiveValue := range effectiveValues[:len(effectiveValues)-1] {
+		assert.False(t, effectiveValue.Defaulted, effectiveValue.Path)
+	}
+
+	// Only the containers present get defaults
+	effectiveValues, err = testModel.GetEffectivePathValues("", []byte(`{"cont1a": {"leaf1a": "leaf1aval"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(effectiveValues))
+	effectiveValues, err = testModel.GetEffectivePathValues("/cont1a/cont2a", []byte(`{"leaf2b": "0.432"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(effectiveValues))
+	assert.Equal(t, "/t1:cont1a/cont2a/leaf2a", effectiveValues[1].Path)
+	assert.True(t, effectiveValues[1].Defaulted)
+}
+
+func Test_parentOfPath(t *testing.T) {
+	assert.Equal(t, "/a/b[name=x/y]", parentOfPath("/a/b[name=x/y]/c"))
+	assert.Equal(t, "/a", parentOfPath("/a/b[name=x/y]"))
+	assert.Equal(t, "", parentOfPath("/a"))
+}
+
+func Test_removePathIndices(t *testing.T) {
+	assert.Equal(t, "/t1:cont1a/list2a/name", removePathIndices("/t1:cont1a/list2a[name=*]/name"))
+	assert.Equal(t, "/cont1a/list4/list4a/displayname", removePathIndices("/cont1a/list4[id=l2a1]/list4a[fkey1=five][fkey2=7]/displayname"))
+	assert.Equal(t, "/cont1a/list2a", removePathIndices("/cont1a/list2a[0]"))
+	assert.Equal(t, "/cont1a/list2a[name=l2a1", removePathIndices("/cont1a/list2a[name=l2a1"))
+	assert.Equal(t, "/cont1a/leaf1a", removePathIndices("/cont1a/leaf1a"))
+}
+
+func Test_indicesOfPath(t *testing.T) {
+	model := devicesimModel(t)
+	assert.Equal(t, []string{"name"}, model.indicesOfPath("/interfaces/interface"))
+	assert.Equal(t, []string{"name"}, model.indicesOfPath("/interfaces/interface[0]"))
+	assert.Equal(t, []string{}, testModel.indicesOfPath("/cont1a/leaf1a"))
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// ValidateChanges validates the configuration that results from applying a
+// set of changes, updates and deletes, to a base JSON configuration. The
+// violations are reported as by ValidateConfig; those that the changes
+// introduced also list the paths of the changes that caused them: the changes
+// at, above or below the path of the violation or, failing that, the change
+// after which the violation first appears when the changes are applied one at
+// a time. Changes that cannot be applied are reported as ReasonChange
+// violations.
+func (s *ModelPluginServer) ValidateChanges(ctx context.Context, baseJSON []byte, changes []*configapi.PathValue) (*admin.ValidateConfigResponse, error) {
+	log.Infof("Received validate changes request: %d change(s)", len(changes))
+	base, err := s.unmarshalBase(baseJSON)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	baseViolations, err := s.validate(base)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+
+	device, err := s.unmarshalBase(baseJSON)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	changeErrors := make([]*Violation, 0)
+	for _, change := range changes {
+		if err := s.applyChange(device, change); err != nil {
+			changeErrors = append(changeErrors, &Violation{
+				Reason:  ReasonChange,
+				Path:    change.Path,
+				Message: err.Error(),
+				Changes: []string{change.Path},
+			})
+		}
+	}
+	if len(changeErrors) > 0 {
+		return nil, s.invalidConfigError(changeErrors)
+	}
+
+	violations, err := s.validate(device)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if len(violations) == 0 {
+		return &admin.ValidateConfigResponse{Valid: true}, nil
+	}
+
+	existing := make(map[string]bool)
+	for _, v := range baseViolations {
+		existing[v.key()] = true
+	}
+	unrelated := make(map[string][]*Violation)
+	for _, v := range violations {
+		if existing[v.key()] {
+			continue
+		}
+		for _, change := range changes {
+			if relatedPaths(v.Path, change.Path) {
+				v.Changes = append(v.Changes, change.Path)
+			}
+		}
+		if len(v.Changes) == 0 {
+			unrelated[v.key()] = append(unrelated[v.key()], v)
+		}
+	}
+	if len(unrelated) > 0 {
+		if err := s.replayChanges(baseJSON, changes, unrelated); err != nil {
+			return nil, errors.Status(err).Err()
+		}
+	}
+	return nil, s.invalidConfigError(violations)
+}
+
+// replayChanges applies the changes one at a time to the base configuration,
+// to find the change after which each of the unrelated violations appears
+func (s *ModelPluginServer) replayChanges(baseJSON []byte, changes []*configapi.PathValue, unrelated map[string][]*Violation) error {
+	device, err := s.unmarshalBase(baseJSON)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if err := s.applyChange(device, change); err != nil {
+			return errors.NewInvalid("Unable to apply change to %s: %v", change.Path, err)
+		}
+		violations, err := s.validate(device)
+		if err != nil {
+			return err
+		}
+		for _, v := range violations {
+			for _, u := range unrelated[v.key()] {
+				u.Changes = []string{change.Path}
+			}
+			delete(unrelated, v.key())
+		}
+		if len(unrelated) == 0 {
+			return nil
+		}
+	}
+	// Not expected, since the violations are found once all the changes are applied
+	for _, vs := range unrelated {
+		for _, v := range vs {
+			for _, change := range changes {
+				v.Changes = append(v.Changes, change.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// unmarshalBase unmarshals a base configuration, which may be empty
+func (s *ModelPluginServer) unmarshalBase(baseJSON []byte) (ygot.ValidatedGoStruct, error) {
+	if len(strings.TrimSpace(string(baseJSON))) == 0 {
+		return s.newRoot()
+	}
+	return s.unmarshalConfigValues(baseJSON)
+}
+
+// applyChange updates or deletes the node at the path of a change
+func (s *ModelPluginServer) applyChange(device ygot.ValidatedGoStruct, change *configapi.PathValue) error {
+	path, err := ygot.StringToStructuredPath(change.Path)
+	if err != nil {
+		return err
+	}
+	if change.Deleted {
+		return ytypes.DeleteNode(s.schema.RootSchema(), device, path)
+	}
+	value, err := toGnmiTypedValue(&change.Value)
+	if err != nil {
+		return err
+	}
+	return ytypes.SetNode(s.schema.RootSchema(), device, path, value, &ytypes.InitMissingElements{})
+}
+
+// toGnmiTypedValue converts a value of onos-config to a gNMI value, as
+// expected by YGOT
+func toGnmiTypedValue(tv *configapi.TypedValue) (*gnmi.TypedValue, error) {
+	switch tv.Type {
+	case configapi.ValueType_STRING:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: (*configapi.TypedString)(tv).String()}}, nil
+	case configapi.ValueType_INT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: int64((*configapi.TypedInt)(tv).Int())}}, nil
+	case configapi.ValueType_UINT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64((*configapi.TypedUint)(tv).Uint())}}, nil
+	case configapi.ValueType_BOOL:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: (*configapi.TypedBool)(tv).Bool()}}, nil
+	case configapi.ValueType_DECIMAL:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: decimalFloat((*configapi.TypedDecimal)(tv).Decimal64())}}, nil
+	case configapi.ValueType_FLOAT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: float64((*configapi.TypedFloat)(tv).Float32())}}, nil
+	case configapi.ValueType_DOUBLE:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: (*configapi.TypedDouble)(tv).Double()}}, nil
+	case configapi.ValueType_BYTES:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: (*configapi.TypedBytes)(tv).ByteArray()}}, nil
+	}
+
+	elements := make([]*gnmi.TypedValue, 0)
+	switch tv.Type {
+	case configapi.ValueType_LEAFLIST_STRING:
+		for _, v := range (*configapi.TypedLeafListString)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_INT:
+		values, _ := (*configapi.TypedLeafListInt)(tv).List()
+		for _, v := range values {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_UINT:
+		values, _ := (*configapi.TypedLeafListUint)(tv).List()
+		for _, v := range values {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_BOOL:
+		for _, v := range (*configapi.TypedLeafListBool)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_DECIMAL:
+		digits, precision := (*configapi.TypedLeafListDecimal)(tv).List()
+		for _, d := range digits {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: decimalFloat(d, precision)}})
+		}
+	case configapi.ValueType_LEAFLIST_FLOAT:
+		for _, v := range (*configapi.TypedLeafListFloat)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: float64(v)}})
+		}
+	case configapi.ValueType_LEAFLIST_DOUBLE:
+		for _, v := range (*configapi.TypedLeafListDouble)(tv).ListDouble() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_BYTES:
+		for _, v := range (*configapi.TypedLeafListBytes)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: v}})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", tv.Type)
+	}
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{Element: elements}}}, nil
+}
+
+var pathKeysRegex = regexp.MustCompile(`\[[^\]]*\]`)
+
+// relatedPaths reports whether a path is at, above or below another one,
+// ignoring the list keys, since the schema violations have none, and the
+// module prefixes
+func relatedPaths(path1 string, path2 string) bool {
+	p1, p2 := schemaPath(path1), schemaPath(path2)
+	return p1 == p2 || strings.HasPrefix(p1, p2+"/") || strings.HasPrefix(p2, p1+"/")
+}
+
+func schemaPath(path string) string {
+	elems := strings.Split(pathKeysRegex.ReplaceAllString(path, ""), "/")
+	for i, elem := range elems {
+		if j := strings.Index(elem, ":"); j >= 0 {
+			elems[i] = elem[j+1:]
+		}
+	}
+	return strings.TrimSuffix(strings.Join(elems, "/"), "/")
+}
+
+// decimalFloat converts the digits of a decimal64 at a precision to the
+// nearest float, as YGOT does for the decimal values of gNMI
+func decimalFloat(digits int64, precision uint8) float64 {
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	value, _ := new(big.Rat).SetFrac(big.NewInt(digits), denominator).Float64()
+	return value
+}
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// listSizeErrors are found in the messages of the min-elements and
+// max-elements errors of YGOT, which only checks the lists that are present;
+// these constraints are validated by validateListConstraints instead. YGOT
+// returns them as plain errors, so TestListSizeErrors pins their text.
+var listSizeErrors = []string{
+	" contains fewer than min required elements: ",
+	" contains more than max allowed elements: ",
+}
+
+func isListSizeError(msg string) bool {
+	for _, listSizeError := range listSizeErrors {
+		if strings.HasPrefix(msg, "list ") && strings.Contains(msg, listSizeError) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateListConstraints walks the schema together with the Go struct of
+// the configuration and checks the min-elements and max-elements of the
+// lists and leaf-lists, and the unique statements of the lists. The lists of
+// the absent non-presence containers count as empty, unless the containers
+// are in a choice case or have a when statement, as they may not exist.
+func validateListConstraints(schema *yang.Entry, goStruct reflect.Value, path string) []*Violation {
+	violations := make([]*Violation, 0)
+	if goStruct.Kind() != reflect.Ptr || goStruct.Elem().Kind() != reflect.Struct {
+		return violations
+	}
+	structValue := goStruct.Elem()
+	for i := 0; i < structValue.NumField(); i++ {
+		field := structValue.Type().Field(i)
+		if util.IsYgotAnnotation(field) || field.Tag.Get("path") == "" {
+			continue
+		}
+		fieldSchema, err := util.ChildSchema(schema, field)
+		if err != nil || fieldSchema == nil {
+			continue
+		}
+		fieldPath := path + "/" + strings.Split(field.Tag.Get("path"), "|")[0]
+		fieldValue := structValue.Field(i)
+
+		switch {
+		case fieldSchema.IsList():
+			violations = append(violations, validateList(fieldSchema, fieldValue, fieldPath)...)
+		case fieldSchema.IsLeafList():
+			violations = append(violations, validateSize(fieldSchema, fieldValue.Len(), fieldPath, nil)...)
+		case fieldSchema.IsDir():
+			if fieldValue.Kind() != reflect.Ptr {
+				continue
+			}
+			if fieldValue.IsNil() {
+				if _, presence := fieldSchema.Extra["presence"]; presence || isConditional(fieldSchema) {
+					continue
+				}
+				fieldValue = reflect.New(fieldValue.Type().Elem())
+			}
+			violations = append(violations, validateListConstraints(fieldSchema, fieldValue, fieldPath)...)
+		}
+	}
+	return violations
+}
+
+// isConditional tells if a node is in a choice case, or has a when statement
+// of its own or of the augment or uses statement that added it
+func isConditional(schema *yang.Entry) bool {
+	if len(schema.Extra["when"]) > 0 {
+		return true
+	}
+	parent := schema.Parent
+	if parent == nil {
+		return false
+	}
+	if parent.IsCase() || parent.IsChoice() {
+		return true
+	}
+	for _, augment := range parent.Augmented {
+		if _, ok := augment.Dir[schema.Name]; ok && len(augment.Extra["when"]) > 0 {
+			return true
+		}
+	}
+	for _, uses := range parent.Uses {
+		if uses.Uses == nil || uses.Uses.When == nil || uses.Grouping == nil {
+			continue
+		}
+		if _, ok := uses.Grouping.Dir[schema.Name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// listEntry is an entry of a list and the keys that identify it in a path
+type listEntry struct {
+	keys  string
+	value reflect.Value
+}
+
+func validateList(schema *yang.Entry, list reflect.Value, path string) []*Violation {
+	entries := listEntries(schema, list)
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.keys)
+	}
+	violations := validateSize(schema, len(entries), path, keys)
+
+	for _, unique := range extractUnique(schema) {
+		entriesByValues := make(map[string][]string)
+		for _, entry := range entries {
+			if values, ok := uniqueValues(entry.value, unique); ok {
+				entriesByValues[values] = append(entriesByValues[values], entry.keys)
+			}
+		}
+		duplicates := make([]string, 0)
+		for _, entryKeys := range entriesByValues {
+			if len(entryKeys) > 1 {
+				duplicates = append(duplicates, entryKeys...)
+			}
+		}
+		if len(duplicates) > 0 {
+			sort.Strings(duplicates)
+			violations = append(violations, &Violation{
+				Reason:     ReasonUnique,
+				Path:       path,
+				Message:    fmt.Sprintf("entries %s have the same values of '%s'", strings.Join(duplicates, ", "), unique),
+				Constraint: fmt.Sprintf("unique %s", unique),
+				Keys:       duplicates,
+			})
+		}
+	}
+
+	for _, entry := range entries {
+		violations = append(violations, validateListConstraints(schema, entry.value, path+entry.keys)...)
+	}
+	return violations
+}
+
+// validateSize checks the min-elements and max-elements of a list or
+// leaf-list; max-elements 0 stands for unbounded, as in YGOT
+func validateSize(schema *yang.Entry, size int, path string, keys []string) []*Violation {
+	if schema.ListAttr == nil {
+		return nil
+	}
+	violations := make([]*Violation, 0)
+	if uint64(size) < schema.ListAttr.MinElements {
+		violations = append(violations, &Violation{
+			Reason:     ReasonMinElements,
+			Path:       path,
+			Message:    fmt.Sprintf("%d element(s) is fewer than min-elements %d", size, schema.ListAttr.MinElements),
+			Constraint: fmt.Sprintf("min-elements %d", schema.ListAttr.MinElements),
+			Keys:       keys,
+		})
+	}
+	if schema.ListAttr.MaxElements != 0 && uint64(size) > schema.ListAttr.MaxElements {
+		violations = append(violations, &Violation{
+			Reason:     ReasonMaxElements,
+			Path:       path,
+			Message:    fmt.Sprintf("%d element(s) is more than max-elements %d", size, schema.ListAttr.MaxElements),
+			Constraint: fmt.Sprintf("max-elements %d", schema.ListAttr.MaxElements),
+			Keys:       keys,
+		})
+	}
+	return violations
+}
+
+// listEntries returns the entries of a list, sorted by keys. Keyed lists are
+// maps, whose keys are either the value of the only key or a struct of the
+// values of the keys; lists without keys are slices.
+func listEntries(schema *yang.Entry, list reflect.Value) []*listEntry {
+	entries := make([]*listEntry, 0, list.Len())
+	switch list.Kind() {
+	case reflect.Map:
+		keyNames := strings.Fields(schema.Key)
+		iter := list.MapRange()
+		for iter.Next() {
+			key := iter.Key()
+			var keys strings.Builder
+			if key.Kind() == reflect.Struct {
+				for i := 0; i < key.NumField(); i++ {
+					fmt.Fprintf(&keys, "[%s=%v]", key.Type().Field(i).Tag.Get("path"), key.Field(i).Interface())
+				}
+			} else if len(keyNames) > 0 {
+				fmt.Fprintf(&keys, "[%s=%v]", keyNames[0], key.Interface())
+			}
+			entries = append(entries, &listEntry{keys: keys.String(), value: iter.Value()})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].keys < entries[j].keys
+		})
+	case reflect.Slice:
+		for i := 0; i < list.Len(); i++ {
+			entries = append(entries, &listEntry{keys: fmt.Sprintf("[%d]", i), value: list.Index(i)})
+		}
+	}
+	return entries
+}
+
+// extractUnique - the unique statements are crammed in to the Extra field
+// of the yang.Entry
+func extractUnique(schema *yang.Entry) []string {
+	uniques := make([]string, 0)
+	for _, s := range schema.Extra["unique"] {
+		switch u := s.(type) {
+		case *yang.Value:
+			uniques = append(uniques, u.Name)
+		case map[string]interface{}:
+			if name, ok := u["Name"].(string); ok {
+				uniques = append(uniques, name)
+			}
+		}
+	}
+	return uniques
+}
+
+// uniqueValues formats the values of the leaves of a unique statement in a
+// list entry. As in RFC 7950, an entry where one of the leaves is not set
+// is not subject to the constraint.
+func uniqueValues(entry reflect.Value, unique string) (string, bool) {
+	values := make([]string, 0)
+	for _, leafPath := range strings.Fields(unique) {
+		steps := strings.Split(leafPath, "/")
+		for i, step := range steps {
+			if j := strings.Index(step, ":"); j >= 0 {
+				steps[i] = step[j+1:]
+			}
+		}
+		value, ok := descendant(entry, strings.Join(steps, "/"))
+		if !ok {
+			return "", false
+		}
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	return strings.Join(values, "\x00"), true
+}
+
+// descendant returns the value of the leaf at path in a Go struct
+func descendant(goStruct reflect.Value, path string) (interface{}, bool) {
+	if goStruct.Kind() != reflect.Ptr || goStruct.IsNil() || goStruct.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	structValue := goStruct.Elem()
+	for i := 0; i < structValue.NumField(); i++ {
+		for _, fieldPath := range strings.Split(structValue.Type().Field(i).Tag.Get("path"), "|") {
+			if fieldPath == "" {
+				continue
+			}
+			field := structValue.Field(i)
+			if fieldPath == path {
+				if util.IsValueNil(field.Interface()) {
+					return nil, false
+				}
+				if field.Kind() == reflect.Ptr {
+					field = field.Elem()
+				}
+				return field.Interface(), true
+			}
+			if strings.HasPrefix(path, fieldPath+"/") {
+				return descendant(field, strings.TrimPrefix(path, fieldPath+"/"))
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// DefaultPort is the default gRPC port of model plugins
+const DefaultPort = 5152
+
+var logLevels = map[string]logging.Level{
+	"debug": logging.DebugLevel,
+	"info":  logging.InfoLevel,
+	"warn":  logging.WarnLevel,
+	"error": logging.ErrorLevel,
+}
+
+// Config is the configuration of a model plugin process
+type Config struct {
+	Port     uint16
+	TLSCert  string
+	TLSKey   string
+	CACert   string
+	LogLevel logging.Level
+}
+
+// ParseFlags parses the command line arguments of a model plugin. For
+// compatibility, the port may also be given as the only argument.
+func ParseFlags(name string, args []string) (*Config, error) {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	port := flags.Uint("port", DefaultPort, "gRPC port of the model plugin service")
+	tlsCert := flags.String("tls-cert", "", "TLS certificate file of the server; the service is plain text without it")
+	tlsKey := flags.String("tls-key", "", "TLS key file of the server")
+	caCert := flags.String("ca-cert", "", "CA certificate file used to verify the certificates of the clients, which are then required")
+	logLevel := flags.String("log-level", "info", "log level: debug, info, warn or error")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	portSet := false
+	flags.Visit(func(f *flag.Flag) { portSet = portSet || f.Name == "port" })
+	if !portSet && flags.NArg() > 0 {
+		p, err := strconv.ParseUint(flags.Arg(0), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gRPC port '%s'", flags.Arg(0))
+		}
+		*port = uint(p)
+	}
+	if *port == 0 || *port > 65535 {
+		return nil, fmt.Errorf("invalid gRPC port %d", *port)
+	}
+
+	level, ok := logLevels[strings.ToLower(*logLevel)]
+	if !ok {
+		return nil, fmt.Errorf("invalid log level '%s'", *logLevel)
+	}
+	if (*tlsCert == "") != (*tlsKey == "") {
+		return nil, fmt.Errorf("--tls-cert and --tls-key must be given together")
+	}
+	if *caCert != "" && *tlsCert == "" {
+		return nil, fmt.Errorf("--ca-cert requires --tls-cert and --tls-key")
+	}
+	return &Config{
+		Port:     uint16(*port),
+		TLSCert:  *tlsCert,
+		TLSKey:   *tlsKey,
+		CACert:   *caCert,
+		LogLevel: level,
+	}, nil
+}
+
+// ServerOptions returns the gRPC server options of the configuration: TLS is
+// enabled by a certificate and key, and the client certificates are verified
+// against the CA certificate if one is given
+func (c *Config) ServerOptions() ([]grpc.ServerOption, error) {
+	if c.TLSCert == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.TLSCert, c.TLSKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.CACert != "" {
+		ca, err := ioutil.ReadFile(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.ClientCAs = x509.NewCertPool()
+		if !tlsCfg.ClientCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in '%s'", c.CACert)
+		}
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsCfg))}, nil
+}
+
+// Serve serves the model plugin and the gRPC health services on lis until
+// ctx is done, then stops gracefully
+func (s *ModelPluginServer) Serve(ctx context.Context, lis net.Listener, opts ...grpc.ServerOption) error {
+	gs := grpc.NewServer(opts...)
+	s.Register(gs)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(gs, healthServer)
+
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping model plugin %s-%s", s.model.Name, s.model.Version)
+			healthServer.Shutdown()
+			gs.GracefulStop()
+		case <-stopped:
+		}
+	}()
+	defer close(stopped)
+
+	log.Infof("Serving model plugin %s-%s on %s", s.model.Name, s.model.Version, lis.Addr())
+	return gs.Serve(lis)
+}
+
+// Run serves the model plugin as configured until the process is terminated
+func Run(model Model, cfg *Config) error {
+	logging.SetLevel(cfg.LogLevel)
+	server, err := NewModelPluginServer(model)
+	if err != nil {
+		return err
+	}
+	opts, err := cfg.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("unable to load TLS credentials: %v", err)
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		return err
+	}
+	// Stop serving cleanly when the container is stopped
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+	return server.Serve(ctx, lis, opts...)
+}
+
+// Main is the main function of model plugins: it parses the command line and
+// runs the model plugin, exiting on failure
+func Main(model Model) {
+	cfg, err := ParseFlags(os.Args[0], os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err := Run(model, cfg); err != nil {
+		log.Fatalf("Model plugin %s-%s failed: %+v", model.Name, model.Version, err)
+	}
+}
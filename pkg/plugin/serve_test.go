@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"context"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	cfg, err := ParseFlags("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{Port: DefaultPort, LogLevel: logging.InfoLevel}, cfg)
+
+	cfg, err = ParseFlags("test", []string{"--port", "40000", "--log-level", "DEBUG",
+		"--tls-cert", "tls.crt", "--tls-key", "tls.key", "--ca-cert", "ca.crt"})
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{Port: 40000, TLSCert: "tls.crt", TLSKey: "tls.key", CACert: "ca.crt", LogLevel: logging.DebugLevel}, cfg)
+
+	// The port used to be the only argument
+	cfg, err = ParseFlags("test", []string{"50000"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(50000), cfg.Port)
+
+	for _, args := range [][]string{
+		{"--port", "70000"},
+		{"--port", "0"},
+		{"port"},
+		{"--log-level", "verbose"},
+		{"--tls-cert", "tls.crt"},
+		{"--ca-cert", "ca.crt"},
+	} {
+		_, err := ParseFlags("test", args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+func TestServe(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+	lis, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.Serve(ctx, lis)
+	}()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	health, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, health.Status)
+
+	info, err := admin.NewModelPluginServiceClient(conn).GetModelInfo(context.Background(), &admin.ModelInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", info.ModelInfo.Name)
+
+	// Serving stops cleanly once the context is done
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugin implements the gRPC service of config model plugins, on top
+// of the Go bindings generated for each config model
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/grpc"
+	"reflect"
+)
+
+var log = logging.GetLogger("config-model", "plugin")
+
+// Model adapts the Go bindings generated for a config model to the model plugin server
+type Model struct {
+	Name         string
+	Version      string
+	GetStateMode uint32
+	// ModelData lists the YANG modules of the model
+	ModelData func() []*gnmi.ModelData
+	// Encodings lists the encodings supported by the model
+	Encodings func() []gnmi.Encoding
+	// Schema returns the schema of the bindings; its root is the root struct of the model
+	Schema func() (*ytypes.Schema, error)
+	// Unmarshal unmarshals RFC7951 JSON into a struct of the bindings
+	Unmarshal ytypes.UnmarshalFunc
+}
+
+// ModelPluginServer implements the model plugin gRPC service of a config model
+type ModelPluginServer struct {
+	model     Model
+	schema    *ytypes.Schema
+	pathModel *path.PathModel
+}
+
+// NewModelPluginServer creates the model plugin server of a config model,
+// extracting the read-only and read-write paths from its schema
+func NewModelPluginServer(model Model) (*ModelPluginServer, error) {
+	schema, err := model.Schema()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the schema of model %s-%s: %v", model.Name, model.Version, err)
+	}
+	if schema.Root == nil {
+		return nil, fmt.Errorf("the schema of model %s-%s has no root", model.Name, model.Version)
+	}
+	// The values of the identities are only found in the Go bindings
+	opts := make([]path.ModelOption, 0)
+	if root, ok := schema.Root.(ygot.ValidatedGoStruct); ok {
+		opts = append(opts, path.WithEnumTypes(root.ΛEnumTypeMap()))
+	}
+	pathModel, err := path.NewPathModel(schema.SchemaTree, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract the paths of model %s-%s: %v", model.Name, model.Version, err)
+	}
+	return &ModelPluginServer{
+		model:     model,
+		schema:    schema,
+		pathModel: pathModel,
+	}, nil
+}
+
+// Register registers the model plugin service with the gRPC server
+func (s *ModelPluginServer) Register(gs *grpc.Server) {
+	log.Info("Registering model plugin service")
+	admin.RegisterModelPluginServiceServer(gs, s)
+}
+
+// GetModelInfo returns the description of the config model
+func (s *ModelPluginServer) GetModelInfo(ctx context.Context, request *admin.ModelInfoRequest) (*admin.ModelInfoResponse, error) {
+	log.Infof("Received model info request: %+v", request)
+	return &admin.ModelInfoResponse{
+		ModelInfo: &admin.ModelInfo{
+			Name:                s.model.Name,
+			Version:             s.model.Version,
+			ModelData:           s.model.ModelData(),
+			SupportedEncodings:  s.model.Encodings(),
+			GetStateMode:        s.model.GetStateMode,
+			ReadOnlyPath:        s.pathModel.ReadOnlyPaths(),
+			ReadWritePath:       s.pathModel.ReadWritePaths(),
+			NamespaceMappings:   s.pathModel.Namespaces(),
+			SouthboundUsePrefix: false,
+		},
+	}, nil
+}
+
+// ValidateConfig validates a JSON configuration against the schema, the
+// must and when statements, the leafrefs and the list constraints of the
+// config model. All the violations are reported at
+// once, as ErrorInfo details of an InvalidArgument status; see Violations.
+func (s *ModelPluginServer) ValidateConfig(ctx context.Context, request *admin.ValidateConfigRequest) (*admin.ValidateConfigResponse, error) {
+	log.Infof("Received validate config request: %s", request.String())
+	device, err := s.unmarshalConfigValues(request.Json)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+
+	violations, err := s.validate(device)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	if len(violations) > 0 {
+		return nil, s.invalidConfigError(violations)
+	}
+	return &admin.ValidateConfigResponse{Valid: true}, nil
+}
+
+// validate returns all the violations of a configuration
+func (s *ModelPluginServer) validate(device ygot.ValidatedGoStruct) ([]*Violation, error) {
+	violations := make([]*Violation, 0)
+	// The leafrefs are validated by the navigator, which also checks the
+	// leaf-lists and respects require-instance false
+	if err := device.Validate(&ytypes.LeafrefOptions{IgnoreMissingData: true}); err != nil {
+		violations = append(violations, schemaViolations(err, s.schema.RootSchema().Name)...)
+	}
+
+	navigatorViolations, err := s.validateXPath(device)
+	if err != nil {
+		return nil, err
+	}
+	violations = append(violations, navigatorViolations...)
+	violations = append(violations, validateListConstraints(s.schema.RootSchema(), reflect.ValueOf(device), "")...)
+	return violations, nil
+}
+
+// GetPathValues flattens a JSON configuration into path values
+func (s *ModelPluginServer) GetPathValues(ctx context.Context, request *admin.PathValuesRequest) (*admin.PathValuesResponse, error) {
+	log.Infof("Received path values request: %+v", request)
+	pathValues, err := s.pathModel.GetPathValues(request.PathPrefix, request.Json)
+	if err != nil {
+		return nil, errors.Status(errors.NewInvalid("Unable to get path values: %+v", err)).Err()
+	}
+	return &admin.PathValuesResponse{PathValues: pathValues}, nil
+}
+
+// newRoot creates an empty root struct of the bindings
+func (s *ModelPluginServer) newRoot() (ygot.ValidatedGoStruct, error) {
+	root, ok := reflect.New(reflect.TypeOf(s.schema.Root).Elem()).Interface().(ygot.ValidatedGoStruct)
+	if !ok {
+		return nil, errors.NewInvalid("Unable to create the root of model %s-%s", s.model.Name, s.model.Version)
+	}
+	return root, nil
+}
+
+func (s *ModelPluginServer) unmarshalConfigValues(jsonTree []byte) (ygot.ValidatedGoStruct, error) {
+	device, err := s.newRoot()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.model.Unmarshal(jsonTree, device); err != nil {
+		return nil, errors.NewInvalid("Unable to unmarshal JSON: %+v", err)
+	}
+	return device, nil
+}
+
+// validateXPath evaluates all the must and when statements and leafrefs of
+// the config model, returning the statements that are false and the dangling
+// references
+func (s *ModelPluginServer) validateXPath(device ygot.ValidatedGoStruct) ([]*Violation, error) {
+	log.Infof("Validating must and when statements and leafrefs of device: %v", device)
+	// The navigator annotates the schema entries, so it needs a schema of its own
+	schema, err := s.model.Schema()
+	if err != nil {
+		return nil, errors.NewInvalid("Unable to get schema: %+v", err)
+	}
+	nn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
+	ynn, ok := nn.(*navigator.YangNodeNavigator)
+	if !ok {
+		return nil, errors.NewInvalid("Cannot cast NodeNavigator to YangNodeNavigator")
+	}
+	violations := make([]*Violation, 0)
+	err = ynn.WalkAndValidateMust()
+	if mustErrs, ok := err.(navigator.MustViolations); ok {
+		violations = append(violations, mustViolations(mustErrs)...)
+	} else if err != nil {
+		return nil, errors.NewInvalid("%v", err)
+	}
+	err = ynn.WalkAndValidateWhen()
+	if whenErrs, ok := err.(navigator.WhenViolations); ok {
+		violations = append(violations, whenViolations(whenErrs)...)
+	} else if err != nil {
+		return nil, errors.NewInvalid("%v", err)
+	}
+	err = ynn.WalkAndValidateLeafrefs()
+	if leafrefErrs, ok := err.(navigator.LeafrefViolations); ok {
+		violations = append(violations, leafrefViolations(leafrefErrs)...)
+	} else if err != nil {
+		return nil, errors.NewInvalid("%v", err)
+	}
+	return violations, nil
+}
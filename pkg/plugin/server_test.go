@@ -0,0 +1,531 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+// Device, Cont1A, List2A and Cont3A stand in for the bindings generated for testdata/test-plugin.yang
+type Device struct {
+	Cont1A *Cont1A            `path:"cont1a" module:"test-plugin"`
+	List2A map[string]*List2A `path:"list2a" module:"test-plugin"`
+	Cont3A *Cont3A            `path:"cont3a" module:"test-plugin"`
+}
+
+func (*Device) IsYANGGoStruct() {}
+
+func (d *Device) Validate(opts ...ygot.ValidationOption) error {
+	return validate(d, opts...)
+}
+
+func (*Device) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*Device) ΛBelongingModule() string { return "" }
+
+type Cont1A struct {
+	Leaf1A *string `path:"leaf1a" module:"test-plugin"`
+	Leaf1B *uint8  `path:"leaf1b" module:"test-plugin"`
+	Leaf1C *string `path:"leaf1c" module:"test-plugin"`
+	Leaf1D *string `path:"leaf1d" module:"test-plugin"`
+	Leaf1E *string `path:"leaf1e" module:"test-plugin"`
+	Leaf1F *string `path:"leaf1f" module:"test-plugin"`
+	Leaf1G *string `path:"leaf1g" module:"test-plugin"`
+}
+
+func (*Cont1A) IsYANGGoStruct() {}
+
+func (c *Cont1A) Validate(opts ...ygot.ValidationOption) error {
+	return validate(c, opts...)
+}
+
+func (*Cont1A) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*Cont1A) ΛBelongingModule() string { return "test-plugin" }
+
+type List2A struct {
+	Name    *string  `path:"name" module:"test-plugin"`
+	TxPower *uint16  `path:"tx-power" module:"test-plugin"`
+	RxPower *uint16  `path:"rx-power" module:"test-plugin"`
+	Tags    []string `path:"tags" module:"test-plugin"`
+}
+
+func (*List2A) IsYANGGoStruct() {}
+
+func (l *List2A) Validate(opts ...ygot.ValidationOption) error {
+	return validate(l, opts...)
+}
+
+func (*List2A) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*List2A) ΛBelongingModule() string { return "test-plugin" }
+
+func (l *List2A) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *l.Name}, nil
+}
+
+type Cont3A struct {
+	Leaf3A *string        `path:"leaf3a" module:"test-plugin"`
+	Static *Cont3A_Static `path:"static" module:"test-plugin"`
+	Dhcp   *bool          `path:"dhcp" module:"test-plugin"`
+	Cont3B *Cont3A_Cont3B `path:"cont3b" module:"test-plugin"`
+}
+
+func (*Cont3A) IsYANGGoStruct() {}
+
+func (c *Cont3A) Validate(opts ...ygot.ValidationOption) error {
+	return validate(c, opts...)
+}
+
+func (*Cont3A) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*Cont3A) ΛBelongingModule() string { return "test-plugin" }
+
+type Cont3A_Static struct {
+	Addresses []string `path:"addresses" module:"test-plugin"`
+}
+
+func (*Cont3A_Static) IsYANGGoStruct() {}
+
+func (c *Cont3A_Static) Validate(opts ...ygot.ValidationOption) error {
+	return validate(c, opts...)
+}
+
+func (*Cont3A_Static) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*Cont3A_Static) ΛBelongingModule() string { return "test-plugin" }
+
+type Cont3A_Cont3B struct {
+	Items []string `path:"items" module:"test-plugin"`
+}
+
+func (*Cont3A_Cont3B) IsYANGGoStruct() {}
+
+func (c *Cont3A_Cont3B) Validate(opts ...ygot.ValidationOption) error {
+	return validate(c, opts...)
+}
+
+func (*Cont3A_Cont3B) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+
+func (*Cont3A_Cont3B) ΛBelongingModule() string { return "test-plugin" }
+
+// testSchema is the gzipped JSON schema of testdata/test-plugin.yang, as
+// the generated bindings embed it
+var testSchema []byte
+
+func init() {
+	ms := yang.NewModules()
+	ms.ParseOptions.StoreUses = true
+	if err := ms.Read("testdata/test-plugin.yang"); err != nil {
+		panic(err)
+	}
+	if errs := ms.Process(); len(errs) > 0 {
+		panic(errs)
+	}
+	module := yang.ToEntry(ms.Modules["test-plugin"])
+	cont1a := module.Dir["cont1a"]
+	cont1a.Annotation = map[string]interface{}{"structname": "Cont1A"}
+	list2a := module.Dir["list2a"]
+	list2a.Annotation = map[string]interface{}{"structname": "List2A"}
+	cont3a := module.Dir["cont3a"]
+	cont3a.Annotation = map[string]interface{}{"structname": "Cont3A"}
+	cont3a.Dir["mode"].Dir["static"].Dir["static"].Annotation = map[string]interface{}{"structname": "Cont3A_Static"}
+	cont3a.Dir["cont3b"].Annotation = map[string]interface{}{"structname": "Cont3A_Cont3B"}
+	root := &yang.Entry{
+		Name:       "device",
+		Kind:       yang.DirectoryEntry,
+		Dir:        map[string]*yang.Entry{"cont1a": cont1a, "list2a": list2a, "cont3a": cont3a},
+		Annotation: map[string]interface{}{"structname": "Device", "isFakeRoot": true},
+	}
+	data, err := json.Marshal(root)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	_, _ = gzw.Write(data)
+	_ = gzw.Close()
+	testSchema = buf.Bytes()
+}
+
+func schema() (*ytypes.Schema, error) {
+	schemaTree, err := ygot.GzipToSchema(testSchema)
+	if err != nil {
+		return nil, err
+	}
+	return &ytypes.Schema{
+		Root:       &Device{},
+		SchemaTree: schemaTree,
+		Unmarshal:  unmarshal,
+	}, nil
+}
+
+func unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	s, err := schema()
+	if err != nil {
+		return err
+	}
+	entry, ok := s.SchemaTree[reflect.TypeOf(destStruct).Elem().Name()]
+	if !ok {
+		return fmt.Errorf("no schema for %T", destStruct)
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal(data, &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(entry, destStruct, jsonTree, opts...)
+}
+
+func validate(goStruct ygot.GoStruct, opts ...ygot.ValidationOption) error {
+	s, err := schema()
+	if err != nil {
+		return err
+	}
+	if errs := ytypes.Validate(s.SchemaTree[reflect.TypeOf(goStruct).Elem().Name()], goStruct, opts...); errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+var testModel = Model{
+	Name:    "test",
+	Version: "1.0.0",
+	ModelData: func() []*gnmi.ModelData {
+		return []*gnmi.ModelData{{Name: "test-plugin", Version: "2022-10-01"}}
+	},
+	Encodings: func() []gnmi.Encoding {
+		return []gnmi.Encoding{gnmi.Encoding_JSON_IETF}
+	},
+	Schema:    schema,
+	Unmarshal: unmarshal,
+}
+
+func TestGetModelInfo(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+	resp, err := s.GetModelInfo(context.Background(), &admin.ModelInfoRequest{})
+	assert.NoError(t, err)
+	info := resp.ModelInfo
+	assert.Equal(t, "test", info.Name)
+	assert.Equal(t, "1.0.0", info.Version)
+	assert.Equal(t, "test-plugin", info.ModelData[0].Name)
+	assert.Equal(t, []gnmi.Encoding{gnmi.Encoding_JSON_IETF}, info.SupportedEncodings)
+	assert.Len(t, info.ReadWritePath, 15)
+	assert.Empty(t, info.ReadOnlyPath)
+}
+
+func TestValidateConfig(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		json  string
+		error string
+	}{
+		{"valid", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6}}`, ""},
+		{"valid again", `{"test-plugin:cont1a": {"leaf1a": "def", "leaf1b": 7}}`, ""},
+		{"not JSON", `{"test-plugin:cont1a": `, "Unable to unmarshal JSON"},
+		{"wrong type", `{"test-plugin:cont1a": {"leaf1b": "six"}}`, "Unable to unmarshal JSON"},
+		{"too long", `{"test-plugin:cont1a": {"leaf1a": "abcdefghijk", "leaf1b": 6}}`, "Invalid configuration of model test-1.0.0: 1 violation(s): /cont1a/leaf1a: "},
+		{"must", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 5}}`, "leaf1b must be greater than 5"},
+		{"leafref", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6, "leaf1d": "abc", "leaf1e": "abc"}}`, ""},
+		{"dangling leafref", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6, "leaf1d": "def"}}`, "/cont1a/leaf1d: value 'def' does not match any ../tp:leaf1a"},
+		{"when", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 11, "leaf1f": "def", "leaf1g": "ghi"}}`, ""},
+		{"false when", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6, "leaf1f": "def"}}`, "/cont1a/leaf1f: node present although when statement '../leaf1b > 10' is false"},
+		{"false uses when", `{"test-plugin:cont1a": {"leaf1a": "def", "leaf1b": 6, "leaf1g": "ghi"}}`, "/cont1a/leaf1g: node present although when statement './leaf1a = 'abc'' is false"},
+		{"leafref without instance", `{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6, "leaf1e": "def"}}`, ""},
+	}
+	for _, test := range tests {
+		resp, err := s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{Json: []byte(test.json)})
+		if test.error == "" {
+			assert.NoError(t, err, test.name)
+			assert.True(t, resp.Valid, test.name)
+			continue
+		}
+		assert.Error(t, err, test.name)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), test.name)
+		assert.Contains(t, err.Error(), test.error, test.name)
+	}
+}
+
+func TestValidateConfigViolations(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+
+	// Every schema error, false must or when statement and dangling leafref is reported
+	_, err = s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{
+		Json: []byte(`{"test-plugin:cont1a": {"leaf1a": "abcdefghijk", "leaf1b": 5, "leaf1c": "abcd", "leaf1d": "abc", "leaf1f": "def"}}`),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "5 violation(s)")
+	violations := Violations(err)
+	assert.Len(t, violations, 5)
+
+	assert.Equal(t, ReasonSchema, violations[0].Reason)
+	assert.Equal(t, "/cont1a/leaf1a", violations[0].Path)
+	assert.Contains(t, violations[0].Message, "length 11 is outside range 1..10")
+
+	assert.Equal(t, &Violation{
+		Reason:  ReasonMust,
+		Path:    "/cont1a",
+		Message: "leaf1b must be greater than 5",
+		Must:    "number(./leaf1b) > 5",
+	}, violations[1])
+	assert.Equal(t, &Violation{
+		Reason:      ReasonMust,
+		Path:        "/cont1a/leaf1c",
+		Message:     "leaf1c must be shorter than 4",
+		Must:        "string-length(.) < 4",
+		ErrorAppTag: "leaf1c-length",
+	}, violations[2])
+	assert.Equal(t, &Violation{
+		Reason:  ReasonWhen,
+		Path:    "/cont1a/leaf1f",
+		Message: "node present although when statement '../leaf1b > 10' is false",
+		When:    "../leaf1b > 10",
+	}, violations[3])
+	assert.Equal(t, &Violation{
+		Reason:     ReasonLeafref,
+		Path:       "/cont1a/leaf1d",
+		Message:    "value 'abc' does not match any ../tp:leaf1a",
+		TargetPath: "../tp:leaf1a",
+	}, violations[4])
+
+	assert.Empty(t, Violations(fmt.Errorf("not a status")))
+}
+
+func TestValidateConfigListConstraints(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+
+	resp, err := s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{
+		Json: []byte(`{"test-plugin:list2a": [
+			{"name": "l1", "tx-power": 5, "rx-power": 6, "tags": ["a"]},
+			{"name": "l2", "tx-power": 5, "rx-power": 7, "tags": ["b"]}
+		]}`),
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Valid)
+
+	// Too many entries, two with the same powers, and an entry without tags
+	_, err = s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{
+		Json: []byte(`{"test-plugin:list2a": [
+			{"name": "l1", "tx-power": 5, "rx-power": 6, "tags": ["a"]},
+			{"name": "l2", "tx-power": 5, "rx-power": 7, "tags": ["b"]},
+			{"name": "l3", "tx-power": 5, "rx-power": 6}
+		]}`),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	violations := Violations(err)
+	assert.Equal(t, []*Violation{
+		{
+			Reason:     ReasonMaxElements,
+			Path:       "/list2a",
+			Message:    "3 element(s) is more than max-elements 2",
+			Constraint: "max-elements 2",
+			Keys:       []string{"[name=l1]", "[name=l2]", "[name=l3]"},
+		},
+		{
+			Reason:     ReasonUnique,
+			Path:       "/list2a",
+			Message:    "entries [name=l1], [name=l3] have the same values of 'tx-power rx-power'",
+			Constraint: "unique tx-power rx-power",
+			Keys:       []string{"[name=l1]", "[name=l3]"},
+		},
+		{
+			Reason:     ReasonMinElements,
+			Path:       "/list2a[name=l3]/tags",
+			Message:    "0 element(s) is fewer than min-elements 1",
+			Constraint: "min-elements 1",
+		},
+	}, violations)
+}
+
+// TestListSizeErrors pins the messages of the min-elements and max-elements
+// errors of YGOT, which are skipped in favor of validateListConstraints
+func TestListSizeErrors(t *testing.T) {
+	s, err := schema()
+	assert.NoError(t, err)
+	name := func(n string) *string { return &n }
+	list2a := map[string]*List2A{
+		"l1": {Name: name("l1"), Tags: []string{"a"}},
+		"l2": {Name: name("l2"), Tags: []string{"b"}},
+		"l3": {Name: name("l3"), Tags: []string{"c"}},
+	}
+	listSchema := *s.SchemaTree["Device"].Dir["list2a"]
+	listAttr := *listSchema.ListAttr
+	listSchema.ListAttr = &listAttr
+
+	errs := ytypes.Validate(&listSchema, list2a)
+	assert.Len(t, errs, 1)
+	assert.Empty(t, schemaViolations(errs, "device"), errs)
+
+	listAttr.MinElements = 4
+	errs = ytypes.Validate(&listSchema, list2a)
+	assert.Len(t, errs, 2)
+	assert.Empty(t, schemaViolations(errs, "device"), errs)
+}
+
+func TestValidateConfigConditionalConstraints(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+
+	// The containers of an unselected case and those whose when statement is
+	// false do not exist, so their leaf-lists are not too short
+	for _, config := range []string{
+		`{"test-plugin:cont3a": {"leaf3a": "off"}}`,
+		`{"test-plugin:cont3a": {"dhcp": true}}`,
+	} {
+		resp, err := s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{Json: []byte(config)})
+		assert.NoError(t, err, config)
+		assert.True(t, resp.GetValid(), config)
+	}
+
+	// The containers that are present are checked
+	_, err = s.ValidateConfig(context.Background(), &admin.ValidateConfigRequest{
+		Json: []byte(`{"test-plugin:cont3a": {"leaf3a": "on", "static": {}, "cont3b": {}}}`),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []*Violation{
+		{
+			Reason:     ReasonMinElements,
+			Path:       "/cont3a/static/addresses",
+			Message:    "0 element(s) is fewer than min-elements 1",
+			Constraint: "min-elements 1",
+		},
+		{
+			Reason:     ReasonMinElements,
+			Path:       "/cont3a/cont3b/items",
+			Message:    "0 element(s) is fewer than min-elements 1",
+			Constraint: "min-elements 1",
+		},
+	}, Violations(err))
+}
+
+func TestToGnmiTypedValueDecimal(t *testing.T) {
+	// Decimals between -1 and 0 keep their sign
+	value, err := toGnmiTypedValue(configapi.NewTypedValueDecimal(-1, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, -0.001, value.GetDoubleVal())
+
+	value, err = toGnmiTypedValue(configapi.NewLeafListDecimalTv([]int64{-5, 1540}, 3))
+	assert.NoError(t, err)
+	elements := value.GetLeaflistVal().GetElement()
+	assert.Len(t, elements, 2)
+	assert.Equal(t, -0.005, elements[0].GetDoubleVal())
+	assert.Equal(t, 1.54, elements[1].GetDoubleVal())
+}
+
+func TestViolationsMetadata(t *testing.T) {
+	// The keys and the paths of the changes may contain the separators of a list
+	violation := &Violation{
+		Reason:     ReasonUnique,
+		Path:       "/list2a",
+		Message:    "entries have the same values of 'tx-power rx-power'",
+		Constraint: "unique tx-power rx-power",
+		Keys:       []string{"[name=l1, l2]", "[name=l3]"},
+		Changes:    []string{"/list2a[name=l1, l2]/tx-power", "/list2a[name=l3]/tx-power"},
+	}
+	st, err := status.New(codes.InvalidArgument, "invalid").WithDetails(violation.errorInfo())
+	assert.NoError(t, err)
+	assert.Equal(t, []*Violation{violation}, Violations(st.Err()))
+}
+
+func TestValidateChanges(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+	base := []byte(`{"test-plugin:cont1a": {"leaf1a": "abc", "leaf1b": 6, "leaf1d": "abc"}}`)
+
+	resp, err := s.ValidateChanges(context.Background(), base, []*configapi.PathValue{
+		{Path: "/cont1a/leaf1b", Value: *configapi.NewTypedValueUint(7, 8)},
+		{Path: "/cont1a/leaf1c", Value: *configapi.NewTypedValueString("abc")},
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Valid)
+
+	// The must statement of cont1a is above both changes; the leafref
+	// violation only appears once the second change is applied
+	_, err = s.ValidateChanges(context.Background(), base, []*configapi.PathValue{
+		{Path: "/cont1a/leaf1b", Value: *configapi.NewTypedValueUint(5, 8)},
+		{Path: "/cont1a/leaf1a", Deleted: true},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []*Violation{
+		{
+			Reason:  ReasonMust,
+			Path:    "/cont1a",
+			Message: "leaf1b must be greater than 5",
+			Must:    "number(./leaf1b) > 5",
+			Changes: []string{"/cont1a/leaf1b", "/cont1a/leaf1a"},
+		},
+		{
+			Reason:     ReasonLeafref,
+			Path:       "/cont1a/leaf1d",
+			Message:    "value 'abc' does not match any ../tp:leaf1a",
+			TargetPath: "../tp:leaf1a",
+			Changes:    []string{"/cont1a/leaf1a"},
+		},
+	}, Violations(err))
+
+	// The violations of the base configuration are not attributed to the changes
+	_, err = s.ValidateChanges(context.Background(), []byte(`{"test-plugin:cont1a": {"leaf1b": 5}}`), []*configapi.PathValue{
+		{Path: "/cont1a/leaf1c", Value: *configapi.NewTypedValueString("abc")},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	violations := Violations(err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, ReasonMust, violations[0].Reason)
+	assert.Empty(t, violations[0].Changes)
+
+	// Changes to paths that are not in the model cannot be applied
+	_, err = s.ValidateChanges(context.Background(), nil, []*configapi.PathValue{
+		{Path: "/cont1a/leaf1z", Value: *configapi.NewTypedValueString("abc")},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	violations = Violations(err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, ReasonChange, violations[0].Reason)
+	assert.Equal(t, "/cont1a/leaf1z", violations[0].Path)
+	assert.Equal(t, []string{"/cont1a/leaf1z"}, violations[0].Changes)
+}
+
+func TestGetPathValues(t *testing.T) {
+	s, err := NewModelPluginServer(testModel)
+	assert.NoError(t, err)
+	resp, err := s.GetPathValues(context.Background(), &admin.PathValuesRequest{
+		Json: []byte(`{"cont1a": {"leaf1a": "abc", "leaf1b": 6}}`),
+	})
+	assert.NoError(t, err)
+	paths := make([]string, 0)
+	for _, pv := range resp.PathValues {
+		paths = append(paths, pv.Path)
+	}
+	assert.ElementsMatch(t, []string{"/cont1a/leaf1a", "/cont1a/leaf1b"}, paths)
+}
+
+func TestNewModelPluginServerNoSchema(t *testing.T) {
+	model := testModel
+	model.Schema = func() (*ytypes.Schema, error) {
+		return nil, fmt.Errorf("no schema")
+	}
+	_, err := NewModelPluginServer(model)
+	assert.EqualError(t, err, "unable to load the schema of model test-1.0.0: no schema")
+}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/openconfig/ygot/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// Reasons of the violations, as found in the ErrorInfo details of the status
+// returned by ValidateConfig
+const (
+	ReasonSchema  = "SCHEMA_VIOLATION"
+	ReasonMust    = "MUST_VIOLATION"
+	ReasonWhen    = "WHEN_VIOLATION"
+	ReasonLeafref = "LEAFREF_VIOLATION"
+	// ReasonMinElements, ReasonMaxElements and ReasonUnique are violations of
+	// the constraints of the lists and leaf-lists
+	ReasonMinElements = "MIN_ELEMENTS_VIOLATION"
+	ReasonMaxElements = "MAX_ELEMENTS_VIOLATION"
+	ReasonUnique      = "UNIQUE_VIOLATION"
+	// ReasonChange is a change that cannot be applied to the configuration
+	ReasonChange = "INVALID_CHANGE"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details of the violations
+const ErrorDomain = "config-models.onosproject.org"
+
+// Keys of the metadata of the ErrorInfo details of the violations
+const (
+	metadataPath        = "path"
+	metadataMessage     = "message"
+	metadataMust        = "must"
+	metadataWhen        = "when"
+	metadataErrorAppTag = "error-app-tag"
+	metadataTargetPath  = "target-path"
+	metadataConstraint  = "constraint"
+	metadataKeys        = "keys"
+	metadataChanges     = "changes"
+)
+
+// Violation is one of the reasons why a configuration is invalid
+type Violation struct {
+	// Reason is one of the Reason constants
+	Reason string
+	// Path is the data path of the invalid node; for schema violations, it
+	// is the schema path, without list keys
+	Path    string
+	Message string
+	// Must is the expression of the must statement that is false
+	Must string
+	// ErrorAppTag is the error-app-tag of the must statement that is false
+	ErrorAppTag string
+	// When is the expression of the when statement that is false
+	When string
+	// TargetPath is the path of the leafref whose value matches no node
+	TargetPath string
+	// Constraint is the min-elements, max-elements or unique statement of a
+	// list or leaf-list that is not met, e.g. "max-elements 4"
+	Constraint string
+	// Keys are the keys of the list entries that do not meet the constraint,
+	// e.g. "[name=eth0]"
+	Keys []string
+	// Changes are the paths of the changes that caused the violation, when
+	// validating changes
+	Changes []string
+}
+
+func (v *Violation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// key identifies the violation, to compare the violations of configurations
+func (v *Violation) key() string {
+	return strings.Join([]string{v.Reason, v.String(), strings.Join(v.Keys, ", ")}, "\x00")
+}
+
+func (v *Violation) errorInfo() *errdetails.ErrorInfo {
+	metadata := map[string]string{
+		metadataPath:    v.Path,
+		metadataMessage: v.Message,
+	}
+	if v.Must != "" {
+		metadata[metadataMust] = v.Must
+	}
+	if v.ErrorAppTag != "" {
+		metadata[metadataErrorAppTag] = v.ErrorAppTag
+	}
+	if v.When != "" {
+		metadata[metadataWhen] = v.When
+	}
+	if v.TargetPath != "" {
+		metadata[metadataTargetPath] = v.TargetPath
+	}
+	if v.Constraint != "" {
+		metadata[metadataConstraint] = v.Constraint
+	}
+	if len(v.Keys) > 0 {
+		metadata[metadataKeys] = joinMetadata(v.Keys)
+	}
+	if len(v.Changes) > 0 {
+		metadata[metadataChanges] = joinMetadata(v.Changes)
+	}
+	return &errdetails.ErrorInfo{
+		Reason:   v.Reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}
+}
+
+// Violations returns the violations carried by an error returned by
+// ValidateConfig, if any
+func Violations(err error) []*Violation {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	violations := make([]*Violation, 0)
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != ErrorDomain {
+			continue
+		}
+		violations = append(violations, &Violation{
+			Reason:      info.Reason,
+			Path:        info.Metadata[metadataPath],
+			Message:     info.Metadata[metadataMessage],
+			Must:        info.Metadata[metadataMust],
+			ErrorAppTag: info.Metadata[metadataErrorAppTag],
+			When:        info.Metadata[metadataWhen],
+			TargetPath:  info.Metadata[metadataTargetPath],
+			Constraint:  info.Metadata[metadataConstraint],
+			Keys:        splitMetadata(info.Metadata[metadataKeys]),
+			Changes:     splitMetadata(info.Metadata[metadataChanges]),
+		})
+	}
+	return violations
+}
+
+// joinMetadata encodes a list as a JSON array, because the keys and the paths
+// in it may contain any separator
+func joinMetadata(values []string) string {
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
+func splitMetadata(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(value), &values); err != nil {
+		return []string{value}
+	}
+	return values
+}
+
+// schemaViolations splits the errors of the validation of the bindings.
+// YGOT prefixes the errors with the schema paths of the invalid nodes, which
+// start with the name of the root.
+func schemaViolations(err error, rootName string) []*Violation {
+	errs, ok := err.(util.Errors)
+	if !ok {
+		errs = util.Errors{err}
+	}
+	violations := make([]*Violation, 0, len(errs))
+	for _, e := range util.UniqueErrors(errs) {
+		path, msg := "", e.Error()
+		for strings.HasPrefix(msg, "/") {
+			i := strings.Index(msg, ": ")
+			if i < 0 {
+				break
+			}
+			path, msg = msg[:i], msg[i+2:]
+		}
+		if isListSizeError(msg) {
+			continue
+		}
+		if rootPrefix := "/" + rootName; rootName != "" && strings.HasPrefix(path, rootPrefix+"/") {
+			path = strings.TrimPrefix(path, rootPrefix)
+		}
+		violations = append(violations, &Violation{Reason: ReasonSchema, Path: path, Message: msg})
+	}
+	return violations
+}
+
+// mustViolations converts the must statements found false by the navigator
+func mustViolations(violations navigator.MustViolations) []*Violation {
+	result := make([]*Violation, 0, len(violations))
+	for _, v := range violations {
+		msg := v.ErrorMessage
+		if msg == "" {
+			msg = fmt.Sprintf("must statement '%s' is false", v.Expression)
+		}
+		result = append(result, &Violation{
+			Reason:      ReasonMust,
+			Path:        v.Path,
+			Message:     msg,
+			Must:        v.Expression,
+			ErrorAppTag: v.ErrorAppTag,
+		})
+	}
+	return result
+}
+
+// whenViolations converts the nodes present although their when statement is
+// false, as found by the navigator
+func whenViolations(violations navigator.WhenViolations) []*Violation {
+	result := make([]*Violation, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, &Violation{
+			Reason:  ReasonWhen,
+			Path:    v.Path,
+			Message: fmt.Sprintf("node present although when statement '%s' is false", v.Expression),
+			When:    v.Expression,
+		})
+	}
+	return result
+}
+
+// leafrefViolations converts the dangling references found by the navigator
+func leafrefViolations(violations navigator.LeafrefViolations) []*Violation {
+	result := make([]*Violation, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, &Violation{
+			Reason:     ReasonLeafref,
+			Path:       v.Path,
+			Message:    v.Message(),
+			TargetPath: v.TargetPath,
+		})
+	}
+	return result
+}
+
+// invalidConfigError is an InvalidArgument status that lists the violations
+// in its message and carries them as ErrorInfo details
+func (s *ModelPluginServer) invalidConfigError(violations []*Violation) error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, v.String())
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid configuration of model %s-%s: %d violation(s): %s",
+		s.model.Name, s.model.Version, len(violations), strings.Join(msgs, "; ")))
+	for _, v := range violations {
+		withDetails, err := st.WithDetails(v.errorInfo())
+		if err != nil {
+			log.Warnf("Unable to add violation details: %v", err)
+			break
+		}
+		st = withDetails
+	}
+	return st.Err()
+}
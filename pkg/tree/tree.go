@@ -0,0 +1,513 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tree renders YANG schema trees as RFC 8340 tree diagrams, in the
+// same layout as `pyang -f tree`.
+package tree
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	schemaRoot = "Device"
+	schemaPath = "schemapath"
+)
+
+// data definition statements that appear as nodes in the tree
+var dataKeywords = map[string]bool{
+	"container": true,
+	"leaf":      true,
+	"leaf-list": true,
+	"list":      true,
+	"choice":    true,
+	"case":      true,
+	"anydata":   true,
+	"anyxml":    true,
+}
+
+// WriteModules renders the tree diagram of module entries, as returned by
+// yang.ToEntry for modules that have been processed by goyang. Modules are
+// rendered in the given order. Nodes augmented in to a module are shown in
+// place, prefixed with the augmenting module's prefix; augments of modules
+// outside the given set are rendered in an "augment" section and deviations
+// in a "deviations" section.
+func WriteModules(w io.Writer, modules []*yang.Entry) error {
+	bw := bufio.NewWriter(w)
+	moduleNames := make(map[string]bool)
+	for _, m := range modules {
+		moduleNames[m.Name] = true
+	}
+
+	printedHeader := false
+	for _, m := range modules {
+		if printedHeader {
+			bw.WriteString("\n")
+		}
+		printedHeader = false
+		header := func() {
+			if !printedHeader {
+				writeModuleHeader(bw, m)
+				printedHeader = true
+			}
+		}
+
+		tw := &treeWriter{w: bw, prefix: prefixName(m)}
+		if children := orderedChildren(m); len(children) > 0 {
+			header()
+			tw.writeChildren(children, "", 0)
+		}
+
+		sectionStarted := false
+		for _, a := range moduleAugments(m) {
+			if moduleNames[augmentTargetModule(m, a.Name)] {
+				// Already shown in place in the target module
+				continue
+			}
+			header()
+			if !sectionStarted {
+				bw.WriteString("\n")
+				sectionStarted = true
+			}
+			fmt.Fprintf(bw, "  augment %s:\n", a.Name)
+			tw.writeChildren(orderedChildren(a), "  ", 0)
+		}
+
+		if len(m.Deviations) > 0 {
+			header()
+			bw.WriteString("\n  deviations:\n")
+			for _, d := range m.Deviations {
+				fmt.Fprintf(bw, "    %s: %s\n", d.DeviatedPath, deviateName(d))
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteSchema renders the tree diagram of a ygot generated schema tree, as
+// returned by api.UnzipSchema() of a model plugin. The top level nodes of the
+// fake root are grouped by the module that defines them. The source statements
+// are not kept in a generated schema, so nodes are rendered in name order.
+func WriteSchema(w io.Writer, entries map[string]*yang.Entry) error {
+	root, ok := entries[schemaRoot]
+	if !ok {
+		return fmt.Errorf("schema has no %s root entry", schemaRoot)
+	}
+
+	// Containers and lists carry the module name in their schema path; use
+	// them to find the module of each prefix, so that leaves can be placed too
+	prefixModules := make(map[string]string)
+	for _, e := range root.Dir {
+		if module := schemaModule(e); module != "" {
+			prefixModules[prefixName(e)] = module
+		}
+	}
+	byModule := make(map[string][]*yang.Entry)
+	modulePrefixes := make(map[string]string)
+	for _, e := range root.Dir {
+		module := schemaModule(e)
+		if module == "" {
+			module = prefixModules[prefixName(e)]
+		}
+		if module == "" {
+			module = prefixName(e)
+		}
+		byModule[module] = append(byModule[module], e)
+		modulePrefixes[module] = prefixName(e)
+	}
+	moduleNames := make([]string, 0, len(byModule))
+	for module := range byModule {
+		moduleNames = append(moduleNames, module)
+	}
+	sort.Strings(moduleNames)
+
+	bw := bufio.NewWriter(w)
+	for i, module := range moduleNames {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		fmt.Fprintf(bw, "module: %s\n", module)
+		children := byModule[module]
+		sort.Slice(children, func(i, j int) bool {
+			return children[i].Name < children[j].Name
+		})
+		tw := &treeWriter{w: bw, prefix: modulePrefixes[module]}
+		tw.writeChildren(children, "", 0)
+	}
+	return bw.Flush()
+}
+
+type treeWriter struct {
+	w *bufio.Writer
+	// prefix of the module being rendered
+	prefix string
+}
+
+func (t *treeWriter) writeChildren(children []*yang.Entry, prefix string, width int) {
+	if width == 0 {
+		width = t.width(children)
+	}
+	for i, child := range children {
+		childPrefix := prefix + "  |"
+		if i == len(children)-1 {
+			childPrefix = prefix + "   "
+		}
+		t.writeNode(child, childPrefix, width)
+	}
+}
+
+func (t *treeWriter) writeNode(e *yang.Entry, prefix string, width int) {
+	line := fmt.Sprintf("%s%s--", prefix[:len(prefix)-1], status(e))
+	name := t.name(e)
+	flags := "rw"
+	if e.ReadOnly() {
+		flags = "ro"
+	}
+
+	switch {
+	case e.IsList():
+		line += fmt.Sprintf("%s %s* [%s]", flags, name, strings.Join(strings.Fields(e.Key), " "))
+	case e.IsChoice():
+		line += fmt.Sprintf("%s (%s)", flags, name)
+		if e.Mandatory != yang.TSTrue {
+			line += "?"
+		}
+	case e.IsCase():
+		line += fmt.Sprintf(":(%s)", name)
+	case e.IsContainer():
+		if len(e.Extra["presence"]) > 0 {
+			name += "!"
+		}
+		line += fmt.Sprintf("%s %s", flags, name)
+	default:
+		if e.IsLeafList() {
+			name += "*"
+		} else if e.Mandatory != yang.TSTrue && !isKey(e) {
+			name += "?"
+		}
+		if typ := typeName(e); typ != "" {
+			line += fmt.Sprintf("%s %-*s   %s", flags, width+1, name, typ)
+		} else {
+			line += fmt.Sprintf("%s %s", flags, name)
+		}
+	}
+	if features := extraNames(e, "if-feature"); len(features) > 0 {
+		line += fmt.Sprintf(" {%s}?", strings.Join(features, ","))
+	}
+	t.w.WriteString(line + "\n")
+
+	if !e.IsDir() {
+		return
+	}
+	children := orderedChildren(e)
+	if e.IsChoice() || e.IsCase() {
+		t.writeChildren(children, prefix, width-3)
+	} else {
+		t.writeChildren(children, prefix, 0)
+	}
+}
+
+// width is the widest node name in a group of siblings, used to align types
+func (t *treeWriter) width(children []*yang.Entry) int {
+	w := 0
+	for _, child := range children {
+		var n int
+		if child.IsChoice() || child.IsCase() {
+			n = 3 + t.width(orderedChildren(child))
+		} else {
+			n = len(t.name(child))
+		}
+		if n > w {
+			w = n
+		}
+	}
+	return w
+}
+
+// name is the name of the node, qualified by its prefix when it belongs to a
+// module other than the one being rendered (e.g. it has been augmented)
+func (t *treeWriter) name(e *yang.Entry) string {
+	if p := prefixName(e); p != "" && p != t.prefix {
+		return p + ":" + e.Name
+	}
+	return e.Name
+}
+
+// moduleAugments returns the augments defined in a module. goyang drops the
+// augments it has applied from the module entry, but the entries of the
+// augment statements keep the augmenting nodes.
+func moduleAugments(m *yang.Entry) []*yang.Entry {
+	mod, ok := m.Node.(*yang.Module)
+	if !ok {
+		return m.Augments
+	}
+	augments := make([]*yang.Entry, 0, len(mod.Augment))
+	for _, a := range mod.Augment {
+		augments = append(augments, yang.ToEntry(a))
+	}
+	return augments
+}
+
+// deviateName is the argument of a deviation's deviate statements, which
+// goyang does not record in the deviated entry until deviations are applied
+func deviateName(d *yang.DeviatedEntry) string {
+	if d.Type != yang.DeviationUnset {
+		return d.Type.String()
+	}
+	names := make([]string, 0)
+	if n, ok := d.Node.(*yang.Deviation); ok {
+		for _, deviate := range n.Deviate {
+			names = append(names, deviate.Name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func writeModuleHeader(w *bufio.Writer, m *yang.Entry) {
+	if mod, ok := m.Node.(*yang.Module); ok && mod.Kind() == "submodule" && mod.BelongsTo != nil {
+		fmt.Fprintf(w, "submodule: %s (belongs-to %s)\n", m.Name, mod.BelongsTo.Name)
+		return
+	}
+	fmt.Fprintf(w, "module: %s\n", m.Name)
+}
+
+// orderedChildren returns the children of an entry in the order they are
+// defined in the YANG source, expanding groupings where they are used and
+// following them by any nodes augmented in to the entry
+func orderedChildren(e *yang.Entry) []*yang.Entry {
+	children := make([]*yang.Entry, 0, len(e.Dir))
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if child, ok := e.Dir[name]; ok && !seen[name] {
+			seen[name] = true
+			children = append(children, child)
+		}
+	}
+
+	// Cases that goyang inserts for shorthand choices point at the statement
+	// of their only child rather than at a case statement
+	implicitCase := e.IsCase() && e.Node != nil && e.Node.Kind() != "case"
+	if e.Node != nil && !implicitCase {
+		var walk func(n yang.Node)
+		walk = func(n yang.Node) {
+			uses := usesNodes(n)
+			for _, s := range n.Statement().SubStatements() {
+				if dataKeywords[s.Keyword] {
+					add(s.Argument)
+					continue
+				}
+				if s.Keyword != "uses" {
+					continue
+				}
+				for _, u := range uses {
+					if u.Statement() != s {
+						continue
+					}
+					if g := yang.FindGrouping(u, u.Name, map[string]bool{}); g != nil {
+						walk(g)
+					}
+				}
+			}
+		}
+		walk(e.Node)
+		for _, a := range e.Augmented {
+			for _, child := range orderedChildren(a) {
+				add(child.Name)
+			}
+		}
+	}
+
+	remaining := make([]string, 0)
+	for name := range e.Dir {
+		if !seen[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	sort.Strings(remaining)
+	for _, name := range remaining {
+		add(name)
+	}
+	return children
+}
+
+// usesNodes returns the uses statements of a node, e.g. a container or grouping
+func usesNodes(n yang.Node) []*yang.Uses {
+	v := reflect.ValueOf(n)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.Elem().FieldByName("Uses")
+	if !f.IsValid() {
+		return nil
+	}
+	uses, _ := f.Interface().([]*yang.Uses)
+	return uses
+}
+
+// augmentTargetModule resolves the module that an augment path points in to
+func augmentTargetModule(m *yang.Entry, target string) string {
+	first := strings.SplitN(strings.TrimPrefix(target, "/"), "/", 2)[0]
+	idx := strings.Index(first, ":")
+	if idx < 0 || m.Node == nil {
+		return m.Name
+	}
+	if mod := yang.FindModuleByPrefix(m.Node, first[:idx]); mod != nil {
+		if mod.Kind() == "submodule" && mod.BelongsTo != nil {
+			return mod.BelongsTo.Name
+		}
+		return mod.Name
+	}
+	return ""
+}
+
+func typeName(e *yang.Entry) string {
+	switch e.Kind {
+	case yang.AnyDataEntry:
+		return "<anydata>"
+	case yang.AnyXMLEntry:
+		return "<anyxml>"
+	}
+	if e.Type == nil {
+		return ""
+	}
+	// Show the type as it is written in the leaf, e.g. a prefixed typedef
+	name := e.Type.Name
+	switch n := e.Node.(type) {
+	case *yang.Leaf:
+		if n.Type != nil {
+			name = n.Type.Name
+		}
+	case *yang.LeafList:
+		if n.Type != nil {
+			name = n.Type.Name
+		}
+	}
+	if name == "leafref" {
+		return "-> " + leafrefPath(e)
+	}
+	return name
+}
+
+// leafrefPath is the path of a leafref, with a step's prefix shown only when
+// it changes from that of the previous step, starting from the prefix of the
+// module in which the leaf is defined
+func leafrefPath(e *yang.Entry) string {
+	var t *yang.Type
+	switch n := e.Node.(type) {
+	case *yang.Leaf:
+		t = n.Type
+	case *yang.LeafList:
+		t = n.Type
+	}
+	if t == nil || t.Path == nil {
+		return e.Type.Path
+	}
+	current := ""
+	if m := yang.RootNode(e.Node); m != nil {
+		current = m.GetPrefix()
+	}
+	steps := splitPath(t.Path.Name)
+	for i, step := range steps {
+		idx := strings.Index(step, ":")
+		if bracket := strings.Index(step, "["); idx < 0 || (bracket >= 0 && bracket < idx) {
+			continue
+		}
+		if step[:idx] == current {
+			steps[i] = step[idx+1:]
+		}
+		current = step[:idx]
+	}
+	return strings.Join(steps, "/")
+}
+
+// splitPath splits a path in to its steps, ignoring separators in predicates
+func splitPath(path string) []string {
+	steps := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range path {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				steps = append(steps, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(steps, path[start:])
+}
+
+func isKey(e *yang.Entry) bool {
+	if e.Parent == nil || !e.Parent.IsList() {
+		return false
+	}
+	for _, k := range strings.Fields(e.Parent.Key) {
+		if k == e.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func status(e *yang.Entry) string {
+	for _, s := range extraNames(e, "status") {
+		switch s {
+		case "deprecated":
+			return "x"
+		case "obsolete":
+			return "o"
+		}
+	}
+	return "+"
+}
+
+// extraNames returns the arguments of statements that goyang keeps in Extra.
+// They are *yang.Value when parsed, or maps when read from a JSON schema.
+func extraNames(e *yang.Entry, keyword string) []string {
+	names := make([]string, 0)
+	for _, v := range e.Extra[keyword] {
+		switch value := v.(type) {
+		case *yang.Value:
+			names = append(names, value.Name)
+		case map[string]interface{}:
+			if name, ok := value["Name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// prefixName is the prefix of the module that a node belongs to. For parsed
+// modules this follows the namespace, so that nodes from groupings belong to
+// the module that uses them and augmented nodes to the augmenting module.
+func prefixName(e *yang.Entry) string {
+	if e.Node != nil {
+		if ms := e.Modules(); ms != nil {
+			if m, err := ms.FindModuleByNamespace(e.Namespace().Name); err == nil && m.Prefix != nil {
+				return m.Prefix.Name
+			}
+		}
+	}
+	if e.Prefix != nil {
+		return e.Prefix.Name
+	}
+	return ""
+}
+
+func schemaModule(e *yang.Entry) string {
+	if p, ok := e.Annotation[schemaPath].(string); ok {
+		return strings.SplitN(strings.TrimPrefix(p, "/"), "/", 2)[0]
+	}
+	return ""
+}
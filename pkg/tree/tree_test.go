@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tree
+
+import (
+	"bytes"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func loadModules(t *testing.T, names ...string) []*yang.Entry {
+	ms := yang.NewModules()
+	ms.AddPath("testdata")
+	for _, name := range []string{"tree-base", "tree-aug"} {
+		assert.NoError(t, ms.Read(name))
+	}
+	assert.Empty(t, ms.Process())
+	entries := make([]*yang.Entry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, yang.ToEntry(ms.Modules[name]))
+	}
+	return entries
+}
+
+func TestWriteModules(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, WriteModules(&buf, loadModules(t, "tree-base", "tree-aug")))
+	assert.Equal(t, `module: tree-base
+  +--rw system
+     +--rw hostname      string
+     +--rw dns-server*   string
+     +--rw protocol?     identityref
+     +--rw interface* [name]
+        +--rw name          string
+        +--rw peer?         -> /system/interface/name
+        +--rw (address)?
+        |  +--:(static)
+        |  |  +--rw ip?     string
+        |  +--:(dhcp)
+        |     +--rw dhcp?   boolean
+        +--rw stats {extra-stats}?
+        |  +--ro in-pkts?    uint64
+        |  +--ro out-pkts?   uint64
+        +--rw ta:mtu?       uint16
+
+module: tree-aug
+
+  deviations:
+    /tb:system/tb:logging: not-supported
+`, buf.String())
+}
+
+func TestWriteModules_Augment(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, WriteModules(&buf, loadModules(t, "tree-aug")))
+	assert.Equal(t, `module: tree-aug
+
+  augment /tb:system/tb:interface:
+    +--rw mtu?   uint16
+
+  deviations:
+    /tb:system/tb:logging: not-supported
+`, buf.String())
+}
+
+func TestWriteSchema(t *testing.T) {
+	device := &yang.Entry{Name: "Device", Kind: yang.DirectoryEntry, Dir: map[string]*yang.Entry{}}
+	system := &yang.Entry{
+		Name:       "system",
+		Kind:       yang.DirectoryEntry,
+		Prefix:     &yang.Value{Name: "tb"},
+		Annotation: map[string]interface{}{"schemapath": "/tree-base/system"},
+		Dir:        map[string]*yang.Entry{},
+		Parent:     device,
+	}
+	hostname := &yang.Entry{
+		Name:      "hostname",
+		Kind:      yang.LeafEntry,
+		Prefix:    &yang.Value{Name: "tb"},
+		Type:      &yang.YangType{Name: "string", Kind: yang.Ystring},
+		Mandatory: yang.TSTrue,
+		Parent:    system,
+	}
+	state := &yang.Entry{
+		Name:   "state",
+		Kind:   yang.LeafEntry,
+		Prefix: &yang.Value{Name: "tb"},
+		Type:   &yang.YangType{Name: "uint8", Kind: yang.Yuint8},
+		Config: yang.TSFalse,
+		Parent: system,
+	}
+	system.Dir["hostname"] = hostname
+	system.Dir["state"] = state
+	device.Dir["system"] = system
+	mtu := &yang.Entry{
+		Name:   "mtu",
+		Kind:   yang.LeafEntry,
+		Prefix: &yang.Value{Name: "tb"},
+		Type:   &yang.YangType{Name: "uint16", Kind: yang.Yuint16},
+		Parent: device,
+	}
+	device.Dir["mtu"] = mtu
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteSchema(&buf, map[string]*yang.Entry{"Device": device}))
+	assert.Equal(t, `module: tree-base
+  +--rw mtu?      uint16
+  +--rw system
+     +--rw hostname    string
+     +--ro state?      uint8
+`, buf.String())
+
+	assert.Error(t, WriteSchema(&buf, map[string]*yang.Entry{}))
+}
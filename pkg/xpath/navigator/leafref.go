@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package navigator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/SeanCondon/xpath"
+	"github.com/openconfig/goyang/pkg/yang"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// LeafrefViolation is a leafref value that matches no node of the leafref
+// path, or that cannot be checked against it
+type LeafrefViolation struct {
+	// Path is the data path of the leafref leaf
+	Path  string
+	Value string
+	// TargetPath is the path statement of the leafref
+	TargetPath string
+	// Reason is why the leaf could not be checked, if it could not
+	Reason string
+}
+
+func (v *LeafrefViolation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message())
+}
+
+// Message describes the violation, without its path
+func (v *LeafrefViolation) Message() string {
+	if v.Reason != "" {
+		return fmt.Sprintf("unable to check the value against %s: %s", v.TargetPath, v.Reason)
+	}
+	return fmt.Sprintf("value '%s' does not match any %s", v.Value, v.TargetPath)
+}
+
+// LeafrefViolations lists all the leafref values that match no node
+type LeafrefViolations []*LeafrefViolation
+
+func (v LeafrefViolations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// WalkAndValidateLeafrefs - walk through the YNN and check that the values of
+// the leafrefs match a node of their path, unless the leafref does not
+// require an instance or takes its default value. Dangling references are
+// returned as LeafrefViolations, with the leafrefs whose path or value the
+// navigator cannot resolve.
+func (x *YangNodeNavigator) WalkAndValidateLeafrefs() error {
+	violations := make(LeafrefViolations, 0)
+	x.MoveToRoot()
+	for x.moveToNextNode() {
+		leaf := x.curr
+		if !leaf.IsLeaf() && !leaf.IsLeafList() {
+			continue
+		}
+		if leaf.Type == nil || leaf.Type.Kind != yang.Yleafref || leaf.Type.OptionalInstance || isDefaulted(leaf) {
+			continue
+		}
+		values, err := leafValues(leaf)
+		if err == nil && len(values) == 0 {
+			continue
+		}
+		var expr string
+		if err == nil {
+			expr, err = x.leafrefXPath(leaf, leaf.Type.Path)
+		}
+		if err != nil {
+			violations = append(violations, &LeafrefViolation{
+				Path:       dataPath(leaf),
+				TargetPath: leaf.Type.Path,
+				Reason:     err.Error(),
+			})
+			continue
+		}
+		compiled, err := xpath.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("unable to compile leafref path '%s' of %s: %v", leaf.Type.Path, dataPath(leaf), err)
+		}
+		targets := make(map[string]bool)
+		iter := compiled.Select(x.Copy())
+		for iter.MoveNext() {
+			targets[iter.Current().Value()] = true
+		}
+		for _, value := range values {
+			if !targets[value] {
+				violations = append(violations, &LeafrefViolation{
+					Path:       dataPath(leaf),
+					Value:      value,
+					TargetPath: leaf.Type.Path,
+				})
+			}
+		}
+	}
+	x.MoveToRoot()
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// leafrefXPath converts the path of a leafref of leaf to an XPath expression
+// that the navigator evaluates: the keys of the lists are attributes, and
+// the current() expressions of the predicates are replaced by their value
+func (x *YangNodeNavigator) leafrefXPath(leaf *yang.Entry, path string) (string, error) {
+	cursor := leaf
+	steps := splitSteps(strings.TrimSpace(path))
+	elems := make([]string, 0, len(steps))
+	if len(steps) > 0 && steps[0] == "" {
+		// Absolute path
+		cursor = x.root
+		elems = append(elems, "")
+		steps = steps[1:]
+	}
+
+	for _, step := range steps {
+		name, predicates := step, ""
+		if i := strings.Index(step, "["); i >= 0 {
+			name, predicates = step[:i], step[i:]
+		}
+		name = stripPrefix(strings.TrimSpace(name))
+		switch name {
+		case "..":
+			if cursor.Parent == nil {
+				return "", fmt.Errorf("leafref path '%s' of %s goes above the root", path, dataPath(leaf))
+			}
+			cursor = cursor.Parent
+			elems = append(elems, name)
+			continue
+		case ".":
+			elems = append(elems, name)
+			continue
+		}
+
+		child, ok := cursor.Dir[name]
+		if !ok {
+			return "", fmt.Errorf("unknown node '%s' in leafref path '%s' of %s", name, path, dataPath(leaf))
+		}
+		elem := name
+		if isKey(cursor, name) {
+			elem = "@" + name
+		}
+		for _, predicate := range splitPredicates(predicates) {
+			converted, err := x.leafrefPredicate(leaf, child, predicate)
+			if err != nil {
+				return "", err
+			}
+			elem += "[" + converted + "]"
+		}
+		elems = append(elems, elem)
+		cursor = child
+	}
+	return strings.Join(elems, "/"), nil
+}
+
+// leafrefPredicate converts a predicate of a leafref path on list, such as
+// name = current()/../interface
+func (x *YangNodeNavigator) leafrefPredicate(leaf *yang.Entry, list *yang.Entry, predicate string) (string, error) {
+	parts := strings.SplitN(predicate, "=", 2)
+	if len(parts) != 2 {
+		return predicate, nil
+	}
+	key := stripPrefix(strings.TrimSpace(parts[0]))
+	if isKey(list, key) {
+		key = "@" + key
+	}
+	value := strings.TrimSpace(parts[1])
+	if !strings.HasPrefix(value, "current()") {
+		return key + " = " + value, nil
+	}
+
+	// The xpath library has no current(): evaluate the path from the leaf
+	relPath := strings.TrimPrefix(strings.TrimPrefix(value, "current()"), "/")
+	literal := ""
+	if relPath != "" {
+		expr, err := x.leafrefXPath(leaf, relPath)
+		if err != nil {
+			return "", err
+		}
+		compiled, err := xpath.Compile(expr)
+		if err != nil {
+			return "", fmt.Errorf("unable to compile '%s' in leafref path of %s: %v", value, dataPath(leaf), err)
+		}
+		nav := x.Copy().(*YangNodeNavigator)
+		nav.curr = leaf
+		iter := compiled.Select(nav)
+		if iter.MoveNext() {
+			literal = iter.Current().Value()
+		}
+	} else {
+		values, err := leafValues(leaf)
+		if err != nil {
+			return "", err
+		}
+		if len(values) > 0 {
+			literal = values[0]
+		}
+	}
+	if strings.Contains(literal, "'") {
+		return fmt.Sprintf(`%s = "%s"`, key, literal), nil
+	}
+	return fmt.Sprintf("%s = '%s'", key, literal), nil
+}
+
+// leafValues returns the values of a leaf or leaf-list as the navigator
+// formats them, or an error for a value of a type that it does not format
+func leafValues(leaf *yang.Entry) ([]string, error) {
+	value, ok := leaf.Annotation[goStruct]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, nil
+	}
+	if leaf.IsLeaf() {
+		formatted, err := formatLeafValue(v)
+		if err != nil {
+			return nil, err
+		}
+		return []string{formatted}, nil
+	}
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("unexpected %s value of leaf-list", v.Type())
+	}
+	values := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		formatted, err := formatLeafValue(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, formatted)
+	}
+	return values, nil
+}
+
+// formatLeafValue formats a value of a leaf as the navigator does: the
+// enumerations and identities by their name, the binaries in base64 and the
+// union members by their only field
+func formatLeafValue(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", fmt.Errorf("unexpected nil %s value", v.Type())
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int64:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return stringer.String(), nil
+		}
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Float()), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+	case reflect.Struct:
+		if v.NumField() == 1 {
+			return formatLeafValue(v.Field(0))
+		}
+	}
+	return "", fmt.Errorf("unable to format %s value", v.Type())
+}
+
+func isKey(list *yang.Entry, name string) bool {
+	if !list.IsList() {
+		return false
+	}
+	for _, key := range strings.Fields(list.Key) {
+		if key == name {
+			return true
+		}
+	}
+	return false
+}
+
+func stripPrefix(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// splitSteps splits a path on the slashes that are not in predicates
+func splitSteps(path string) []string {
+	steps := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range path {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				steps = append(steps, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(steps, path[start:])
+}
+
+// splitPredicates returns the contents of the predicates of a step, e.g.
+// [a = 1][b = 2]
+func splitPredicates(predicates string) []string {
+	result := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range predicates {
+		switch c {
+		case '[':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				result = append(result, strings.TrimSpace(predicates[start:i]))
+			}
+		}
+	}
+	return result
+}
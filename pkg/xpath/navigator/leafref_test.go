@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package navigator
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+type lrDevice struct {
+	Vlan map[uint16]*lrVlan `path:"vlan"`
+	Port map[string]*lrPort `path:"port"`
+}
+
+func (d *lrDevice) IsYANGGoStruct() {
+}
+
+func (d *lrDevice) Validate(...ygot.ValidationOption) error {
+	return nil
+}
+
+func (d *lrDevice) ΛEnumTypeMap() map[string][]reflect.Type {
+	return nil
+}
+
+func (d *lrDevice) ΛBelongingModule() string {
+	return ""
+}
+
+type lrVlan struct {
+	VlanId *uint16 `path:"vlan-id"`
+	Name   *string `path:"name"`
+}
+
+type lrPort struct {
+	Name     *string  `path:"name"`
+	Vlan     *uint16  `path:"vlan"`
+	Tagged   []uint16 `path:"tagged"`
+	Native   *uint16  `path:"native"`
+	VlanName *string  `path:"vlan-name"`
+}
+
+func lrLeaf(name string, kind yang.TypeKind) *yang.Entry {
+	return &yang.Entry{
+		Name: name,
+		Kind: yang.LeafEntry,
+		Type: &yang.YangType{Kind: kind},
+	}
+}
+
+func lrLeafref(name string, path string, requireInstance bool) *yang.Entry {
+	return &yang.Entry{
+		Name: name,
+		Kind: yang.LeafEntry,
+		Type: &yang.YangType{Kind: yang.Yleafref, Path: path, OptionalInstance: !requireInstance},
+	}
+}
+
+func lrSchema() *yang.Entry {
+	tagged := lrLeafref("tagged", "/t:vlan/t:vlan-id", true)
+	tagged.ListAttr = &yang.ListAttr{}
+	return &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"vlan": {
+				Name:     "vlan",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: &yang.ListAttr{},
+				Key:      "vlan-id",
+				Dir: map[string]*yang.Entry{
+					"vlan-id": lrLeaf("vlan-id", yang.Yuint16),
+					"name":    lrLeaf("name", yang.Ystring),
+				},
+			},
+			"port": {
+				Name:     "port",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: &yang.ListAttr{},
+				Key:      "name",
+				Dir: map[string]*yang.Entry{
+					"name":      lrLeaf("name", yang.Ystring),
+					"vlan":      lrLeafref("vlan", "../../t:vlan/t:vlan-id", true),
+					"tagged":    tagged,
+					"native":    lrLeafref("native", "../../t:vlan/t:vlan-id", false),
+					"vlan-name": lrLeafref("vlan-name", "/t:vlan[t:vlan-id = current()/../t:vlan]/t:name", true),
+				},
+			},
+		},
+	}
+}
+
+func Test_WalkAndValidateLeafrefs(t *testing.T) {
+	u16 := func(v uint16) *uint16 { return &v }
+	str := func(v string) *string { return &v }
+	device := &lrDevice{
+		Vlan: map[uint16]*lrVlan{
+			10: {VlanId: u16(10), Name: str("ten")},
+			20: {VlanId: u16(20), Name: str("twenty")},
+		},
+		Port: map[string]*lrPort{
+			"p1": {Name: str("p1"), Vlan: u16(10), Tagged: []uint16{10, 20}, Native: u16(30), VlanName: str("ten")},
+			"p2": {Name: str("p2"), Vlan: u16(20)},
+		},
+	}
+	ynn := NewYangNodeNavigator(lrSchema(), device, true).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateLeafrefs())
+
+	// Port p2 references VLANs that do not exist, and p1 the name of another VLAN
+	device.Port["p2"] = &lrPort{Name: str("p2"), Vlan: u16(30), Tagged: []uint16{20, 40}}
+	device.Port["p1"].VlanName = str("twenty")
+	ynn = NewYangNodeNavigator(lrSchema(), device, true).(*YangNodeNavigator)
+	err := ynn.WalkAndValidateLeafrefs()
+	violations, ok := err.(LeafrefViolations)
+	assert.True(t, ok, "unexpected error %v", err)
+	assert.Len(t, violations, 3)
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p1]/vlan-name",
+		Value:      "twenty",
+		TargetPath: "/t:vlan[t:vlan-id = current()/../t:vlan]/t:name",
+	}, violations[0])
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p2]/tagged",
+		Value:      "40",
+		TargetPath: "/t:vlan/t:vlan-id",
+	}, violations[1])
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p2]/vlan",
+		Value:      "30",
+		TargetPath: "../../t:vlan/t:vlan-id",
+	}, violations[2])
+	assert.Equal(t, "/port[name=p2]/vlan: value '30' does not match any ../../t:vlan/t:vlan-id", violations[2].Error())
+}
+
+type lrOddDevice struct {
+	Vlan map[uint16]*lrVlan    `path:"vlan"`
+	Port map[string]*lrOddPort `path:"port"`
+}
+
+func (d *lrOddDevice) IsYANGGoStruct() {
+}
+
+func (d *lrOddDevice) Validate(...ygot.ValidationOption) error {
+	return nil
+}
+
+func (d *lrOddDevice) ΛEnumTypeMap() map[string][]reflect.Type {
+	return nil
+}
+
+func (d *lrOddDevice) ΛBelongingModule() string {
+	return ""
+}
+
+type lrOddPort struct {
+	Name *string     `path:"name"`
+	Vlan lrVlanUnion `path:"vlan"`
+	Lost *uint16     `path:"lost"`
+	Odd  *complex128 `path:"odd"`
+}
+
+type lrVlanUnion interface {
+	isLrVlanUnion()
+}
+
+type lrVlanUnionUint16 struct {
+	Uint16 uint16
+}
+
+func (*lrVlanUnionUint16) isLrVlanUnion() {}
+
+func Test_WalkAndValidateLeafrefsUnchecked(t *testing.T) {
+	u16 := func(v uint16) *uint16 { return &v }
+	str := func(v string) *string { return &v }
+	odd := complex(1, 1)
+	schema := lrSchema()
+	schema.Dir["port"].Dir = map[string]*yang.Entry{
+		"name": lrLeaf("name", yang.Ystring),
+		"vlan": lrLeafref("vlan", "../../t:vlan/t:vlan-id", true),
+		"lost": lrLeafref("lost", "../../t:vlans/t:vlan-id", true),
+		"odd":  lrLeafref("odd", "../../t:vlan/t:vlan-id", true),
+	}
+	device := &lrOddDevice{
+		Vlan: map[uint16]*lrVlan{
+			10: {VlanId: u16(10), Name: str("ten")},
+		},
+		Port: map[string]*lrOddPort{
+			"p1": {Name: str("p1"), Vlan: &lrVlanUnionUint16{Uint16: 20}, Lost: u16(10), Odd: &odd},
+		},
+	}
+	ynn := NewYangNodeNavigator(schema, device, true).(*YangNodeNavigator)
+	err := ynn.WalkAndValidateLeafrefs()
+	violations, ok := err.(LeafrefViolations)
+	assert.True(t, ok, "unexpected error %v", err)
+	assert.Len(t, violations, 3)
+	// A path that names an unknown node and a value that cannot be formatted
+	// are violations too, and do not stop the walk
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p1]/lost",
+		TargetPath: "../../t:vlans/t:vlan-id",
+		Reason:     "unknown node 'vlans' in leafref path '../../t:vlans/t:vlan-id' of /port[name=p1]/lost",
+	}, violations[0])
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p1]/odd",
+		TargetPath: "../../t:vlan/t:vlan-id",
+		Reason:     "unable to format complex128 value",
+	}, violations[1])
+	assert.Equal(t, "/port[name=p1]/odd: unable to check the value against ../../t:vlan/t:vlan-id: unable to format complex128 value",
+		violations[1].Error())
+	// The value of a union is the value of its member
+	assert.Equal(t, &LeafrefViolation{
+		Path:       "/port[name=p1]/vlan",
+		Value:      "20",
+		TargetPath: "../../t:vlan/t:vlan-id",
+	}, violations[2])
+}
+
+func Test_splitSteps(t *testing.T) {
+	assert.Equal(t, []string{"", "a", "b[c = current()/../d]", "e"}, splitSteps("/a/b[c = current()/../d]/e"))
+	assert.Equal(t, []string{"..", "..", "a"}, splitSteps("../../a"))
+	assert.Equal(t, []string{"c = 1", "d = current()/../e"}, splitPredicates("[c = 1][d = current()/../e]"))
+}
@@ -19,6 +19,7 @@ import (
 const (
 	goStruct        = "gostruct"
 	orderedAttrList = "orderedattrlist"
+	defaulted       = "defaulted"
 )
 
 type XpathSelect struct {
@@ -56,7 +57,7 @@ func NewYangNodeNavigator(root *yang.Entry, device ygot.ValidatedGoStruct, ignor
 
 // addGoStructToYangEntry - recursive function that walks the Abstract Syntax
 // Tree and matches up the GoStruct
-// Also extracts the "must" statements in to XPath queries
+// Also extracts the "must" and "when" statements in to XPath queries
 func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]*yang.Entry {
 	resultMap := make(map[string]*yang.Entry)
 
@@ -69,6 +70,11 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 		dir.Annotation["must"] = extractMust(mustStmnt)
 	}
 
+	whenStmnts, ok := dir.Extra[whenStatements]
+	if ok {
+		dir.Annotation[whenStatements] = extractWhen(whenStmnts)
+	}
+
 	// Create a new entry per list index
 	if dir.IsList() {
 		//mapKeysAsValues := reflect.ValueOf(yangStruct).MapKeys()
@@ -117,6 +123,12 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 		structVal := reflect.ValueOf(yangStruct)
 		switch structVal.Kind() {
 		case reflect.Ptr:
+			if v.IsChoice() {
+				for childKey, childValue := range processChoice(structVal, v) {
+					childMap[childKey] = childValue
+				}
+				continue
+			}
 			for childKey, childValue := range processStruct(structVal, k, v) {
 				childMap[childKey] = childValue
 			}
@@ -150,11 +162,89 @@ func processStruct(structVal reflect.Value, dirName string, dirValue *yang.Entry
 		if !val.IsZero() {
 			return addGoStructToYangEntry(dirValue, val.Interface()) //Recursive
 		}
-		return nil
+		return defaultLeaf(dirValue)
 	}
 	return nil
 }
 
+// processChoice - the nodes of the cases of a choice are fields of the struct
+// of its parent. Only the nodes of the active case are processed: the case
+// with a node present or, when none has, the default case of the choice, as
+// in RFC 7950 section 7.9.3; so the leaves of the other cases take no default
+func processChoice(structVal reflect.Value, choice *yang.Entry) map[string]*yang.Entry {
+	active := ""
+	if len(choice.Default) > 0 {
+		active = choice.Default[0]
+	}
+	for name, caseEntry := range choice.Dir {
+		if casePresent(structVal, caseEntry) {
+			active = name
+			break
+		}
+	}
+	caseEntry, ok := choice.Dir[active]
+	if !ok {
+		return nil
+	}
+	resultMap := make(map[string]*yang.Entry)
+	for k, v := range caseEntry.Dir {
+		var children map[string]*yang.Entry
+		if v.IsChoice() {
+			children = processChoice(structVal, v)
+		} else {
+			children = processStruct(structVal, k, v)
+		}
+		for childKey, childValue := range children {
+			resultMap[childKey] = childValue
+		}
+	}
+	return resultMap
+}
+
+// casePresent reports whether a node of a case is a field of the struct that
+// is set
+func casePresent(structVal reflect.Value, caseEntry *yang.Entry) bool {
+	for k, v := range caseEntry.Dir {
+		if v.IsChoice() || v.IsCase() {
+			if casePresent(structVal, v) {
+				return true
+			}
+			continue
+		}
+		for i := 0; i < structVal.Elem().NumField(); i++ {
+			if structVal.Elem().Type().Field(i).Tag.Get("path") == k && !structVal.Elem().Field(i).IsZero() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// defaultLeaf - an absent leaf of an existing container or list entry takes
+// its default value, if any, so that the XPath expressions see it. It is
+// marked as defaulted, since it is not part of the data.
+func defaultLeaf(dir *yang.Entry) map[string]*yang.Entry {
+	if !dir.IsLeaf() {
+		return nil
+	}
+	value, ok := dir.SingleDefaultValue()
+	if !ok {
+		return nil
+	}
+	if dir.Type != nil && dir.Type.Kind == yang.Yidentityref {
+		value = value[strings.Index(value, ":")+1:]
+	}
+	resultMap := addGoStructToYangEntry(dir, &value)
+	dir.Annotation[defaulted] = true
+	return resultMap
+}
+
+// isDefaulted reports whether a node is a leaf that takes its default value
+func isDefaulted(e *yang.Entry) bool {
+	isDefault, ok := e.Annotation[defaulted].(bool)
+	return ok && isDefault
+}
+
 // extractMust - this is necessary since the Must statement is not
 // yet a first class citizen of the yang.Entry - for the moment it
 // is crammed in to the Extra field
@@ -247,17 +337,40 @@ func deepCopyDir(dir *yang.Entry) *yang.Entry {
 	return newDir
 }
 
+// MustViolation is a must statement that evaluates to false
+type MustViolation struct {
+	// Path is the data path of the node of the must statement
+	Path         string
+	Expression   string
+	ErrorMessage string
+	ErrorAppTag  string
+	// Context lists the node and its values
+	Context []string
+}
+
+func (v *MustViolation) Error() string {
+	return fmt.Sprintf("%s. Must statement '%v' to true. Container(s): %v",
+		v.ErrorMessage, v.Expression, v.Context)
+}
+
+// MustViolations lists all the must statements that evaluate to false
+type MustViolations []*MustViolation
+
+func (v MustViolations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // WalkAndValidateMust - walk through the YNN and validate any Must statements
-// This goes down first and then across
+// This goes down first and then across. Every must statement is evaluated and
+// those that evaluate to false are returned as MustViolations.
 func (x *YangNodeNavigator) WalkAndValidateMust() error {
+	violations := make(MustViolations, 0)
 	for {
-		if x.MoveToChild() ||
-			x.MoveToNext() ||
-			(x.MoveToParent() && x.MoveToNext()) ||
-			(x.MoveToParent() && x.MoveToNext()) ||
-			(x.MoveToParent() && x.MoveToNext()) ||
-			(x.MoveToParent() && x.MoveToNext()) ||
-			(x.MoveToParent() && x.MoveToNext()) {
+		if x.moveToNextNode() {
 
 			mustIf, ok := x.curr.Annotation["must"]
 			if ok {
@@ -275,23 +388,88 @@ func (x *YangNodeNavigator) WalkAndValidateMust() error {
 							mustExpr.String(), result)
 					}
 					if !resultBool {
-						items := x1.generateMustError("@*")
-						if len(items) == 0 {
-							items = x1.generateMustError("*")
+						items := x.mustContext(x1)
+						violation := &MustViolation{
+							Path:       dataPath(x.curr),
+							Expression: mustStruct.Name,
+							Context:    items,
+						}
+						if mustStruct.ErrorMessage != nil {
+							violation.ErrorMessage = mustStruct.ErrorMessage.Name
+						}
+						if mustStruct.ErrorAppTag != nil {
+							violation.ErrorAppTag = mustStruct.ErrorAppTag.Name
 						}
-						return fmt.Errorf("%s. Must statement '%v' to true. Container(s): %v",
-							mustStruct.ErrorMessage.Name,
-							mustStruct.Name, items)
+						violations = append(violations, violation)
 					}
 					log.Infof("Checking Must rule %s: %v", mustExpr.String(), resultBool)
 				}
 			}
 			continue
 		}
+		if len(violations) > 0 {
+			return violations
+		}
 		return nil
 	}
 }
 
+// moveToNextNode moves the YangNodeNavigator to the next node of the tree,
+// depth first
+func (x *YangNodeNavigator) moveToNextNode() bool {
+	if x.MoveToChild() || x.MoveToNext() {
+		return true
+	}
+	for x.MoveToParent() {
+		if x.MoveToNext() {
+			return true
+		}
+	}
+	return false
+}
+
+// dataPath formats the path of a node of the navigator, with the keys of
+// the list entries, e.g. /cont1a/list2a[name=l2a1]
+func dataPath(e *yang.Entry) string {
+	elems := make([]string, 0)
+	for ; e != nil && e.Parent != nil; e = e.Parent {
+		elem := e.Name
+		if e.IsList() {
+			for _, key := range strings.Fields(e.Key) {
+				if keyEntry, ok := e.Dir[key]; ok {
+					keyNav := &YangNodeNavigator{curr: keyEntry}
+					elem = fmt.Sprintf("%s[%s=%s]", elem, key, keyNav.Value())
+				}
+			}
+		}
+		elems = append([]string{elem}, elems...)
+	}
+	return "/" + strings.Join(elems, "/")
+}
+
+// mustContext lists the must node and the keys of its list entry: the node
+// itself or, for a leaf, its parent. The evaluation of the expression moves
+// the navigator and marks other nodes as $this, so it is not the context;
+// but for the nodes outside of list entries, the keys of the list entry where
+// the evaluation stopped, e.g. the entry that a predicate selected, are all
+// there is.
+func (x *YangNodeNavigator) mustContext(evaluated *YangNodeNavigator) []string {
+	node := x.Copy().(*YangNodeNavigator)
+	node.MarkThis()
+	keysExpr := "@*"
+	if x.curr.IsLeaf() || x.curr.IsLeafList() {
+		keysExpr = "../@*"
+	}
+	items := node.generateMustError(keysExpr)
+	if len(items) > 1 {
+		return items
+	}
+	if evaluatedItems := evaluated.generateMustError("@*"); len(evaluatedItems) > 1 {
+		items = append(items, evaluatedItems[1:]...)
+	}
+	return items
+}
+
 func (x *YangNodeNavigator) generateMustError(expr string) []string {
 	items := make([]string, 0)
 	gSt, ok := x.this.Annotation["gostruct"]
@@ -313,6 +491,9 @@ func (x *YangNodeNavigator) generateMustError(expr string) []string {
 	}
 	currentIter := currentExpr.Select(x)
 	for currentIter.MoveNext() {
+		if current, ok := currentIter.Current().(*YangNodeNavigator); ok && current.curr == x.this {
+			continue
+		}
 		items = append(items, fmt.Sprintf("%s=%s", currentIter.Current().LocalName(), currentIter.Current().Value()))
 	}
 	return items
@@ -384,6 +565,8 @@ func (x *YangNodeNavigator) Value() string {
 				case reflect.Slice: // Most likely Binary
 					bytes := valueReflected.Bytes()
 					return base64.StdEncoding.EncodeToString(bytes)
+				case reflect.Bool: // Most likely Empty
+					return fmt.Sprint(valueReflected.Bool())
 				case reflect.Int64: // Most likely a YANG Identity
 					strMethod := valueReflected.MethodByName("String")
 					if !strMethod.IsZero() {
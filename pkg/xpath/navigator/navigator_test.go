@@ -10,6 +10,7 @@ import (
 	"github.com/openconfig/ygot/ygot"
 	"github.com/stretchr/testify/assert"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -348,3 +349,114 @@ func Test_generateMustError(t *testing.T) {
 	assert.Equal(t, "a=test1", parts[1])
 	assert.Equal(t, "b=10", parts[2])
 }
+
+func mustExtra(expr string, errorMessage string, errorAppTag string) map[string][]interface{} {
+	return map[string][]interface{}{
+		"must": {
+			map[string]interface{}{
+				"Name":         expr,
+				"ErrorMessage": map[string]interface{}{"Name": errorMessage},
+				"ErrorAppTag":  map[string]interface{}{"Name": errorAppTag},
+			},
+		},
+	}
+}
+
+func Test_WalkAndValidateMust(t *testing.T) {
+	aValue := "test1"
+	bValue := 10
+	td := testDevice{
+		TestStruct: &testDevice_testStruct{
+			A: &aValue,
+			B: &bValue,
+		},
+	}
+
+	newEntry := func(mustA string, mustB string) *yang.Entry {
+		return &yang.Entry{
+			Name: "testDevice",
+			Kind: yang.DirectoryEntry,
+			Dir: map[string]*yang.Entry{
+				"testStruct": {
+					Name:  "testStruct",
+					Kind:  yang.DirectoryEntry,
+					Extra: mustExtra(mustA, "a must be test2", "a-must"),
+					Dir: map[string]*yang.Entry{
+						"a": {
+							Name: "a",
+							Kind: yang.LeafEntry,
+						},
+						"b": {
+							Name:  "b",
+							Kind:  yang.LeafEntry,
+							Extra: mustExtra(mustB, "b must be greater than 20", "b-must"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	ynn := NewYangNodeNavigator(newEntry("./a = 'test1'", "number(.) > 5"), &td, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateMust())
+
+	// All the must statements are evaluated, not only the first false one
+	ynn = NewYangNodeNavigator(newEntry("./a = 'test2'", "number(.) > 20"), &td, false).(*YangNodeNavigator)
+	err := ynn.WalkAndValidateMust()
+	violations, ok := err.(MustViolations)
+	assert.True(t, ok, "unexpected error %v", err)
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "/testStruct", violations[0].Path)
+	assert.Equal(t, "./a = 'test2'", violations[0].Expression)
+	assert.Equal(t, "a must be test2", violations[0].ErrorMessage)
+	assert.Equal(t, "a-must", violations[0].ErrorAppTag)
+	assert.Equal(t, "/testStruct/b", violations[1].Path)
+	assert.Equal(t, "b-must", violations[1].ErrorAppTag)
+	assert.True(t, strings.HasPrefix(violations[0].Error(), "a must be test2. Must statement './a = 'test2'' to true. Container(s): [context: testStruct="))
+	assert.Equal(t, violations[0].Error()+"; "+violations[1].Error(), err.Error())
+}
+
+func Test_WalkAndValidateMustDefault(t *testing.T) {
+	newEntry := func() *yang.Entry {
+		return &yang.Entry{
+			Name: "testDevice",
+			Kind: yang.DirectoryEntry,
+			Dir: map[string]*yang.Entry{
+				"testStruct": {
+					Name:  "testStruct",
+					Kind:  yang.DirectoryEntry,
+					Extra: mustExtra("number(./b) > 5", "b must be greater than 5", "b-must"),
+					Dir: map[string]*yang.Entry{
+						"a": {Name: "a", Kind: yang.LeafEntry},
+						"b": {Name: "b", Kind: yang.LeafEntry, Default: []string{"3"}, Extra: whenExtra("../a = 'test2'")},
+					},
+				},
+			},
+		}
+	}
+
+	// b takes its default value, which fails the must statement; its when
+	// statement is false, but it only applies to data
+	aValue := "test1"
+	td := testDevice{
+		TestStruct: &testDevice_testStruct{A: &aValue},
+	}
+	ynn := NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	err := ynn.WalkAndValidateMust()
+	violations, ok := err.(MustViolations)
+	assert.True(t, ok, "unexpected error %v", err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "/testStruct", violations[0].Path)
+	ynn = NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateWhen())
+
+	bValue := 30
+	td.TestStruct.B = &bValue
+	ynn = NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateMust())
+	assert.False(t, isDefaulted(ynn.root.Dir["testStruct"].Dir["b"]))
+
+	// No default without the container
+	ynn = NewYangNodeNavigator(newEntry(), &testDevice{}, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateMust())
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package navigator
+
+import (
+	"fmt"
+	"github.com/SeanCondon/xpath"
+	"github.com/openconfig/goyang/pkg/yang"
+	"strings"
+)
+
+const whenStatements = "when"
+
+// WhenViolation is a node present in the data although its when statement
+// is false
+type WhenViolation struct {
+	// Path is the data path of the node
+	Path       string
+	Expression string
+}
+
+func (v *WhenViolation) Error() string {
+	return fmt.Sprintf("%s: node present although when statement '%s' is false", v.Path, v.Expression)
+}
+
+// WhenViolations lists all the nodes present although their when statement is false
+type WhenViolations []*WhenViolation
+
+func (v WhenViolations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// whenCondition is a when statement that applies to a node
+type whenCondition struct {
+	expression string
+	// fromParent is set for the when statements of augment and uses, whose
+	// context is the parent of the nodes they add
+	fromParent bool
+}
+
+// extractWhen - the when statements of the nodes are crammed in to the
+// Extra field of the yang.Entry, like the must statements
+func extractWhen(whenStmnts []interface{}) []string {
+	expressions := make([]string, 0, len(whenStmnts))
+	for _, s := range whenStmnts {
+		switch w := s.(type) {
+		case *yang.Value:
+			expressions = append(expressions, w.Name)
+		case map[string]interface{}:
+			if name, ok := w["Name"].(string); ok {
+				expressions = append(expressions, name)
+			}
+		}
+	}
+	return expressions
+}
+
+// whenConditions returns the when statements of a node: its own, and those of
+// the augment and uses statements that added it to its parent
+func whenConditions(e *yang.Entry) []whenCondition {
+	conditions := make([]whenCondition, 0)
+	if expressions, ok := e.Annotation[whenStatements].([]string); ok {
+		for _, expr := range expressions {
+			conditions = append(conditions, whenCondition{expression: expr})
+		}
+	}
+	parent := e.Parent
+	if parent == nil {
+		return conditions
+	}
+	for _, augment := range parent.Augmented {
+		if _, ok := augment.Dir[e.Name]; ok {
+			for _, expr := range extractWhen(augment.Extra[whenStatements]) {
+				conditions = append(conditions, whenCondition{expression: expr, fromParent: true})
+			}
+		}
+	}
+	for _, uses := range parent.Uses {
+		if uses.Uses == nil || uses.Uses.When == nil || uses.Grouping == nil {
+			continue
+		}
+		if _, ok := uses.Grouping.Dir[e.Name]; ok {
+			conditions = append(conditions, whenCondition{expression: uses.Uses.When.Name, fromParent: true})
+		}
+	}
+	return conditions
+}
+
+// WalkAndValidateWhen - walk through the YNN and evaluate the when statements
+// of the nodes present in the data, including those of the augment and uses
+// statements that added them. The nodes whose when statement is false are
+// returned as WhenViolations. Leaves that take their default value are not
+// data, so their when statements are not checked.
+func (x *YangNodeNavigator) WalkAndValidateWhen() error {
+	violations := make(WhenViolations, 0)
+	x.MoveToRoot()
+	for x.moveToNextNode() {
+		if isDefaulted(x.curr) {
+			continue
+		}
+		for _, condition := range whenConditions(x.curr) {
+			context := x.curr
+			if condition.fromParent {
+				context = x.curr.Parent
+			}
+			result, err := x.evaluateWhen(condition.expression, context)
+			if err != nil {
+				return err
+			}
+			log.Infof("Checking When rule %s: %v", condition.expression, result)
+			if !result {
+				violations = append(violations, &WhenViolation{
+					Path:       dataPath(x.curr),
+					Expression: condition.expression,
+				})
+			}
+		}
+	}
+	x.MoveToRoot()
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// evaluateWhen evaluates a when statement from its context node. As in
+// XPath, a node set is true if it is not empty.
+func (x *YangNodeNavigator) evaluateWhen(expression string, context *yang.Entry) (bool, error) {
+	whenExpr, err := xpath.Compile(expression)
+	if err != nil {
+		return false, err
+	}
+	nav := x.Copy().(*YangNodeNavigator)
+	nav.curr = context
+	switch result := whenExpr.Evaluate(nav).(type) {
+	case bool:
+		return result, nil
+	case *xpath.NodeIterator:
+		return result.MoveNext(), nil
+	default:
+		return false, fmt.Errorf("result of %s cannot be evaluated as bool %v", whenExpr.String(), result)
+	}
+}
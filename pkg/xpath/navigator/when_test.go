@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package navigator
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func whenExtra(expr string) map[string][]interface{} {
+	return map[string][]interface{}{
+		"when": {map[string]interface{}{"Name": expr}},
+	}
+}
+
+func Test_WalkAndValidateWhen(t *testing.T) {
+	newEntry := func() *yang.Entry {
+		return &yang.Entry{
+			Name: "testDevice",
+			Kind: yang.DirectoryEntry,
+			Dir: map[string]*yang.Entry{
+				"testStruct": {
+					Name:  "testStruct",
+					Kind:  yang.DirectoryEntry,
+					Extra: whenExtra("./b"),
+					Dir: map[string]*yang.Entry{
+						"a": {Name: "a", Kind: yang.LeafEntry, Extra: whenExtra("../b > 5")},
+						"b": {Name: "b", Kind: yang.LeafEntry},
+						"c": {Name: "c", Kind: yang.LeafEntry},
+						"d": {Name: "d", Kind: yang.LeafEntry},
+					},
+					// c is added by an augment and d by a uses, both conditional
+					Augmented: []*yang.Entry{{
+						Name:  "testStruct",
+						Dir:   map[string]*yang.Entry{"c": {Name: "c"}},
+						Extra: whenExtra("./b = 10"),
+					}},
+					Uses: []*yang.UsesStmt{{
+						Uses:     &yang.Uses{Name: "d-group", When: &yang.Value{Name: "./a = 'test1'"}},
+						Grouping: &yang.Entry{Name: "d-group", Dir: map[string]*yang.Entry{"d": {Name: "d"}}},
+					}},
+				},
+			},
+		}
+	}
+
+	aValue := "test1"
+	bValue := 10
+	cValue := "test3"
+	dValue := true
+	td := testDevice{
+		TestStruct: &testDevice_testStruct{A: &aValue, B: &bValue, C: &cValue, D: &dValue},
+	}
+	ynn := NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateWhen())
+
+	bValue = 3
+	ynn = NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	err := ynn.WalkAndValidateWhen()
+	violations, ok := err.(WhenViolations)
+	assert.True(t, ok, "unexpected error %v", err)
+	assert.Equal(t, WhenViolations{
+		{Path: "/testStruct/a", Expression: "../b > 5"},
+		{Path: "/testStruct/c", Expression: "./b = 10"},
+	}, violations)
+	assert.Equal(t, "/testStruct/a: node present although when statement '../b > 5' is false; "+
+		"/testStruct/c: node present although when statement './b = 10' is false", err.Error())
+
+	// The when statements only apply to the nodes present in the data
+	td.TestStruct.A = nil
+	td.TestStruct.C = nil
+	td.TestStruct.D = nil
+	ynn = NewYangNodeNavigator(newEntry(), &td, false).(*YangNodeNavigator)
+	assert.NoError(t, ynn.WalkAndValidateWhen())
+}
@@ -11,7 +11,8 @@ ENV GO111MODULE=on
 COPY go.mod go.sum /models/{{ .Name }}/
 COPY api /models/{{ .Name }}/api
 COPY plugin /models/{{ .Name }}/plugin
-RUN cd /models/{{ .Name }} && go build -o _bin/{{ .Name }} ./plugin
+COPY vendor /models/{{ .Name }}/vendor
+RUN cd /models/{{ .Name }} && go build -mod=vendor -o _bin/{{ .Name }} ./plugin
 
 FROM alpine:3.14
 RUN apk add libc6-compat
@@ -39,4 +40,4 @@ USER nobody
 COPY --from=build /models/{{ .Name }}/_bin/{{ .Name }} /usr/local/bin/{{ .Name }}
 
 ENTRYPOINT ["{{ .Name }}"]
-CMD ["5152"]
\ No newline at end of file
+CMD ["--port=5152"]
\ No newline at end of file
@@ -13,6 +13,7 @@ LATEST_VERSION           ?= ${BASE_VERSION}-{{ .Name }}-latest
 GO_TAG ?= models/{{ .Name }}-{{ .Version }}/v${BASE_VERSION}
 KIND_CLUSTER_NAME ?= kind
 PLATFORM ?= --platform linux/x86_64
+MODEL_COMPILER ?= model-compiler
 IS_RELEASED_VERSION=$(shell MY_STRING="${BASE_VERSION}"; MY_REGEX='^([0-9]+)\.([0-9]+)\.([0-9]+)$$'; if [[ $$MY_STRING =~ $$MY_REGEX ]]; then echo true; else echo false; fi)
 
 ## Docker labels. Only set ref and commit date if committed
@@ -66,12 +67,8 @@ openapi: build-openapi # @HELP Generate OpenApi specs
 {{- /*	go run gnmi-gen/gnmi-gen.go*/}}
 {{- /*	go fmt api/gnmi_client.go*/}}
 
-yang-lint:
-	cd yang; \
-	p="."; \
-	mdy=`grep ".yang" ../metadata.yaml | awk '{print $$2}' | paste -sd ' ' -`; \
-	for dir in */; do p=$$p:$$dir; done; \
-	pyang --lint --lint-ensure-hyphenated-names --ignore-error=XPATH_FUNCTION -p $$p $$mdy
+yang-lint: # @HELP Lint the YANG files (available parameters: MODEL_COMPILER)
+	${MODEL_COMPILER} lint .
 
 test: mod-update # @HELP Run the unit tests
 	go test ./...
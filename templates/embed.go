@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package templates holds the templates of the model plugin artifacts, so that
+// they are compiled in to the model-compiler binary.
+package templates
+
+import "embed"
+
+// Templates are the model plugin artifact templates, by file name
+//
+//go:embed *.tpl
+var Templates embed.FS
@@ -6,7 +6,7 @@ require (
 	github.com/SeanCondon/xpath v0.0.0-20220821123841-6149b14eb04f
 	github.com/ghodss/yaml v1.0.0
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0 // indirect
-	github.com/onosproject/config-models v0.10.40
+	github.com/onosproject/config-models {{ .ConfigModelsVersion }}
 	github.com/onosproject/onos-api/go v0.9.46
 	github.com/onosproject/onos-lib-go v0.9.3
 	github.com/openconfig/gnmi v0.0.0-20220617175856-41246b1b3507
@@ -16,3 +16,7 @@ require (
 	google.golang.org/grpc v1.41.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
+{{- if .ConfigModelsDir }}
+
+replace github.com/onosproject/config-models => {{ .ConfigModelsDir }}
+{{- end }}